@@ -7,6 +7,7 @@ import (
 	blobante "github.com/celestiaorg/celestia-app/v6/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v6/x/blob/keeper"
 	minfeekeeper "github.com/celestiaorg/celestia-app/v6/x/minfee/keeper"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -24,6 +25,7 @@ func NewAnteHandler(
 	channelKeeper *ibckeeper.Keeper,
 	minfeeKeeper *minfeekeeper.Keeper,
 	circuitkeeper *circuitkeeper.Keeper,
+	nsregistryKeeper *nsregistrykeeper.Keeper,
 	paramFilters map[string]ParamFilter,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
@@ -45,10 +47,14 @@ func NewAnteHandler(
 		// Ensure the tx's gas limit is > the gas consumed based on the tx size.
 		// Side effect: consumes gas from the gas meter.
 		NewConsumeGasForTxSizeDecorator(accountKeeper),
-		// Ensure the feepayer (fee granter or first signer) has enough funds to pay for the tx.
+		// Ensure the feepayer (sponsor of a sponsored PFB, fee granter or first signer) has enough funds to pay for the tx.
 		// Ensure that the tx's gas price is >= the network minimum gas price.
 		// Side effect: deducts fees from the fee payer. Sets the tx priority in context.
-		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, ValidateTxFeeWrapper(minfeeKeeper)),
+		NewSponsoredFeeDecorator(
+			blobKeeper,
+			ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, ValidateTxFeeWrapper(minfeeKeeper)),
+			ValidateTxFeeWrapper(minfeeKeeper),
+		),
 		// Set public keys in the context for fee-payer and all signers.
 		// Contract: must be called before all signature verification decorators.
 		ante.NewSetPubKeyDecorator(accountKeeper),
@@ -72,6 +78,9 @@ func NewAnteHandler(
 		// Ensure that the blob shares occupied by the tx <= the max shares
 		// available to blob data in a data square.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of a MsgPayForBlobs is allowed to submit
+		// blobs to the namespaces registered in the namespace registry.
+		NewNamespaceRegistryDecorator(nsregistryKeeper),
 		// Ensure that txs with MsgSubmitProposal/MsgExec have at least one message and param filters are applied.
 		NewParamFilterDecorator(paramFilters),
 		// Side effect: increment the nonce for all tx signers.
@@ -53,7 +53,7 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, minfeeKeeper *minfeekeeper.Keeper
 		}
 	}
 
-	networkMinGasPrice := minfeeKeeper.GetParams(ctx).NetworkMinGasPrice
+	networkMinGasPrice := minfeeKeeper.GetNetworkMinGasPrice(ctx)
 
 	err := verifyMinFee(fee, gas, networkMinGasPrice, "insufficient gas price for the network")
 	if err != nil {
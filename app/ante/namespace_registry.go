@@ -0,0 +1,48 @@
+package ante
+
+import (
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = NamespaceRegistryDecorator{}
+
+// NamespaceRegistryKeeper defines the nsregistry keeper methods used by the
+// NamespaceRegistryDecorator.
+type NamespaceRegistryKeeper interface {
+	GetNamespaceRecord(ctx sdk.Context, namespace []byte) (nsregistrytypes.NamespaceRecord, bool)
+}
+
+// NamespaceRegistryDecorator ensures that the signer of a MsgPayForBlobs is
+// allowed to submit blobs to each of its namespaces. Namespaces that are not
+// registered, or whose registration has expired, are open to every signer.
+//
+// Contract: must be called after the MsgExecDecorator which rejects a
+// MsgPayForBlobs nested inside a MsgExec.
+type NamespaceRegistryDecorator struct {
+	k NamespaceRegistryKeeper
+}
+
+func NewNamespaceRegistryDecorator(k NamespaceRegistryKeeper) NamespaceRegistryDecorator {
+	return NamespaceRegistryDecorator{k}
+}
+
+func (d NamespaceRegistryDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		pfb, ok := msg.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		for _, namespace := range pfb.Namespaces {
+			record, found := d.k.GetNamespaceRecord(ctx, namespace)
+			if !found || record.IsExpired(ctx.BlockTime()) {
+				continue
+			}
+			if !record.CanSubmit(pfb.Signer) {
+				return ctx, nsregistrytypes.ErrSignerNotAllowed.Wrapf("%s can not submit blobs to namespace %X owned by %s", pfb.Signer, namespace, record.Owner)
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
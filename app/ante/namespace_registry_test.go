@@ -0,0 +1,79 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/ante"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceRegistryDecorator(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	allowed := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	stranger := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	restricted := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	unrestricted := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	expired := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+	unregistered := share.MustNewV0Namespace(bytes.Repeat([]byte{4}, share.NamespaceVersionZeroIDSize))
+
+	keeper := mockNamespaceRegistryKeeper{
+		string(restricted.Bytes()):   {Owner: owner, Restricted: true, AllowedSigners: []string{allowed}, Expiration: now.Add(time.Hour)},
+		string(unrestricted.Bytes()): {Owner: owner, Expiration: now.Add(time.Hour)},
+		string(expired.Bytes()):      {Owner: owner, Restricted: true, Expiration: now},
+	}
+
+	tests := []struct {
+		name       string
+		signer     string
+		namespaces []share.Namespace
+		wantErr    bool
+	}{
+		{"owner submits to restricted namespace", owner, []share.Namespace{restricted}, false},
+		{"allowed signer submits to restricted namespace", allowed, []share.Namespace{restricted}, false},
+		{"stranger submits to restricted namespace", stranger, []share.Namespace{restricted}, true},
+		{"stranger submits to restricted namespace among others", stranger, []share.Namespace{unregistered, restricted}, true},
+		{"stranger submits to unrestricted namespace", stranger, []share.Namespace{unrestricted}, false},
+		{"stranger submits to expired namespace", stranger, []share.Namespace{expired}, false},
+		{"stranger submits to unregistered namespace", stranger, []share.Namespace{unregistered}, false},
+	}
+
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewNamespaceRegistryDecorator(keeper))
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &blobtypes.MsgPayForBlobs{Signer: tc.signer}
+			for _, ns := range tc.namespaces {
+				msg.Namespaces = append(msg.Namespaces, ns.Bytes())
+			}
+			txBuilder := cdc.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+
+			ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, nil)
+			_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+			if tc.wantErr {
+				require.ErrorIs(t, err, nsregistrytypes.ErrSignerNotAllowed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type mockNamespaceRegistryKeeper map[string]nsregistrytypes.NamespaceRecord
+
+func (m mockNamespaceRegistryKeeper) GetNamespaceRecord(_ sdk.Context, namespace []byte) (nsregistrytypes.NamespaceRecord, bool) {
+	record, found := m[string(namespace)]
+	return record, found
+}
@@ -0,0 +1,99 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+var _ sdk.AnteDecorator = SponsoredFeeDecorator{}
+
+// SponsorKeeper defines the blob keeper methods used by the
+// SponsoredFeeDecorator.
+type SponsorKeeper interface {
+	DeductSponsoredFee(ctx sdk.Context, sponsor, signer string, fee sdk.Coins) error
+}
+
+// SponsoredFeeDecorator deducts the fee of a tx whose MsgPayForBlobs sets a
+// sponsor from the funds deposited by that sponsor in the blob module. The
+// fee of every other tx is deducted by the wrapped decorator.
+//
+// A sponsored MsgPayForBlobs must be the only message of its tx so that the
+// sponsor never pays for other messages, and the tx must not set a fee
+// granter.
+type SponsoredFeeDecorator struct {
+	k            SponsorKeeper
+	deductFee    sdk.AnteDecorator
+	txFeeChecker ante.TxFeeChecker
+}
+
+func NewSponsoredFeeDecorator(k SponsorKeeper, deductFee sdk.AnteDecorator, txFeeChecker ante.TxFeeChecker) SponsoredFeeDecorator {
+	return SponsoredFeeDecorator{
+		k:            k,
+		deductFee:    deductFee,
+		txFeeChecker: txFeeChecker,
+	}
+}
+
+func (d SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	pfb, err := getSponsoredPFB(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if pfb == nil {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if feeTx.FeeGranter() != nil {
+		return ctx, errors.Wrap(blobtypes.ErrInvalidSponsoredTx, "a sponsored tx can not set a fee granter")
+	}
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errors.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	var priority int64
+	fee := feeTx.GetFee()
+	if !simulate {
+		fee, priority, err = d.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+	if !fee.IsValid() {
+		return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+	if err := d.k.DeductSponsoredFee(ctx, pfb.Sponsor, pfb.Signer, fee); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, pfb.Sponsor),
+	))
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// getSponsoredPFB returns the MsgPayForBlobs of tx if it sets a sponsor, or
+// nil if no message of tx is a sponsored MsgPayForBlobs.
+func getSponsoredPFB(tx sdk.Tx) (*blobtypes.MsgPayForBlobs, error) {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		pfb, ok := msg.(*blobtypes.MsgPayForBlobs)
+		if !ok || pfb.Sponsor == "" {
+			continue
+		}
+		if len(msgs) != 1 {
+			return nil, errors.Wrapf(blobtypes.ErrInvalidSponsoredTx, "a sponsored MsgPayForBlobs must be the only message of its tx, got %d messages", len(msgs))
+		}
+		return pfb, nil
+	}
+	return nil, nil
+}
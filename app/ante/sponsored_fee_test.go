@@ -0,0 +1,106 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/ante"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsoredFeeDecorator(t *testing.T) {
+	sponsor := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	signer := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	fee := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(100)))
+
+	tests := []struct {
+		name          string
+		msgs          []sdk.Msg
+		feeGranter    sdk.AccAddress
+		wantErr       error
+		wantSponsored bool
+	}{
+		{
+			name: "unsponsored PFB is deducted by the wrapped decorator",
+			msgs: []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: signer}},
+		},
+		{
+			name: "non PFB is deducted by the wrapped decorator",
+			msgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: signer}},
+		},
+		{
+			name:          "sponsored PFB is deducted from the sponsor",
+			msgs:          []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: signer, Sponsor: sponsor}},
+			wantSponsored: true,
+		},
+		{
+			name:    "sponsored PFB with other messages",
+			msgs:    []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: signer, Sponsor: sponsor}, &banktypes.MsgSend{FromAddress: signer}},
+			wantErr: blobtypes.ErrInvalidSponsoredTx,
+		},
+		{
+			name:       "sponsored PFB with a fee granter",
+			msgs:       []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: signer, Sponsor: sponsor}},
+			feeGranter: sdk.AccAddress(bytes.Repeat([]byte{3}, 20)),
+			wantErr:    blobtypes.ErrInvalidSponsoredTx,
+		},
+	}
+
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	feeChecker := func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error) { return fee, 1, nil }
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keeper := &mockSponsorKeeper{}
+			deductFee := &mockDeductFeeDecorator{}
+			anteHandler := sdk.ChainAnteDecorators(ante.NewSponsoredFeeDecorator(keeper, deductFee, feeChecker))
+
+			txBuilder := cdc.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetGasLimit(1000)
+			txBuilder.SetFeeGranter(tc.feeGranter)
+
+			ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, nil)
+			_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, !tc.wantSponsored, deductFee.called)
+			if tc.wantSponsored {
+				require.Equal(t, sponsor, keeper.sponsor)
+				require.Equal(t, signer, keeper.signer)
+				require.Equal(t, fee, keeper.fee)
+			}
+		})
+	}
+}
+
+type mockSponsorKeeper struct {
+	sponsor, signer string
+	fee             sdk.Coins
+}
+
+func (m *mockSponsorKeeper) DeductSponsoredFee(_ sdk.Context, sponsor, signer string, fee sdk.Coins) error {
+	m.sponsor, m.signer, m.fee = sponsor, signer, fee
+	return nil
+}
+
+type mockDeductFeeDecorator struct {
+	called bool
+}
+
+func (m *mockDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	m.called = true
+	return next(ctx, tx, simulate)
+}
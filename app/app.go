@@ -32,7 +32,9 @@ import (
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	"github.com/celestiaorg/celestia-app/v6/app/ante"
 	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
 	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/proposal"
 	celestiatx "github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
@@ -46,6 +48,9 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/x/mint"
 	mintkeeper "github.com/celestiaorg/celestia-app/v6/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v6/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
 	"github.com/celestiaorg/celestia-app/v6/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/celestiaorg/go-square/v3/share"
@@ -139,6 +144,8 @@ var maccPerms = map[string][]string{
 	icatypes.ModuleName:            nil,
 	hyperlanetypes.ModuleName:      nil,
 	warptypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+	nsregistrytypes.ModuleName:     nil,
+	blobtypes.ModuleName:           nil,
 }
 
 var (
@@ -181,6 +188,7 @@ type App struct {
 	ICAHostKeeper       icahostkeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	BlobKeeper          blobkeeper.Keeper
+	NsRegistryKeeper    *nsregistrykeeper.Keeper
 	CircuitKeeper       circuitkeeper.Keeper
 	HyperlaneKeeper     hyperlanekeeper.Keeper
 	WarpKeeper          warpkeeper.Keeper
@@ -201,6 +209,24 @@ type App struct {
 	// This prevents data races between Commit updating checkState and QuerySequence
 	// reading it via CheckState().
 	checkStateMu *sync.RWMutex
+	// gasPriceHistory keeps the gas price statistics of the recent blocks for
+	// the gas estimation service.
+	gasPriceHistory *gasestimation.GasPriceHistory
+	// finalizedBlock is the block being finalized, whose statistics are
+	// recorded once it's committed.
+	finalizedBlock *finalizedBlock
+	// committedBlockRecorded is closed once the statistics of the last
+	// committed block have been recorded.
+	committedBlockRecorded chan struct{}
+	// proposalOrderingPolicy orders the transactions of the proposals of this
+	// node.
+	proposalOrderingPolicy ProposalOrderingPolicy
+	// proposalReports keeps the reports of the recent proposals built by this
+	// node for the proposal query service.
+	proposalReports *proposal.ReportHistory
+	// blobIndex keeps the PFBs of the recent blocks for the blob index query
+	// service.
+	blobIndex *blobindex.Index
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -231,6 +257,14 @@ func New(
 		delayedPrecommitTimeout = appconsts.DelayedPrecommitTimeout
 	}
 
+	proposalOrderingPolicy, err := NewProposalOrderingPolicy(
+		cast.ToString(appOpts.Get(FlagProposalOrderingPolicy)),
+		cast.ToInt(appOpts.Get(FlagProposalOrderingMaxTxsPerSigner)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	app := &App{
 		BaseApp:                 baseApp,
 		keys:                    keys,
@@ -239,6 +273,10 @@ func New(
 		txCache:                 NewTxCache(),
 		delayedPrecommitTimeout: delayedPrecommitTimeout,
 		checkStateMu:            &sync.RWMutex{},
+		gasPriceHistory:         gasestimation.NewGasPriceHistory(encodingConfig.TxConfig.TxDecoder(), cast.ToInt(appOpts.Get(gasestimation.FlagGasPriceHistoryBlocks))),
+		proposalOrderingPolicy:  proposalOrderingPolicy,
+		proposalReports:         proposal.NewReportHistory(cast.ToInt(appOpts.Get(proposal.FlagProposalReportHistory))),
+		blobIndex:               blobindex.NewIndex(cast.ToInt(appOpts.Get(blobindex.FlagBlobIndexBlocks))),
 	}
 
 	// needed for migration from x/params -> module's ownership of own params
@@ -307,6 +345,7 @@ func New(
 		encodingConfig.Codec,
 		keys[signaltypes.StoreKey],
 		app.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -376,9 +415,18 @@ func New(
 		encodingConfig.Codec,
 		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.NsRegistryKeeper = nsregistrykeeper.NewKeeper(
+		encodingConfig.Codec,
+		keys[nsregistrytypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		govModuleAddr,
+	)
+
 	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
@@ -428,6 +476,7 @@ func New(
 		capability.NewAppModule(encodingConfig.Codec, *app.CapabilityKeeper, true),
 		transfer.NewAppModule(app.TransferKeeper),
 		blob.NewAppModule(encodingConfig.Codec, app.BlobKeeper),
+		nsregistry.NewAppModule(encodingConfig.Codec, app.NsRegistryKeeper),
 		signal.NewAppModule(app.SignalKeeper),
 		minfee.NewAppModule(encodingConfig.Codec, app.MinFeeKeeper),
 		pfm{packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName))},
@@ -457,6 +506,8 @@ func New(
 
 	app.CustomQueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.CustomQueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.CustomQueryRouter().AddRoute(proof.BlobInclusionQueryPath, proof.QueryBlobInclusionProof)
+	app.CustomQueryRouter().AddRoute(proof.NamespaceDataQueryPath, proof.QueryNamespaceData)
 
 	app.configurator = module.NewConfigurator(encodingConfig.Codec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	if err := app.ModuleManager.RegisterServices(app.configurator); err != nil {
@@ -487,6 +538,7 @@ func New(
 		app.IBCKeeper,
 		app.MinFeeKeeper,
 		&app.CircuitKeeper,
+		app.NsRegistryKeeper,
 		app.GovParamFilters(),
 	))
 
@@ -546,12 +598,24 @@ func (app *App) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFin
 		app.txCache.RemoveTransaction(tx)
 	}
 
+	if app.finalizedBlock != nil {
+		app.finalizedBlock.results = res.TxResults
+	}
+
 	return res, nil
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req != nil {
+		app.finalizedBlock = app.newFinalizedBlock(ctx, req)
+		app.recordSquareUtilization(ctx, app.finalizedBlock.square)
+	}
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -738,6 +802,8 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new celestia routes from grpc-gateway.
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	proof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	blobindex.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register grpc-gateway routes for all modules.
 	app.BasicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -745,8 +811,11 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.encodingConfig.InterfaceRegistry)
-	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice)
+	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry, app.Logger())
+	proof.RegisterQueryService(app.GRPCQueryRouter(), clientCtx)
+	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice, app.gasPriceHistory)
+	proposal.RegisterQueryService(app.GRPCQueryRouter(), app.proposalReports)
+	blobindex.RegisterQueryService(app.GRPCQueryRouter(), app.blobIndex)
 }
 
 func (app *App) getGovMaxSquareBytes() (uint64, error) {
@@ -769,8 +838,7 @@ func (app *App) getMinGasPrice() (float64, error) {
 	if err != nil {
 		return localMinGasPrice, err
 	}
-	params := app.MinFeeKeeper.GetParams(ctx)
-	networkMinGasPrice := params.NetworkMinGasPrice.MustFloat64()
+	networkMinGasPrice := app.MinFeeKeeper.GetNetworkMinGasPrice(ctx).MustFloat64()
 	return math.Max(networkMinGasPrice, localMinGasPrice), nil
 }
 
@@ -863,9 +931,15 @@ func (app *App) TimeoutInfo() abci.TimeoutInfo {
 
 // Commit overrides BaseApp's Commit to add synchronization with QuerySequence.
 // This prevents data races between commit updating checkState (mempool state) and
-// QuerySequence reading it via CheckState().
+// QuerySequence reading it via CheckState(). The statistics of the committed block
+// are then recorded in the background.
 func (app *App) Commit() (*abci.ResponseCommit, error) {
 	app.checkStateMu.Lock()
 	defer app.checkStateMu.Unlock()
-	return app.BaseApp.Commit()
+	res, err := app.BaseApp.Commit()
+	if err != nil {
+		return nil, err
+	}
+	app.recordCommittedBlock()
+	return res, nil
 }
@@ -17,7 +17,6 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
 	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
 	"github.com/celestiaorg/go-square/v3/share"
-	"github.com/cometbft/cometbft/rpc/client"
 	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -73,6 +72,7 @@ func BenchmarkGasPriceEstimation(b *testing.B) {
 				func() (uint64, error) { return 128 * 128 * share.ContinuationSparseShareContentSize, nil },
 				func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil },
 				func() (float64, error) { return appconsts.DefaultNetworkMinGasPrice, nil },
+				nil,
 			)
 			for b.Loop() {
 				_, err := gasEstimationServer.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
@@ -100,7 +100,7 @@ func generateRandomPFBs(b *testing.B, signer *user.Signer, account string, numbe
 	return txs
 }
 
-var _ client.MempoolClient = &benchMempool{}
+var _ gasestimation.NodeClient = &benchMempool{}
 
 type benchMempool struct {
 	txs []types.Tx
@@ -118,6 +118,10 @@ func (b benchMempool) CheckTx(ctx context.Context, tx types.Tx) (*rpctypes.Resul
 	return nil, nil
 }
 
+func (b benchMempool) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*rpctypes.ResultBlockchainInfo, error) {
+	return &rpctypes.ResultBlockchainInfo{}, nil
+}
+
 func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfactory.AccountInfo {
 	infos := make([]blobfactory.AccountInfo, len(accs))
 	for i, acc := range accs {
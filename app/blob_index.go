@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// indexPayForBlobs returns the PFBs of a committed block that were executed
+// successfully, identified by the EventPayForBlobs they emitted, along with
+// the share ranges their blobs occupy in the original data square.
+func (app *App) indexPayForBlobs(block *finalizedBlock) ([]*blobindex.IndexedPayForBlobs, error) {
+	txs, results := block.txs, block.results
+	if len(txs) != len(results) {
+		return nil, fmt.Errorf("got %d tx results for %d txs", len(results), len(txs))
+	}
+
+	var (
+		payForBlobs []*blobindex.IndexedPayForBlobs
+		eventType   = proto.MessageName(&blobtypes.EventPayForBlobs{})
+	)
+	for idx, rawTx := range txs {
+		if results[idx].Code != abci.CodeTypeOK {
+			continue
+		}
+		event, found := findPayForBlobsEvent(results[idx].Events, eventType)
+		if !found {
+			continue
+		}
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx || err != nil {
+			continue
+		}
+		sdkTx, err := app.encodingConfig.TxConfig.TxDecoder()(blobTx.Tx)
+		if err != nil {
+			continue
+		}
+		pfb, found := hasPFB(sdkTx.GetMsgs())
+		if !found || len(pfb.ShareCommitments) != len(event.Namespaces) {
+			continue
+		}
+
+		// the square is only built for blocks that contain PFBs.
+		builder, err := block.square.build()
+		if err != nil {
+			return nil, fmt.Errorf("building square: %w", err)
+		}
+
+		indexed := &blobindex.IndexedPayForBlobs{
+			Height:  block.height,
+			TxHash:  cmttypes.Tx(rawTx).Hash(),
+			TxIndex: uint32(idx),
+			Signer:  event.Signer,
+			Blobs:   make([]*blobindex.IndexedBlob, len(event.Namespaces)),
+		}
+		for blobIdx, namespace := range event.Namespaces {
+			start, err := builder.FindBlobStartingIndex(idx, blobIdx)
+			if err != nil {
+				return nil, fmt.Errorf("finding start of blob %d of tx %d: %w", blobIdx, idx, err)
+			}
+			length, err := builder.BlobShareLength(idx, blobIdx)
+			if err != nil {
+				return nil, fmt.Errorf("finding length of blob %d of tx %d: %w", blobIdx, idx, err)
+			}
+			indexed.Blobs[blobIdx] = &blobindex.IndexedBlob{
+				Namespace:       namespace,
+				Size_:           event.BlobSizes[blobIdx],
+				ShareCommitment: pfb.ShareCommitments[blobIdx],
+				ShareVersion:    pfb.ShareVersions[blobIdx],
+				StartShare:      uint64(start),
+				EndShare:        uint64(start + length),
+			}
+		}
+		payForBlobs = append(payForBlobs, indexed)
+	}
+	return payForBlobs, nil
+}
+
+// findPayForBlobsEvent returns the EventPayForBlobs among the events of a tx.
+func findPayForBlobsEvent(events []abci.Event, eventType string) (*blobtypes.EventPayForBlobs, bool) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, false
+		}
+		pfbEvent, ok := msg.(*blobtypes.EventPayForBlobs)
+		return pfbEvent, ok
+	}
+	return nil, false
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// finalizedBlock holds what the node-local statistics and the blob index need
+// from the block that is being finalized. They are recorded once the block is
+// committed.
+type finalizedBlock struct {
+	height  int64
+	txs     [][]byte
+	results []*abci.ExecTxResult
+	// maxSquareBytes is the size in bytes of the max square set by governance
+	// when the block was finalized.
+	maxSquareBytes uint64
+	// square is the data square of the block, shared by everything that needs
+	// it while the block is finalized and once it's committed.
+	square *blockSquare
+}
+
+// blockSquare builds the data square of a block on first use, so that it is
+// built at most once per block and only if it's needed.
+type blockSquare struct {
+	txs           [][]byte
+	maxSquareSize int
+
+	once    sync.Once
+	builder *square.Builder
+	err     error
+}
+
+// build returns the square builder holding the transactions of the block.
+func (s *blockSquare) build() (*square.Builder, error) {
+	s.once.Do(func() {
+		s.builder, s.err = square.NewBuilder(s.maxSquareSize, appconsts.SubtreeRootThreshold, s.txs...)
+	})
+	return s.builder, s.err
+}
+
+// newFinalizedBlock returns the finalized block for the FinalizeBlock request,
+// reading the params from the finalize block state. The results are set once
+// the block is executed.
+func (app *App) newFinalizedBlock(ctx sdk.Context, req *abci.RequestFinalizeBlock) *finalizedBlock {
+	govMaxSquareSize := app.BlobKeeper.GetParams(ctx).GovMaxSquareSize
+	return &finalizedBlock{
+		height:         req.Height,
+		txs:            req.Txs,
+		maxSquareBytes: govMaxSquareSize * govMaxSquareSize * share.ShareSize,
+		square: &blockSquare{
+			txs:           req.Txs,
+			maxSquareSize: app.MaxEffectiveSquareSize(ctx),
+		},
+	}
+}
+
+// recordCommittedBlock records the node-local statistics and indexes the PFBs
+// of the block that was just committed. This happens in the background so that
+// it doesn't delay consensus, in the order in which the blocks were committed.
+func (app *App) recordCommittedBlock() {
+	block := app.finalizedBlock
+	app.finalizedBlock = nil
+	if block == nil {
+		return
+	}
+
+	previous := app.committedBlockRecorded
+	done := make(chan struct{})
+	app.committedBlockRecorded = done
+	go func() {
+		defer close(done)
+		if previous != nil {
+			<-previous
+		}
+		app.gasPriceHistory.AddBlock(block.height, block.txs, block.results, block.maxSquareBytes)
+
+		payForBlobs, err := app.indexPayForBlobs(block)
+		if err != nil {
+			app.Logger().Error("failed to index the PFBs of the block", "height", block.height, "err", err)
+			return
+		}
+		app.blobIndex.AddBlock(block.height, payForBlobs)
+	}()
+}
+
+// WaitForCommittedBlocks blocks until the node-local statistics and the PFBs
+// of all committed blocks have been recorded. It must not be called
+// concurrently with Commit.
+func (app *App) WaitForCommittedBlocks() {
+	if app.committedBlockRecorded != nil {
+		<-app.committedBlockRecorded
+	}
+}
+
+// BlobIndex returns the index of the PFBs of the recent committed blocks served
+// by the blob index query service.
+func (app *App) BlobIndex() *blobindex.Index {
+	return app.blobIndex
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	square "github.com/celestiaorg/go-square/v3"
 	"github.com/celestiaorg/go-square/v3/tx"
@@ -14,25 +16,45 @@ import (
 // FilteredSquareBuilder filters txs and blobs using a copy of the state and tx validity
 // rules before adding it the square.
 type FilteredSquareBuilder struct {
-	handler  sdk.AnteHandler
-	txConfig client.TxConfig
-	builder  *square.Builder
+	handler       sdk.AnteHandler
+	txConfig      client.TxConfig
+	builder       *square.Builder
+	maxSquareSize int
+	// namespaceQuota limits the shares of the blobs of each namespace
+	namespaceQuota *namespaceQuota
+	// policy orders the transactions before they are added to the square
+	policy ProposalOrderingPolicy
+	// report records which transactions were added to the square by Fill
+	report *proposal.ProposalReport
 }
 
+// NewFilteredSquareBuilder returns a FilteredSquareBuilder that orders the
+// transactions with the given policy. A nil policy keeps the mempool order.
+// maxNamespaceShares is the maximum number of shares the blobs of a namespace
+// may occupy, zero means no limit.
 func NewFilteredSquareBuilder(
 	handler sdk.AnteHandler,
 	txConfig client.TxConfig,
 	maxSquareSize,
-	subtreeRootThreshold int,
+	subtreeRootThreshold,
+	maxNamespaceShares int,
+	policy ProposalOrderingPolicy,
 ) (*FilteredSquareBuilder, error) {
 	builder, err := square.NewBuilder(maxSquareSize, subtreeRootThreshold)
 	if err != nil {
 		return nil, err
 	}
+	if policy == nil {
+		policy = MempoolOrderingPolicy{}
+	}
 	return &FilteredSquareBuilder{
-		handler:  handler,
-		txConfig: txConfig,
-		builder:  builder,
+		handler:        handler,
+		txConfig:       txConfig,
+		builder:        builder,
+		maxSquareSize:  maxSquareSize,
+		namespaceQuota: newNamespaceQuota(maxNamespaceShares),
+		policy:         policy,
+		report:         &proposal.ProposalReport{},
 	}, nil
 }
 
@@ -44,26 +66,35 @@ func (fsb *FilteredSquareBuilder) Builder() *square.Builder {
 	return fsb.builder
 }
 
+// Report returns the report of the proposal at the given height whose
+// transactions were added by Fill and whose square was built from them.
+func (fsb *FilteredSquareBuilder) Report(height int64, dataSquare square.Square) *proposal.ProposalReport {
+	fsb.report.Height = height
+	fsb.report.SquareSize = uint64(dataSquare.Size())
+	fsb.report.SharesUsed = 0
+	for _, sh := range dataSquare {
+		if !sh.IsPadding() {
+			fsb.report.SharesUsed++
+		}
+	}
+	return fsb.report
+}
+
 func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 	logger := ctx.Logger().With("app/filtered-square-builder")
 
 	// note that there is an additional filter step for tx size of raw txs here
-	normalTxs, blobTxs := separateTxs(fsb.txConfig, txs)
+	normalTxs, blobTxs := fsb.decodeTxs(logger, txs)
 
 	var (
 		nonPFBMessageCount = 0
 		pfbMessageCount    = 0
-		dec                = fsb.txConfig.TxDecoder()
-		n                  = 0
-		m                  = 0
+		kept               = make([][]byte, 0, len(normalTxs)+len(blobTxs))
+		keptBlobTxs        = make([]*tx.BlobTx, 0, len(blobTxs))
 	)
 
-	for _, tx := range normalTxs {
-		sdkTx, err := dec(tx)
-		if err != nil {
-			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
-			continue
-		}
+	for _, ptx := range fsb.order(normalTxs) {
+		tx, sdkTx := ptx.RawTx, ptx.Tx
 
 		// Set the tx size on the context before calling the AnteHandler
 		ctx = ctx.WithTxBytes(tx)
@@ -71,14 +102,17 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		msgTypes := msgTypes(sdkTx)
 		if nonPFBMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
 			logger.Debug("skipping tx because the max non PFB message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_MAX_NON_PFB_MESSAGES, nil)
 			continue
 		}
 
 		if !fsb.builder.AppendTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_SQUARE_FULL, nil)
 			continue
 		}
 
+		var err error
 		ctx, err = fsb.handler(ctx, sdkTx, false)
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
@@ -91,6 +125,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"msgs", msgTypes,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_ANTE_FAILED, err)
 			err = fsb.builder.RevertLastTx()
 			if err != nil {
 				logger.Error("reverting last transaction", "error", err)
@@ -99,30 +134,35 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		}
 
 		nonPFBMessageCount += len(sdkTx.GetMsgs())
-		normalTxs[n] = tx
-		n++
+		kept = append(kept, tx)
+		fsb.include(ptx)
 	}
 
-	for _, tx := range blobTxs {
-		sdkTx, err := dec(tx.Tx)
-		if err != nil {
-			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
-			continue
-		}
+	for _, ptx := range fsb.order(blobTxs) {
+		tx, sdkTx := ptx.BlobTx, ptx.Tx
 
 		// Set the tx size on the context before calling the AnteHandler
 		ctx = ctx.WithTxBytes(tx.Tx)
 
 		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
 			logger.Debug("skipping blob tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_MAX_PFB_MESSAGES, nil)
+			continue
+		}
+
+		if !fsb.namespaceQuota.fits(tx) {
+			logger.Debug("skipping blob tx because it exceeds the share quota of a namespace", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_NAMESPACE_QUOTA, nil)
 			continue
 		}
 
 		if !fsb.builder.AppendBlobTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_SQUARE_FULL, nil)
 			continue
 		}
 
+		var err error
 		ctx, err = fsb.handler(ctx, sdkTx, false)
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
@@ -132,6 +172,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_ANTE_FAILED, err)
 			err = fsb.builder.RevertLastBlobTx()
 			if err != nil {
 				logger.Error("reverting last blob transaction failed", "error", err)
@@ -140,14 +181,105 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		}
 
 		pfbMessageCount += len(sdkTx.GetMsgs())
-		blobTxs[m] = tx
-		m++
+		fsb.namespaceQuota.add(tx)
+		keptBlobTxs = append(keptBlobTxs, tx)
+		fsb.include(ptx)
+	}
+
+	fsb.report.NonPfbMessageCount = uint64(nonPFBMessageCount)
+	fsb.report.PfbMessageCount = uint64(pfbMessageCount)
+	return append(kept, encodeBlobTxs(keptBlobTxs)...)
+}
+
+// decodeTxs decodes raw tendermint txs into normal and blob proposal txs.
+// Transactions that are too large or can't be decoded are skipped.
+func (fsb *FilteredSquareBuilder) decodeTxs(logger log.Logger, txs [][]byte) (normalTxs, blobTxs []*ProposalTx) {
+	dec := fsb.txConfig.TxDecoder()
+	normalTxs = make([]*ProposalTx, 0, len(txs))
+	blobTxs = make([]*ProposalTx, 0, len(txs))
+	for _, rawTx := range txs {
+		hash := coretypes.Tx(rawTx).Hash()
+		// this check in theory shouldn't get hit, as txs should be filtered
+		// in CheckTx. However in tests we're inserting too large of txs
+		// therefore also filter here.
+		if len(rawTx) > appconsts.MaxTxSize {
+			fsb.skip(hash, proposal.SkipReason_SKIP_REASON_TX_TOO_LARGE, nil)
+			continue
+		}
+
+		blobTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
+		if isBlob {
+			if err != nil {
+				panic(err)
+			}
+			sdkTx, err := dec(blobTx.Tx)
+			if err != nil {
+				logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(blobTx.Tx).Hash()), "error", err)
+				fsb.skip(hash, proposal.SkipReason_SKIP_REASON_DECODE_FAILED, err)
+				continue
+			}
+			blobTxs = append(blobTxs, newProposalTx(hash, blobTx.Tx, blobTx, sdkTx))
+			continue
+		}
+
+		sdkTx, err := dec(rawTx)
+		if err != nil {
+			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(hash), "error", err)
+			fsb.skip(hash, proposal.SkipReason_SKIP_REASON_DECODE_FAILED, err)
+			continue
+		}
+		normalTxs = append(normalTxs, newProposalTx(hash, rawTx, nil, sdkTx))
+	}
+	return normalTxs, blobTxs
+}
+
+// order orders the transactions with the policy and reports the transactions
+// dropped by it.
+func (fsb *FilteredSquareBuilder) order(txs []*ProposalTx) []*ProposalTx {
+	ordered := fsb.policy.Order(txs, fsb.availableShares())
+	if len(ordered) == len(txs) {
+		return ordered
+	}
+	kept := make(map[*ProposalTx]bool, len(ordered))
+	for _, ptx := range ordered {
+		kept[ptx] = true
+	}
+	for _, ptx := range txs {
+		if !kept[ptx] {
+			fsb.skip(ptx.Hash, proposal.SkipReason_SKIP_REASON_ORDERING_POLICY, nil)
+		}
+	}
+	return ordered
+}
+
+// include reports that the transaction was added to the square.
+func (fsb *FilteredSquareBuilder) include(ptx *ProposalTx) {
+	fsb.report.IncludedTxs = append(fsb.report.IncludedTxs, &proposal.IncludedTx{
+		TxHash:   ptx.Hash,
+		Size_:    uint64(ptx.Size),
+		IsBlobTx: ptx.BlobTx != nil,
+	})
+	fsb.report.BytesUsed += uint64(ptx.Size)
+}
+
+// skip reports that the transaction was not added to the square. Only the
+// first MaxSkippedTxsPerReport skipped transactions are kept in the report so
+// that a full mempool doesn't make reports grow unbounded.
+func (fsb *FilteredSquareBuilder) skip(hash []byte, reason proposal.SkipReason, err error) {
+	fsb.report.SkippedTxCount++
+	if len(fsb.report.SkippedTxs) >= proposal.MaxSkippedTxsPerReport {
+		return
+	}
+	skipped := &proposal.SkippedTx{TxHash: hash, Reason: reason}
+	if err != nil {
+		skipped.Error = err.Error()
 	}
+	fsb.report.SkippedTxs = append(fsb.report.SkippedTxs, skipped)
+}
 
-	kept := make([][]byte, 0, m+n)
-	kept = append(kept, normalTxs[:n]...)
-	kept = append(kept, encodeBlobTxs(blobTxs[:m])...)
-	return kept
+// availableShares returns the number of shares left in the square.
+func (fsb *FilteredSquareBuilder) availableShares() int {
+	return fsb.maxSquareSize*fsb.maxSquareSize - fsb.builder.CurrentSize()
 }
 
 func msgTypes(sdkTx sdk.Tx) []string {
@@ -170,28 +302,3 @@ func encodeBlobTxs(blobTxs []*tx.BlobTx) [][]byte {
 	}
 	return txs
 }
-
-// separateTxs decodes raw tendermint txs into normal and blob txs.
-func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
-	normalTxs := make([][]byte, 0, len(rawTxs))
-	blobTxs := make([]*tx.BlobTx, 0, len(rawTxs))
-	for _, rawTx := range rawTxs {
-		// this check in theory shouldn't get hit, as txs should be filtered
-		// in CheckTx. However in tests we're inserting too large of txs
-		// therefore also filter here.
-		if len(rawTx) > appconsts.MaxTxSize {
-			continue
-		}
-
-		bTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
-		if isBlob {
-			if err != nil {
-				panic(err)
-			}
-			blobTxs = append(blobTxs, bTx)
-		} else {
-			normalTxs = append(normalTxs, rawTx)
-		}
-	}
-	return normalTxs, blobTxs
-}
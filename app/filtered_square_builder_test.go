@@ -0,0 +1,119 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/proposal"
+	"github.com/celestiaorg/celestia-app/v6/app/params"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/go-square/v3/tx"
+	coretypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredSquareBuilderReport(t *testing.T) {
+	enc := encoding.MakeConfig(ModuleEncodingRegisters...)
+	address := func(b byte) string {
+		return sdk.MustBech32ifyAddressBytes(params.Bech32PrefixAccAddr, bytes.Repeat([]byte{b}, 20))
+	}
+	newTx := func(memo string, msgs ...sdk.Msg) []byte {
+		builder := enc.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetMemo(memo)
+		rawTx, err := enc.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return rawTx
+	}
+	newSendTx := func(from byte, memo string) []byte {
+		coins := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(1)))
+		return newTx(memo, banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(address(from)), sdk.MustAccAddressFromBech32(address(1)), coins))
+	}
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(address(4), appconsts.Version, blob)
+	require.NoError(t, err)
+	blobTx, err := tx.MarshalBlobTx(newTx("", pfb), blob)
+	require.NoError(t, err)
+
+	var (
+		validTx    = newSendTx(2, "")
+		cappedTx   = newSendTx(2, "capped")
+		rejectedTx = newSendTx(3, "reject")
+		invalidTx  = []byte("not a transaction")
+		tooLargeTx = make([]byte, appconsts.MaxTxSize+1)
+	)
+	handler := func(ctx sdk.Context, sdkTx sdk.Tx, _ bool) (sdk.Context, error) {
+		if memoTx, ok := sdkTx.(sdk.TxWithMemo); ok && memoTx.GetMemo() == "reject" {
+			return ctx, errors.New("rejected")
+		}
+		return ctx, nil
+	}
+	fsb, err := NewFilteredSquareBuilder(handler, enc.TxConfig, 64, appconsts.SubtreeRootThreshold, 0, NewSignerCapOrderingPolicy(MempoolOrderingPolicy{}, 1))
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	kept := fsb.Fill(ctx, [][]byte{validTx, cappedTx, rejectedTx, invalidTx, tooLargeTx, blobTx})
+	require.Equal(t, [][]byte{validTx, blobTx}, kept)
+	dataSquare, err := fsb.Build()
+	require.NoError(t, err)
+
+	report := fsb.Report(5, dataSquare)
+	hash := func(rawTx []byte) []byte { return coretypes.Tx(rawTx).Hash() }
+	assert.Equal(t, int64(5), report.Height)
+	assert.Equal(t, uint64(dataSquare.Size()), report.SquareSize)
+	assert.Positive(t, report.SharesUsed)
+	assert.Less(t, report.SharesUsed, uint64(len(dataSquare)))
+	assert.Equal(t, uint64(1), report.NonPfbMessageCount)
+	assert.Equal(t, uint64(1), report.PfbMessageCount)
+	assert.Equal(t, []*proposal.IncludedTx{
+		{TxHash: hash(validTx), Size_: uint64(len(validTx))},
+		{TxHash: hash(blobTx), Size_: uint64(len(newTx("", pfb)) + blob.DataLen()), IsBlobTx: true},
+	}, report.IncludedTxs)
+	assert.Equal(t, report.IncludedTxs[0].Size_+report.IncludedTxs[1].Size_, report.BytesUsed)
+	assert.Equal(t, []*proposal.SkippedTx{
+		{TxHash: hash(invalidTx), Reason: proposal.SkipReason_SKIP_REASON_DECODE_FAILED, Error: report.SkippedTxs[0].Error},
+		{TxHash: hash(tooLargeTx), Reason: proposal.SkipReason_SKIP_REASON_TX_TOO_LARGE},
+		{TxHash: hash(cappedTx), Reason: proposal.SkipReason_SKIP_REASON_ORDERING_POLICY},
+		{TxHash: hash(rejectedTx), Reason: proposal.SkipReason_SKIP_REASON_ANTE_FAILED, Error: "rejected"},
+	}, report.SkippedTxs)
+	assert.Equal(t, uint64(4), report.SkippedTxCount)
+	assert.NotEmpty(t, report.SkippedTxs[0].Error)
+}
+
+// TestFilteredSquareBuilderReportSkippedTxsLimit ensures that reports keep a
+// bounded number of skipped transactions while counting all of them.
+func TestFilteredSquareBuilderReportSkippedTxsLimit(t *testing.T) {
+	enc := encoding.MakeConfig(ModuleEncodingRegisters...)
+	handler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	fsb, err := NewFilteredSquareBuilder(handler, enc.TxConfig, 64, appconsts.SubtreeRootThreshold, 0, MempoolOrderingPolicy{})
+	require.NoError(t, err)
+
+	numTxs := proposal.MaxSkippedTxsPerReport + 50
+	invalidTxs := make([][]byte, numTxs)
+	for i := range invalidTxs {
+		invalidTxs[i] = []byte(fmt.Sprintf("not a transaction %d", i))
+	}
+	kept := fsb.Fill(sdk.Context{}.WithLogger(log.NewNopLogger()), invalidTxs)
+	require.Empty(t, kept)
+	dataSquare, err := fsb.Build()
+	require.NoError(t, err)
+
+	report := fsb.Report(1, dataSquare)
+	require.Len(t, report.SkippedTxs, proposal.MaxSkippedTxsPerReport)
+	require.Equal(t, uint64(numTxs), report.SkippedTxCount)
+	require.Equal(t, coretypes.Tx(invalidTxs[0]).Hash(), report.SkippedTxs[0].TxHash)
+}
@@ -0,0 +1,102 @@
+package blobindex
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+)
+
+const (
+	// FlagBlobIndexBlocks is the flag to set the number of recent blocks whose
+	// PFBs are kept in the blob index of the node.
+	FlagBlobIndexBlocks = "blob-index-blocks"
+	// DefaultBlobIndexBlocks is the default number of recent blocks whose PFBs
+	// are kept in the blob index of the node.
+	DefaultBlobIndexBlocks = 100
+)
+
+// Index keeps the PFBs of a rolling window of the most recent committed blocks
+// so that they can be queried without decoding the blocks. It is best-effort
+// and not part of the state machine: it lives in the memory of the node, is
+// empty after a restart and is filled again as the node commits blocks. It is
+// safe for concurrent use.
+type Index struct {
+	windowSize int
+
+	mu     sync.RWMutex
+	blocks []indexedBlock
+}
+
+type indexedBlock struct {
+	height      int64
+	payForBlobs []*IndexedPayForBlobs
+}
+
+// NewIndex creates a blob index that keeps the PFBs of the last windowSize
+// blocks.
+func NewIndex(windowSize int) *Index {
+	if windowSize <= 0 {
+		windowSize = DefaultBlobIndexBlocks
+	}
+	return &Index{
+		windowSize: windowSize,
+		blocks:     make([]indexedBlock, 0, windowSize),
+	}
+}
+
+// AddBlock adds the PFBs of the block at the given height to the index,
+// evicting the oldest block if the window is full.
+// A block whose height isn't above the last added one resets the index, as
+// it means the node is replaying blocks.
+func (i *Index) AddBlock(height int64, payForBlobs []*IndexedPayForBlobs) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(i.blocks) > 0 && i.blocks[len(i.blocks)-1].height >= height {
+		i.blocks = i.blocks[:0]
+	}
+	if len(i.blocks) == i.windowSize {
+		// shift in place to reuse the backing array.
+		copy(i.blocks, i.blocks[1:])
+		i.blocks = i.blocks[:len(i.blocks)-1]
+	}
+	i.blocks = append(i.blocks, indexedBlock{height: height, payForBlobs: payForBlobs})
+}
+
+// ByHeight returns the PFBs of the block at the given height in the order
+// they were included. It returns false if the block is not in the index.
+func (i *Index) ByHeight(height int64) ([]*IndexedPayForBlobs, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	idx, found := slices.BinarySearchFunc(i.blocks, height, func(b indexedBlock, height int64) int {
+		switch {
+		case b.height < height:
+			return -1
+		case b.height > height:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if !found {
+		return nil, false
+	}
+	return slices.Clone(i.blocks[idx].payForBlobs), true
+}
+
+// ByNamespace returns the PFBs that pay for at least one blob in namespace in
+// ascending height order.
+func (i *Index) ByNamespace(namespace []byte) []*IndexedPayForBlobs {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	var payForBlobs []*IndexedPayForBlobs
+	for _, block := range i.blocks {
+		for _, pfb := range block.payForBlobs {
+			if slices.ContainsFunc(pfb.Blobs, func(blob *IndexedBlob) bool {
+				return bytes.Equal(blob.Namespace, namespace)
+			}) {
+				payForBlobs = append(payForBlobs, pfb)
+			}
+		}
+	}
+	return payForBlobs
+}
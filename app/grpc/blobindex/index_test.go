@@ -0,0 +1,72 @@
+package blobindex_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	"github.com/stretchr/testify/require"
+)
+
+func newIndexedPayForBlobs(height int64, txIndex uint32, namespaces ...[]byte) *blobindex.IndexedPayForBlobs {
+	pfb := &blobindex.IndexedPayForBlobs{Height: height, TxIndex: txIndex}
+	for _, namespace := range namespaces {
+		pfb.Blobs = append(pfb.Blobs, &blobindex.IndexedBlob{Namespace: namespace})
+	}
+	return pfb
+}
+
+func TestBlobIndex(t *testing.T) {
+	nsA, nsB := []byte("namespace-a"), []byte("namespace-b")
+
+	t.Run("evicts the oldest block when full", func(t *testing.T) {
+		index := blobindex.NewIndex(2)
+		for height := int64(1); height <= 3; height++ {
+			index.AddBlock(height, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(height, 0, nsA)})
+		}
+		_, found := index.ByHeight(1)
+		require.False(t, found)
+		for _, height := range []int64{2, 3} {
+			pfbs, found := index.ByHeight(height)
+			require.True(t, found)
+			require.Len(t, pfbs, 1)
+			require.Equal(t, height, pfbs[0].Height)
+		}
+	})
+
+	t.Run("resets on a height that isn't increasing", func(t *testing.T) {
+		index := blobindex.NewIndex(10)
+		index.AddBlock(5, nil)
+		index.AddBlock(6, nil)
+		index.AddBlock(6, nil)
+		_, found := index.ByHeight(5)
+		require.False(t, found)
+		_, found = index.ByHeight(6)
+		require.True(t, found)
+	})
+
+	t.Run("keeps blocks without PFBs", func(t *testing.T) {
+		index := blobindex.NewIndex(10)
+		index.AddBlock(1, nil)
+		pfbs, found := index.ByHeight(1)
+		require.True(t, found)
+		require.Empty(t, pfbs)
+	})
+
+	t.Run("filters by namespace in height order", func(t *testing.T) {
+		index := blobindex.NewIndex(10)
+		index.AddBlock(1, []*blobindex.IndexedPayForBlobs{
+			newIndexedPayForBlobs(1, 0, nsA),
+			newIndexedPayForBlobs(1, 1, nsB),
+		})
+		index.AddBlock(2, []*blobindex.IndexedPayForBlobs{
+			newIndexedPayForBlobs(2, 0, nsB, nsA),
+		})
+
+		pfbs := index.ByNamespace(nsA)
+		require.Len(t, pfbs, 2)
+		require.Equal(t, int64(1), pfbs[0].Height)
+		require.Equal(t, int64(2), pfbs[1].Height)
+		require.Len(t, index.ByNamespace(nsB), 2)
+		require.Empty(t, index.ByNamespace([]byte("namespace-c")))
+	})
+}
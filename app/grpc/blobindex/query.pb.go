@@ -0,0 +1,1978 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blob_index/query.proto
+
+package blobindex
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlobsByHeightRequest the request to get the PFBs of a block.
+type BlobsByHeightRequest struct {
+	Height     int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BlobsByHeightRequest) Reset()         { *m = BlobsByHeightRequest{} }
+func (m *BlobsByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobsByHeightRequest) ProtoMessage()    {}
+func (*BlobsByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{0}
+}
+func (m *BlobsByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByHeightRequest.Merge(m, src)
+}
+func (m *BlobsByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByHeightRequest proto.InternalMessageInfo
+
+func (m *BlobsByHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlobsByHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BlobsByHeightResponse the response of the blobs by height query.
+type BlobsByHeightResponse struct {
+	// pay_for_blobs are the PFBs in the order they were included in the block.
+	// The pagination key of a PFB is its height and tx index.
+	PayForBlobs []*IndexedPayForBlobs `protobuf:"bytes,1,rep,name=pay_for_blobs,json=payForBlobs,proto3" json:"pay_for_blobs,omitempty"`
+	Pagination  *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BlobsByHeightResponse) Reset()         { *m = BlobsByHeightResponse{} }
+func (m *BlobsByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobsByHeightResponse) ProtoMessage()    {}
+func (*BlobsByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{1}
+}
+func (m *BlobsByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByHeightResponse.Merge(m, src)
+}
+func (m *BlobsByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByHeightResponse proto.InternalMessageInfo
+
+func (m *BlobsByHeightResponse) GetPayForBlobs() []*IndexedPayForBlobs {
+	if m != nil {
+		return m.PayForBlobs
+	}
+	return nil
+}
+
+func (m *BlobsByHeightResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BlobsByNamespaceRequest the request to get the PFBs that pay for a blob in a
+// namespace.
+type BlobsByNamespaceRequest struct {
+	// namespace is the full namespace (version and id) of the blobs.
+	Namespace  []byte             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BlobsByNamespaceRequest) Reset()         { *m = BlobsByNamespaceRequest{} }
+func (m *BlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceRequest) ProtoMessage()    {}
+func (*BlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{2}
+}
+func (m *BlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *BlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BlobsByNamespaceResponse the response of the blobs by namespace query.
+type BlobsByNamespaceResponse struct {
+	// pay_for_blobs are the PFBs in ascending height order. The pagination key
+	// of a PFB is its height and tx index, so paging neither skips nor repeats
+	// PFBs while blocks enter and leave the index.
+	PayForBlobs []*IndexedPayForBlobs `protobuf:"bytes,1,rep,name=pay_for_blobs,json=payForBlobs,proto3" json:"pay_for_blobs,omitempty"`
+	Pagination  *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BlobsByNamespaceResponse) Reset()         { *m = BlobsByNamespaceResponse{} }
+func (m *BlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceResponse) ProtoMessage()    {}
+func (*BlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{3}
+}
+func (m *BlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *BlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceResponse) GetPayForBlobs() []*IndexedPayForBlobs {
+	if m != nil {
+		return m.PayForBlobs
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// IndexedPayForBlobs describes a successfully executed MsgPayForBlobs and the
+// blobs it paid for.
+type IndexedPayForBlobs struct {
+	// height is the height of the block that included the PFB.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash is the hash of the transaction that contains the PFB.
+	TxHash []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tx_index is the index of the transaction in the block.
+	TxIndex uint32         `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Signer  string         `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	Blobs   []*IndexedBlob `protobuf:"bytes,5,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (m *IndexedPayForBlobs) Reset()         { *m = IndexedPayForBlobs{} }
+func (m *IndexedPayForBlobs) String() string { return proto.CompactTextString(m) }
+func (*IndexedPayForBlobs) ProtoMessage()    {}
+func (*IndexedPayForBlobs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{4}
+}
+func (m *IndexedPayForBlobs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedPayForBlobs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedPayForBlobs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedPayForBlobs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedPayForBlobs.Merge(m, src)
+}
+func (m *IndexedPayForBlobs) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedPayForBlobs) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedPayForBlobs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedPayForBlobs proto.InternalMessageInfo
+
+func (m *IndexedPayForBlobs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IndexedPayForBlobs) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IndexedPayForBlobs) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *IndexedPayForBlobs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *IndexedPayForBlobs) GetBlobs() []*IndexedBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+// IndexedBlob describes a blob paid for by a MsgPayForBlobs.
+type IndexedBlob struct {
+	Namespace       []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Size_           uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ShareCommitment []byte `protobuf:"bytes,3,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	ShareVersion    uint32 `protobuf:"varint,4,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// start_share is the index of the first share of the blob in the original
+	// data square.
+	StartShare uint64 `protobuf:"varint,5,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	// end_share is the index after the last share of the blob in the original
+	// data square.
+	EndShare uint64 `protobuf:"varint,6,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+}
+
+func (m *IndexedBlob) Reset()         { *m = IndexedBlob{} }
+func (m *IndexedBlob) String() string { return proto.CompactTextString(m) }
+func (*IndexedBlob) ProtoMessage()    {}
+func (*IndexedBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfb28f04027eadd1, []int{5}
+}
+func (m *IndexedBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedBlob.Merge(m, src)
+}
+func (m *IndexedBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedBlob proto.InternalMessageInfo
+
+func (m *IndexedBlob) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *IndexedBlob) GetSize_() uint32 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *IndexedBlob) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetStartShare() uint64 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetEndShare() uint64 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlobsByHeightRequest)(nil), "celestia.core.v1.blob_index.BlobsByHeightRequest")
+	proto.RegisterType((*BlobsByHeightResponse)(nil), "celestia.core.v1.blob_index.BlobsByHeightResponse")
+	proto.RegisterType((*BlobsByNamespaceRequest)(nil), "celestia.core.v1.blob_index.BlobsByNamespaceRequest")
+	proto.RegisterType((*BlobsByNamespaceResponse)(nil), "celestia.core.v1.blob_index.BlobsByNamespaceResponse")
+	proto.RegisterType((*IndexedPayForBlobs)(nil), "celestia.core.v1.blob_index.IndexedPayForBlobs")
+	proto.RegisterType((*IndexedBlob)(nil), "celestia.core.v1.blob_index.IndexedBlob")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/blob_index/query.proto", fileDescriptor_cfb28f04027eadd1)
+}
+
+var fileDescriptor_cfb28f04027eadd1 = []byte{
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xee, 0xb4, 0x49, 0xda, 0xbe, 0x24, 0x58, 0x06, 0xb5, 0x6b, 0x5a, 0x62, 0x88, 0xd0, 0xae,
+	0xa2, 0x33, 0x24, 0x56, 0x8f, 0x1e, 0x2a, 0xd4, 0x7a, 0x91, 0xba, 0x05, 0x0f, 0x5e, 0xc2, 0xec,
+	0x66, 0xdc, 0x5d, 0x48, 0x76, 0xb6, 0x3b, 0xd3, 0x90, 0x28, 0x22, 0xf8, 0x0b, 0x04, 0xff, 0x86,
+	0xe0, 0x49, 0xe8, 0x4f, 0xf0, 0x22, 0x14, 0xbc, 0x78, 0x94, 0xd6, 0x83, 0x3f, 0x43, 0x76, 0x66,
+	0x9b, 0xb4, 0x69, 0x4d, 0x5b, 0xf0, 0xe2, 0x21, 0xd9, 0x9d, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xbe,
+	0x7d, 0x33, 0xb0, 0xea, 0xf1, 0x0e, 0x97, 0x2a, 0x64, 0xd4, 0x13, 0x09, 0xa7, 0xbd, 0x06, 0x75,
+	0x3b, 0xc2, 0x6d, 0x85, 0x51, 0x9b, 0xf7, 0xe9, 0xce, 0x2e, 0x4f, 0x06, 0x24, 0x4e, 0x84, 0x12,
+	0x78, 0xe9, 0x28, 0x91, 0xa4, 0x89, 0xa4, 0xd7, 0x20, 0xa3, 0xc4, 0xca, 0x1d, 0x4f, 0xc8, 0xae,
+	0x90, 0xd4, 0x65, 0x92, 0x9b, 0x2a, 0xda, 0x6b, 0xb8, 0x5c, 0xb1, 0x06, 0x8d, 0x99, 0x1f, 0x46,
+	0x4c, 0x85, 0x22, 0x32, 0x44, 0x95, 0x65, 0x5f, 0x08, 0xbf, 0xc3, 0x29, 0x8b, 0x43, 0xca, 0xa2,
+	0x48, 0x28, 0x1d, 0x94, 0x26, 0x5a, 0xef, 0xc1, 0xd5, 0xf5, 0x8e, 0x70, 0xe5, 0xfa, 0x60, 0x93,
+	0x87, 0x7e, 0xa0, 0x1c, 0xbe, 0xb3, 0xcb, 0xa5, 0xc2, 0xd7, 0xa1, 0x10, 0x68, 0xc0, 0x42, 0x35,
+	0x64, 0xcf, 0x38, 0xd9, 0x0a, 0x6f, 0x00, 0x8c, 0x3a, 0x58, 0xd3, 0x35, 0x64, 0x17, 0x9b, 0x2b,
+	0xc4, 0xc8, 0x21, 0xa9, 0x1c, 0x62, 0x36, 0x91, 0xc9, 0x21, 0x5b, 0xcc, 0xe7, 0x19, 0xa7, 0x73,
+	0xac, 0xb2, 0xfe, 0x05, 0xc1, 0xb5, 0xb1, 0xc6, 0x32, 0x16, 0x91, 0xe4, 0x78, 0x1b, 0xca, 0x31,
+	0x1b, 0xb4, 0x5e, 0x89, 0xa4, 0x95, 0xee, 0x58, 0x5a, 0xa8, 0x36, 0x63, 0x17, 0x9b, 0x94, 0x4c,
+	0x30, 0x84, 0x3c, 0x4d, 0xff, 0x79, 0x7b, 0x8b, 0x0d, 0x36, 0x44, 0xa2, 0x79, 0x9d, 0x62, 0x3c,
+	0x5a, 0xe0, 0x27, 0x67, 0xc8, 0x5e, 0x3d, 0x57, 0xb6, 0x51, 0x74, 0x42, 0xf7, 0x3b, 0x58, 0xcc,
+	0x64, 0x3f, 0x63, 0x5d, 0x2e, 0x63, 0xe6, 0x1d, 0x6d, 0x0f, 0x2f, 0xc3, 0x7c, 0x74, 0x84, 0x69,
+	0xd7, 0x4a, 0xce, 0x08, 0xf8, 0x67, 0xc6, 0xed, 0x21, 0xb0, 0x4e, 0x2b, 0xf8, 0x2f, 0xbc, 0xdb,
+	0x43, 0x80, 0x4f, 0x37, 0xfb, 0xeb, 0xa8, 0x2d, 0xc2, 0xac, 0xea, 0xb7, 0x02, 0x26, 0x03, 0xdd,
+	0xb4, 0xe4, 0x14, 0x54, 0x7f, 0x93, 0xc9, 0x00, 0xdf, 0x80, 0x39, 0xd5, 0x37, 0xe2, 0xad, 0x99,
+	0x1a, 0xb2, 0xcb, 0xce, 0xac, 0xea, 0x6b, 0xe2, 0x94, 0x4b, 0x86, 0x7e, 0xc4, 0x13, 0x2b, 0x57,
+	0x43, 0xf6, 0xbc, 0x93, 0xad, 0xf0, 0x23, 0xc8, 0x1b, 0x43, 0xf2, 0xda, 0x10, 0xfb, 0x22, 0x86,
+	0xa4, 0xea, 0x1c, 0x53, 0x56, 0xff, 0x86, 0xa0, 0x78, 0x0c, 0x3e, 0xe7, 0x5b, 0x63, 0xc8, 0xc9,
+	0xf0, 0x35, 0xd7, 0xb2, 0xcb, 0x8e, 0x7e, 0xc7, 0xb7, 0x61, 0x41, 0x06, 0x2c, 0xe1, 0x2d, 0x4f,
+	0x74, 0xbb, 0xa1, 0xea, 0xf2, 0x48, 0x69, 0xf1, 0x25, 0xe7, 0x8a, 0xc6, 0x1f, 0x0f, 0x61, 0x7c,
+	0x0b, 0xca, 0x26, 0xb5, 0xc7, 0x13, 0x99, 0x7a, 0x9e, 0xd3, 0x3c, 0x25, 0x0d, 0xbe, 0x30, 0x18,
+	0xbe, 0x09, 0x45, 0xa9, 0x58, 0xa2, 0x5a, 0x1a, 0xb5, 0xf2, 0x35, 0x64, 0xe7, 0x1c, 0xd0, 0xd0,
+	0x76, 0x8a, 0xe0, 0x25, 0x98, 0xe7, 0x51, 0x3b, 0x0b, 0x17, 0x74, 0x78, 0x8e, 0x47, 0x6d, 0x1d,
+	0x6c, 0xfe, 0x9e, 0x86, 0xfc, 0xf3, 0xf4, 0xab, 0xe1, 0x4f, 0x08, 0xca, 0x27, 0x0e, 0x22, 0x6e,
+	0x4c, 0x34, 0xe7, 0xac, 0xdb, 0xa2, 0xd2, 0xbc, 0x4c, 0x89, 0x99, 0x8c, 0xfa, 0xda, 0xfb, 0xef,
+	0xbf, 0x3e, 0x4e, 0x13, 0x7c, 0x97, 0x4e, 0xba, 0x12, 0xcd, 0x2c, 0xd0, 0x37, 0xe6, 0xf9, 0x16,
+	0x7f, 0x46, 0xb0, 0x30, 0x3e, 0xfe, 0x78, 0xed, 0x22, 0xed, 0xc7, 0xcf, 0x6b, 0xe5, 0xc1, 0x25,
+	0xab, 0x32, 0xdd, 0x44, 0xeb, 0xb6, 0xf1, 0xca, 0x44, 0xdd, 0xc3, 0x61, 0x58, 0xdf, 0xfa, 0x7a,
+	0x50, 0x45, 0xfb, 0x07, 0x55, 0xf4, 0xf3, 0xa0, 0x8a, 0x3e, 0x1c, 0x56, 0xa7, 0xf6, 0x0f, 0xab,
+	0x53, 0x3f, 0x0e, 0xab, 0x53, 0x2f, 0x1f, 0xfa, 0xa1, 0x0a, 0x76, 0x5d, 0xe2, 0x89, 0xee, 0x90,
+	0x4b, 0x24, 0xfe, 0xf0, 0xfd, 0x1e, 0x8b, 0x63, 0x9a, 0xfe, 0xfc, 0x24, 0xf6, 0x34, 0xb9, 0xe6,
+	0x76, 0x0b, 0xfa, 0xea, 0xbe, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x26, 0x14, 0x7f, 0x7f, 0x4c,
+	0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobsByHeight returns the PFBs included in a block kept in the node's blob
+	// index.
+	BlobsByHeight(ctx context.Context, in *BlobsByHeightRequest, opts ...grpc.CallOption) (*BlobsByHeightResponse, error)
+	// BlobsByNamespace returns the PFBs of the blocks kept in the node's blob
+	// index that pay for at least one blob in a namespace.
+	BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobsByHeight(ctx context.Context, in *BlobsByHeightRequest, opts ...grpc.CallOption) (*BlobsByHeightResponse, error) {
+	out := new(BlobsByHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_index.Query/BlobsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error) {
+	out := new(BlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_index.Query/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobsByHeight returns the PFBs included in a block kept in the node's blob
+	// index.
+	BlobsByHeight(context.Context, *BlobsByHeightRequest) (*BlobsByHeightResponse, error)
+	// BlobsByNamespace returns the PFBs of the blocks kept in the node's blob
+	// index that pay for at least one blob in a namespace.
+	BlobsByNamespace(context.Context, *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobsByHeight(ctx context.Context, req *BlobsByHeightRequest) (*BlobsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByHeight not implemented")
+}
+func (*UnimplementedQueryServer) BlobsByNamespace(ctx context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_index.Query/BlobsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobsByHeight(ctx, req.(*BlobsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_index.Query/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobsByNamespace(ctx, req.(*BlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blob_index.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByHeight",
+			Handler:    _Query_BlobsByHeight_Handler,
+		},
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _Query_BlobsByNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/blob_index/query.proto",
+}
+
+func (m *BlobsByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PayForBlobs) > 0 {
+		for iNdEx := len(m.PayForBlobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayForBlobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PayForBlobs) > 0 {
+		for iNdEx := len(m.PayForBlobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayForBlobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedPayForBlobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlobsByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PayForBlobs) > 0 {
+		for _, e := range m.PayForBlobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PayForBlobs) > 0 {
+		for _, e := range m.PayForBlobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexedPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexedBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ShareVersion))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovQuery(uint64(m.EndShare))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlobsByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayForBlobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayForBlobs = append(m.PayForBlobs, &IndexedPayForBlobs{})
+			if err := m.PayForBlobs[len(m.PayForBlobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayForBlobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayForBlobs = append(m.PayForBlobs, &IndexedPayForBlobs{})
+			if err := m.PayForBlobs[len(m.PayForBlobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedPayForBlobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedPayForBlobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &IndexedBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,136 @@
+package blobindex
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterQueryService registers the blob index query service on the gRPC
+// router.
+func RegisterQueryService(qrt gogogrpc.Server, index *Index) {
+	RegisterQueryServer(qrt, NewQueryServer(index))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob index query service's
+// GRPC-gateway routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	index *Index
+}
+
+func NewQueryServer(index *Index) QueryServer {
+	return &queryServer{index: index}
+}
+
+// BlobsByHeight implements the QueryServer.BlobsByHeight method.
+func (s *queryServer) BlobsByHeight(_ context.Context, req *BlobsByHeightRequest) (*BlobsByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.index == nil {
+		return nil, status.Error(codes.Unavailable, "blob index is not kept by this node")
+	}
+
+	payForBlobs, found := s.index.ByHeight(req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "block %d is not in the blob index", req.Height)
+	}
+	page, pageRes, err := paginate(payForBlobs, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobsByHeightResponse{PayForBlobs: page, Pagination: pageRes}, nil
+}
+
+// BlobsByNamespace implements the QueryServer.BlobsByNamespace method.
+func (s *queryServer) BlobsByNamespace(_ context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if len(req.Namespace) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "namespace must be specified")
+	}
+	if s.index == nil {
+		return nil, status.Error(codes.Unavailable, "blob index is not kept by this node")
+	}
+
+	page, pageRes, err := paginate(s.index.ByNamespace(req.Namespace), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobsByNamespaceResponse{PayForBlobs: page, Pagination: pageRes}, nil
+}
+
+// pageKeyLen is the length of a pagination key: the big endian encoded height
+// of a PFB followed by its big endian encoded tx index.
+const pageKeyLen = 12
+
+// paginate returns the page of PFBs described by pageReq. The PFBs must be in
+// ascending height and tx index order. It follows the semantics of
+// query.Paginate where the key of a PFB is its height and tx index, so that a
+// key stays valid while blocks enter and leave the index. A key whose PFB left
+// the index resumes at the next PFB that is still in it.
+func paginate(payForBlobs []*IndexedPayForBlobs, pageReq *query.PageRequest) ([]*IndexedPayForBlobs, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		payForBlobs = slices.Clone(payForBlobs)
+		slices.Reverse(payForBlobs)
+	}
+
+	total := uint64(len(payForBlobs))
+	start := min(pageReq.Offset, total)
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != pageKeyLen {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		height := int64(binary.BigEndian.Uint64(pageReq.Key))
+		txIndex := binary.BigEndian.Uint32(pageReq.Key[8:])
+		idx := slices.IndexFunc(payForBlobs, func(pfb *IndexedPayForBlobs) bool {
+			c := cmp.Or(cmp.Compare(pfb.Height, height), cmp.Compare(pfb.TxIndex, txIndex))
+			if pageReq.Reverse {
+				return c <= 0
+			}
+			return c >= 0
+		})
+		start = total
+		if idx >= 0 {
+			start = uint64(idx)
+		}
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := min(start+limit, total)
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		next := payForBlobs[end]
+		pageRes.NextKey = binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint64(nil, uint64(next.Height)), next.TxIndex)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+	return slices.Clone(payForBlobs[start:end]), pageRes, nil
+}
@@ -0,0 +1,151 @@
+package blobindex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlobsByHeightQuery(t *testing.T) {
+	ctx := context.Background()
+	_, err := blobindex.NewQueryServer(nil).BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{Height: 1})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	index := blobindex.NewIndex(10)
+	server := blobindex.NewQueryServer(index)
+	pfbs := make([]*blobindex.IndexedPayForBlobs, 5)
+	for i := range pfbs {
+		pfbs[i] = newIndexedPayForBlobs(1, uint32(i), []byte("namespace"))
+	}
+	index.AddBlock(1, pfbs)
+
+	_, err = server.BlobsByHeight(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{Height: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, pfbs, res.PayForBlobs)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// page through the PFBs two at a time.
+	var (
+		got     []*blobindex.IndexedPayForBlobs
+		nextKey []byte
+	)
+	for {
+		res, err := server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{
+			Height:     1,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.PayForBlobs), 2)
+		require.Equal(t, uint64(len(pfbs)), res.Pagination.Total)
+		got = append(got, res.PayForBlobs...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, pfbs, got)
+
+	res, err = server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{
+		Height:     1,
+		Pagination: &query.PageRequest{Offset: 3, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*blobindex.IndexedPayForBlobs{pfbs[1], pfbs[0]}, res.PayForBlobs)
+
+	_, err = server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{
+		Height:     1,
+		Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBlobsByNamespaceQuery(t *testing.T) {
+	ctx := context.Background()
+	index := blobindex.NewIndex(10)
+	server := blobindex.NewQueryServer(index)
+
+	nsA, nsB := []byte("namespace-a"), []byte("namespace-b")
+	index.AddBlock(1, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(1, 0, nsA)})
+	index.AddBlock(2, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(2, 0, nsB)})
+	index.AddBlock(3, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(3, 0, nsA, nsB)})
+
+	_, err := server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+		Namespace:  nsA,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PayForBlobs, 1)
+	require.Equal(t, int64(1), res.PayForBlobs[0].Height)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+		Namespace:  nsA,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PayForBlobs, 1)
+	require.Equal(t, int64(3), res.PayForBlobs[0].Height)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+// TestBlobsByNamespaceQueryMovingWindow ensures that paging neither skips nor
+// repeats PFBs while blocks enter and leave the index.
+func TestBlobsByNamespaceQueryMovingWindow(t *testing.T) {
+	ctx := context.Background()
+	index := blobindex.NewIndex(3)
+	server := blobindex.NewQueryServer(index)
+
+	ns := []byte("namespace")
+	for height := int64(1); height <= 3; height++ {
+		index.AddBlock(height, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(height, 0, ns)})
+	}
+
+	t.Run("forward", func(t *testing.T) {
+		res, err := server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+			Namespace:  ns,
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), res.PayForBlobs[0].Height)
+
+		// block 1 leaves the index before the next page is requested
+		index.AddBlock(4, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(4, 0, ns)})
+		res, err = server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+			Namespace:  ns,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), res.PayForBlobs[0].Height)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		res, err := server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+			Namespace:  ns,
+			Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(4), res.PayForBlobs[0].Height)
+
+		// a new block enters the index before the next page is requested
+		index.AddBlock(5, []*blobindex.IndexedPayForBlobs{newIndexedPayForBlobs(5, 0, ns)})
+		res, err = server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{
+			Namespace:  ns,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(3), res.PayForBlobs[0].Height)
+	})
+}
@@ -10,10 +10,13 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // gasMultiplier is the multiplier for the gas limit. It's used to account for the fact that
@@ -31,31 +34,40 @@ type govMaxSquareBytesFn func() (uint64, error)
 // current network minimum gas price.
 type minGasPriceFn func() (float64, error)
 
+// NodeClient is the subset of the celestia-core RPC client used by the gas
+// estimator. It's used to query the mempool and the recent block history.
+type NodeClient interface {
+	cmtclient.MempoolClient
+	BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error)
+}
+
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
-func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) {
+func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, gasPriceHistory *GasPriceHistory) {
 	RegisterGasEstimatorServer(
 		qrt,
-		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn),
+		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn, gasPriceHistory),
 	)
 }
 
 var _ GasEstimatorServer = &gasEstimatorServer{}
 
 type gasEstimatorServer struct {
-	mempoolClient       cmtclient.MempoolClient
+	nodeClient          NodeClient
 	simulateFn          baseAppSimulateFn
 	txDecoder           sdk.TxDecoder
 	govMaxSquareBytesFn govMaxSquareBytesFn
 	minGasPriceFn       minGasPriceFn
+	gasPriceHistory     *GasPriceHistory
 }
 
-func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) GasEstimatorServer {
+func NewGasEstimatorServer(nodeClient NodeClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, gasPriceHistory *GasPriceHistory) GasEstimatorServer {
 	return &gasEstimatorServer{
-		mempoolClient:       mempoolClient,
+		nodeClient:          nodeClient,
 		simulateFn:          simulateFn,
 		txDecoder:           txDecoder,
 		govMaxSquareBytesFn: govMaxSquareBytesFn,
 		minGasPriceFn:       minGasPriceFn,
+		gasPriceHistory:     gasPriceHistory,
 	}
 }
 
@@ -106,6 +118,36 @@ func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, reque
 	}, nil
 }
 
+// EstimateGasPriceForInclusion takes a number of blocks and a transaction size
+// and estimates the gas price needed for the transaction to be included within
+// that many blocks.
+func (s *gasEstimatorServer) EstimateGasPriceForInclusion(ctx context.Context, request *EstimateGasPriceForInclusionRequest) (*EstimateGasPriceForInclusionResponse, error) {
+	if request.TargetBlocks == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target blocks must be positive")
+	}
+	if request.TargetBlocks > maxInclusionTargetBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "target blocks %d exceeds the maximum of %d", request.TargetBlocks, maxInclusionTargetBlocks)
+	}
+	if request.TxSize == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx size must be positive")
+	}
+
+	gasPrice, err := s.estimateGasPriceForInclusion(ctx, request.TargetBlocks, request.TxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &EstimateGasPriceForInclusionResponse{EstimatedGasPrice: gasPrice}, nil
+}
+
+// GasPriceHistory returns the gas price statistics of the most recent blocks
+// kept by the node.
+func (s *gasEstimatorServer) GasPriceHistory(_ context.Context, request *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	if s.gasPriceHistory == nil {
+		return nil, status.Error(codes.Unavailable, "gas price history is not kept by this node")
+	}
+	return &GasPriceHistoryResponse{Blocks: s.gasPriceHistory.Blocks(request.NumBlocks)}, nil
+}
+
 // gasPriceEstimationThreshold the threshold of mempool transactions to
 // estimate the gas price.
 // If the returned transactions from the mempool can't fill more than 70% of
@@ -120,7 +162,7 @@ var gasPriceEstimationThreshold = 0.70
 func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority) (float64, error) {
 	// Use -1 to query all the unconfirmed transactions.
 	limit := -1
-	txsResp, err := s.mempoolClient.UnconfirmedTxs(ctx, &limit)
+	txsResp, err := s.nodeClient.UnconfirmedTxs(ctx, &limit)
 	if err != nil {
 		return 0, err
 	}
@@ -152,6 +194,96 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 	return math.Max(estimatedGasPrice, minGasPrice), nil
 }
 
+const (
+	// maxInclusionTargetBlocks is the maximum number of blocks that can be
+	// requested in an inclusion gas price estimation.
+	maxInclusionTargetBlocks = 100
+	// inclusionFillHistoryBlocks is the number of recent blocks used to compute
+	// the average block fill.
+	inclusionFillHistoryBlocks = 10
+	// inclusionOutbidRate is the rate applied to the gas price of the first
+	// mempool transaction that would be left out of the target blocks, so that
+	// the estimated gas price outbids it.
+	inclusionOutbidRate = 1.1
+)
+
+// estimateGasPriceForInclusion estimates the gas price needed for a transaction
+// of txSize bytes to be included within targetBlocks blocks.
+// The next block is expected to be filled by the mempool transactions with the
+// highest gas prices. Each following block is expected to be partially filled
+// by newly arriving transactions following the average fill of the recent
+// blocks, leaving the rest of it to the current mempool transactions.
+// The mempool transactions are then walked in descending gas price order until
+// they, together with the transaction, exceed the available block space. The
+// gas price of the first transaction that doesn't fit is outbid.
+// If all transactions fit, the min gas price is returned.
+func (s *gasEstimatorServer) estimateGasPriceForInclusion(ctx context.Context, targetBlocks, txSize uint64) (float64, error) {
+	govMaxSquareBytes, err := s.govMaxSquareBytesFn()
+	if err != nil {
+		return 0, err
+	}
+	if txSize > govMaxSquareBytes {
+		return 0, status.Errorf(codes.InvalidArgument, "tx size %d exceeds the max square size of %d bytes", txSize, govMaxSquareBytes)
+	}
+
+	minGasPrice, err := s.minGasPriceFn()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get min gas price: %w", err)
+	}
+
+	blockFill, err := s.averageBlockFill(ctx, govMaxSquareBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	// Use -1 to query all the unconfirmed transactions.
+	limit := -1
+	txsResp, err := s.nodeClient.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return 0, err
+	}
+	txs, err := sortByGasPrice(s.txDecoder, txsResp.Txs)
+	if err != nil {
+		return 0, err
+	}
+
+	blockSpace := float64(govMaxSquareBytes)
+	availableBytes := blockSpace + float64(targetBlocks-1)*blockSpace*(1-blockFill)
+	usedBytes := float64(txSize)
+	for _, tx := range txs {
+		usedBytes += float64(tx.size)
+		if usedBytes > availableBytes {
+			return math.Max(tx.gasPrice*inclusionOutbidRate, minGasPrice), nil
+		}
+	}
+
+	// Return the maximum of the default min gas price and network min gas price
+	return math.Max(appconsts.DefaultMinGasPrice, minGasPrice), nil
+}
+
+// averageBlockFill returns the average fraction of the max square size filled
+// by the recent blocks. It returns 0 if there are no blocks yet.
+func (s *gasEstimatorServer) averageBlockFill(ctx context.Context, govMaxSquareBytes uint64) (float64, error) {
+	// Use 0 for both heights to query the latest blocks.
+	blockchainInfo, err := s.nodeClient.BlockchainInfo(ctx, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+	blockMetas := blockchainInfo.BlockMetas
+	if len(blockMetas) > inclusionFillHistoryBlocks {
+		blockMetas = blockMetas[:inclusionFillHistoryBlocks]
+	}
+	if len(blockMetas) == 0 || govMaxSquareBytes == 0 {
+		return 0, nil
+	}
+
+	fills := make([]float64, len(blockMetas))
+	for i, blockMeta := range blockMetas {
+		fills[i] = math.Min(float64(blockMeta.BlockSize)/float64(govMaxSquareBytes), 1)
+	}
+	return Mean(fills), nil
+}
+
 const (
 	// highPriorityGasAdjustmentRate is the percentage increase applied to the
 	// estimated gas price when the block is more than 70% full, i.e., gasPriceEstimationThreshold,
@@ -216,26 +348,39 @@ func estimateGasPriceForTransactions(gasPrices []float64, priority TxPriority) (
 // and returns their corresponding gas prices.
 // The total size of the returned transactions won't exceed the maxBytes parameter.
 func SortAndExtractGasPrices(txDecoder sdk.TxDecoder, txs []types.Tx, maxBytes int64) ([]float64, error) {
-	type gasPriceAndSize struct {
-		gasPrice float64
-		size     int64
+	gasPriceAndSizes, err := sortByGasPrice(txDecoder, txs)
+	if err != nil {
+		return nil, err
 	}
+
+	gasPrices := make([]float64, 0)
+	totalSize := int64(0)
+	for _, tx := range gasPriceAndSizes {
+		if tx.size+totalSize > maxBytes {
+			// to also add small transactions in case they can be included in the block.
+			continue
+		}
+		gasPrices = append(gasPrices, tx.gasPrice)
+		totalSize += tx.size
+	}
+	sort.Float64s(gasPrices)
+	return gasPrices, nil
+}
+
+type gasPriceAndSize struct {
+	gasPrice float64
+	size     int64
+}
+
+// sortByGasPrice takes a list of transactions and returns their gas prices and
+// sizes sorted by gas price in descending order.
+func sortByGasPrice(txDecoder sdk.TxDecoder, txs []types.Tx) ([]gasPriceAndSize, error) {
 	gasPriceAndSizes := make([]gasPriceAndSize, len(txs))
 	for index, rawTx := range txs {
-		txBytes := rawTx
-		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
-		if isBlob {
-			if err != nil {
-				panic(err)
-			}
-			txBytes = bTx.Tx
-		}
-		sdkTx, err := txDecoder(txBytes)
+		gasPrice, _, err := txGasPrice(txDecoder, rawTx)
 		if err != nil {
 			return nil, err
 		}
-		feeTx := sdkTx.(sdk.FeeTx)
-		gasPrice := float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas())
 		gasPriceAndSizes[index] = gasPriceAndSize{
 			size:     int64(len(rawTx)),
 			gasPrice: gasPrice,
@@ -246,19 +391,33 @@ func SortAndExtractGasPrices(txDecoder sdk.TxDecoder, txs []types.Tx, maxBytes i
 	sort.Slice(gasPriceAndSizes, func(i, j int) bool {
 		return gasPriceAndSizes[i].gasPrice > gasPriceAndSizes[j].gasPrice
 	})
+	return gasPriceAndSizes, nil
+}
 
-	gasPrices := make([]float64, 0)
-	totalSize := int64(0)
-	for _, tx := range gasPriceAndSizes {
-		if tx.size+totalSize > maxBytes {
-			// to also add small transactions in case they can be included in the block.
-			continue
+// txGasPrice decodes the transaction and returns its gas price and whether it's
+// a blob transaction.
+func txGasPrice(txDecoder sdk.TxDecoder, rawTx []byte) (float64, bool, error) {
+	txBytes := rawTx
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob {
+		if err != nil {
+			return 0, false, err
 		}
-		gasPrices = append(gasPrices, tx.gasPrice)
-		totalSize += tx.size
+		txBytes = bTx.Tx
 	}
-	sort.Float64s(gasPrices)
-	return gasPrices, nil
+	sdkTx, err := txDecoder(txBytes)
+	if err != nil {
+		return 0, false, err
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return 0, false, errors.New("transaction is not a fee transaction")
+	}
+	if feeTx.GetGas() == 0 {
+		return 0, false, errors.New("transaction has a zero gas limit")
+	}
+	gasPrice := float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas())
+	return gasPrice, isBlob, nil
 }
 
 // Median calculates the median value of the provided gas prices.
@@ -273,12 +273,405 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
+// EstimateGasPriceForInclusionRequest the request to estimate the gas price
+// needed for a transaction to be included within a number of blocks.
+type EstimateGasPriceForInclusionRequest struct {
+	// target_blocks is the number of blocks within which the transaction should
+	// be included. 1 means the next block.
+	TargetBlocks uint64 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+	// tx_size is the size of the transaction in bytes, including its blobs.
+	TxSize uint64 `protobuf:"varint,2,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+}
+
+func (m *EstimateGasPriceForInclusionRequest) Reset()         { *m = EstimateGasPriceForInclusionRequest{} }
+func (m *EstimateGasPriceForInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasPriceForInclusionRequest) ProtoMessage()    {}
+func (*EstimateGasPriceForInclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *EstimateGasPriceForInclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateGasPriceForInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasPriceForInclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateGasPriceForInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasPriceForInclusionRequest.Merge(m, src)
+}
+func (m *EstimateGasPriceForInclusionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasPriceForInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasPriceForInclusionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasPriceForInclusionRequest proto.InternalMessageInfo
+
+func (m *EstimateGasPriceForInclusionRequest) GetTargetBlocks() uint64 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+func (m *EstimateGasPriceForInclusionRequest) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+// EstimateGasPriceForInclusionResponse the response of the gas price
+// estimation for inclusion within a number of blocks.
+type EstimateGasPriceForInclusionResponse struct {
+	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
+}
+
+func (m *EstimateGasPriceForInclusionResponse) Reset()         { *m = EstimateGasPriceForInclusionResponse{} }
+func (m *EstimateGasPriceForInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasPriceForInclusionResponse) ProtoMessage()    {}
+func (*EstimateGasPriceForInclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *EstimateGasPriceForInclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateGasPriceForInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasPriceForInclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateGasPriceForInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasPriceForInclusionResponse.Merge(m, src)
+}
+func (m *EstimateGasPriceForInclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasPriceForInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasPriceForInclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasPriceForInclusionResponse proto.InternalMessageInfo
+
+func (m *EstimateGasPriceForInclusionResponse) GetEstimatedGasPrice() float64 {
+	if m != nil {
+		return m.EstimatedGasPrice
+	}
+	return 0
+}
+
+// GasPriceHistoryRequest the request to get the gas price statistics of the
+// most recent blocks.
+type GasPriceHistoryRequest struct {
+	// num_blocks is the maximum number of the most recent blocks to return. If
+	// zero, all the blocks kept by the node are returned.
+	NumBlocks uint64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+}
+
+func (m *GasPriceHistoryRequest) Reset()         { *m = GasPriceHistoryRequest{} }
+func (m *GasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryRequest) ProtoMessage()    {}
+func (*GasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *GasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryRequest.Merge(m, src)
+}
+func (m *GasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GasPriceHistoryRequest) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+// GasPriceHistoryResponse the response of the gas price history query.
+type GasPriceHistoryResponse struct {
+	// blocks are the gas price statistics per block in ascending height order.
+	Blocks []*BlockGasPriceStats `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *GasPriceHistoryResponse) Reset()         { *m = GasPriceHistoryResponse{} }
+func (m *GasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryResponse) ProtoMessage()    {}
+func (*GasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{7}
+}
+func (m *GasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryResponse.Merge(m, src)
+}
+func (m *GasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GasPriceHistoryResponse) GetBlocks() []*BlockGasPriceStats {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// BlockGasPriceStats the gas price statistics of a committed block.
+type BlockGasPriceStats struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_count is the number of transactions in the block that were executed
+	// successfully.
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// pfb_count is the number of blob transactions in the block that were
+	// executed successfully.
+	PfbCount uint64 `protobuf:"varint,3,opt,name=pfb_count,json=pfbCount,proto3" json:"pfb_count,omitempty"`
+	// total_bytes is the total size of the transactions in the block, including
+	// their blobs.
+	TotalBytes uint64 `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// utilization is the fraction of the max square size in bytes taken by the
+	// transactions of the block.
+	Utilization float64 `protobuf:"fixed64,5,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// gas_price_percentiles are the percentiles of the gas prices of the
+	// transactions in the block that were executed successfully. Unset if there
+	// are none.
+	GasPricePercentiles *GasPricePercentiles `protobuf:"bytes,6,opt,name=gas_price_percentiles,json=gasPricePercentiles,proto3" json:"gas_price_percentiles,omitempty"`
+}
+
+func (m *BlockGasPriceStats) Reset()         { *m = BlockGasPriceStats{} }
+func (m *BlockGasPriceStats) String() string { return proto.CompactTextString(m) }
+func (*BlockGasPriceStats) ProtoMessage()    {}
+func (*BlockGasPriceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{8}
+}
+func (m *BlockGasPriceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasPriceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasPriceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasPriceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasPriceStats.Merge(m, src)
+}
+func (m *BlockGasPriceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasPriceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasPriceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasPriceStats proto.InternalMessageInfo
+
+func (m *BlockGasPriceStats) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasPriceStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockGasPriceStats) GetPfbCount() uint64 {
+	if m != nil {
+		return m.PfbCount
+	}
+	return 0
+}
+
+func (m *BlockGasPriceStats) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *BlockGasPriceStats) GetUtilization() float64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *BlockGasPriceStats) GetGasPricePercentiles() *GasPricePercentiles {
+	if m != nil {
+		return m.GasPricePercentiles
+	}
+	return nil
+}
+
+// GasPricePercentiles the percentiles of a set of gas prices.
+type GasPricePercentiles struct {
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	P10 float64 `protobuf:"fixed64,2,opt,name=p10,proto3" json:"p10,omitempty"`
+	P25 float64 `protobuf:"fixed64,3,opt,name=p25,proto3" json:"p25,omitempty"`
+	P50 float64 `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P75 float64 `protobuf:"fixed64,5,opt,name=p75,proto3" json:"p75,omitempty"`
+	P90 float64 `protobuf:"fixed64,6,opt,name=p90,proto3" json:"p90,omitempty"`
+	Max float64 `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *GasPricePercentiles) Reset()         { *m = GasPricePercentiles{} }
+func (m *GasPricePercentiles) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentiles) ProtoMessage()    {}
+func (*GasPricePercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{9}
+}
+func (m *GasPricePercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentiles.Merge(m, src)
+}
+func (m *GasPricePercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricePercentiles proto.InternalMessageInfo
+
+func (m *GasPricePercentiles) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetP10() float64 {
+	if m != nil {
+		return m.P10
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetP25() float64 {
+	if m != nil {
+		return m.P25
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetP50() float64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetP75() float64 {
+	if m != nil {
+		return m.P75
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetP90() float64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *GasPricePercentiles) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
 	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
 	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
 	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
 	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*EstimateGasPriceForInclusionRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceForInclusionRequest")
+	proto.RegisterType((*EstimateGasPriceForInclusionResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceForInclusionResponse")
+	proto.RegisterType((*GasPriceHistoryRequest)(nil), "celestia.core.v1.gas_estimation.GasPriceHistoryRequest")
+	proto.RegisterType((*GasPriceHistoryResponse)(nil), "celestia.core.v1.gas_estimation.GasPriceHistoryResponse")
+	proto.RegisterType((*BlockGasPriceStats)(nil), "celestia.core.v1.gas_estimation.BlockGasPriceStats")
+	proto.RegisterType((*GasPricePercentiles)(nil), "celestia.core.v1.gas_estimation.GasPricePercentiles")
 }
 
 func init() {
@@ -286,35 +679,57 @@ func init() {
 }
 
 var fileDescriptor_67d02876d749b9cc = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0x2c,
-	0x8e, 0x07, 0x89, 0xe4, 0x26, 0x96, 0x64, 0xe6, 0xe7, 0x21, 0x73, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xe4, 0x61, 0x9a, 0xf4, 0x40, 0x9a, 0xf4, 0xca, 0x0c, 0xf5, 0x50, 0x35,
-	0x29, 0xa5, 0x73, 0x89, 0xbb, 0x42, 0x78, 0xa9, 0xee, 0x89, 0xc5, 0x01, 0x45, 0x99, 0xc9, 0xa9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x3e, 0x5c, 0xdc, 0x25, 0x15, 0xf1, 0x05, 0x45,
-	0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xda, 0x7a, 0x04,
-	0x4c, 0xd4, 0x0b, 0xa9, 0x08, 0x80, 0x6a, 0x09, 0xe2, 0x2a, 0x81, 0xb3, 0x95, 0xbc, 0xb8, 0x24,
-	0x30, 0x2d, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xd2, 0xe3, 0x12, 0x86, 0x1a, 0x90, 0x9a,
-	0x12, 0x0f, 0x32, 0xae, 0x00, 0x24, 0x0d, 0xb6, 0x91, 0x31, 0x48, 0x10, 0x2e, 0x05, 0xd3, 0xa7,
-	0xd4, 0xc5, 0xc8, 0x25, 0x8f, 0x6e, 0x98, 0x63, 0x5e, 0x4a, 0x68, 0x71, 0x62, 0x3a, 0x6d, 0x5c,
-	0x2f, 0x24, 0xc9, 0xc5, 0x51, 0x52, 0x11, 0x9f, 0x54, 0x59, 0x92, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xa8, 0xc1, 0x13, 0xc4, 0x5e, 0x52, 0xe1, 0x04, 0xe2, 0x2a, 0x35, 0x30, 0x72, 0x29, 0xe0, 0x76,
-	0x0c, 0x79, 0x3e, 0x14, 0xd2, 0xe1, 0x12, 0x42, 0x55, 0x5f, 0x5a, 0x9c, 0x9a, 0x02, 0xb6, 0x99,
-	0x25, 0x48, 0x00, 0x59, 0x79, 0x68, 0x71, 0x6a, 0x8a, 0x56, 0x0e, 0x17, 0x17, 0xc2, 0xdd, 0x42,
-	0xd2, 0x5c, 0xe2, 0x21, 0x11, 0xf1, 0x01, 0x41, 0x9e, 0xfe, 0x41, 0x9e, 0x21, 0x91, 0xf1, 0xa1,
-	0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xc2, 0x5c, 0xfc,
-	0xc8, 0x92, 0x3e, 0xfe, 0xe1, 0x02, 0x8c, 0x42, 0x62, 0x5c, 0x42, 0xc8, 0x82, 0xbe, 0xae, 0x2e,
-	0x9e, 0xa1, 0xbe, 0x02, 0x4c, 0x42, 0x22, 0x5c, 0x02, 0xc8, 0xe2, 0x1e, 0x9e, 0xee, 0x1e, 0x02,
-	0xcc, 0x46, 0xfb, 0x98, 0xb8, 0x78, 0xdc, 0x13, 0x8b, 0x5d, 0x61, 0x49, 0x4d, 0xa8, 0x93, 0x91,
-	0x4b, 0x00, 0x3d, 0x04, 0x84, 0x2c, 0x08, 0x06, 0x35, 0x8e, 0x74, 0x27, 0x65, 0x49, 0x86, 0x4e,
-	0x48, 0x30, 0x2b, 0x31, 0x08, 0x2d, 0x64, 0xc4, 0x4c, 0x67, 0xb0, 0xd8, 0x10, 0x72, 0x20, 0xd9,
-	0x64, 0xb4, 0x54, 0x25, 0xe5, 0x48, 0x81, 0x09, 0x30, 0x37, 0x3a, 0x85, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xcc, 0xa2, 0xfc, 0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0x1f, 0x84,
-	0xd3, 0x8b, 0x0a, 0x92, 0x41, 0x19, 0x1e, 0x61, 0x71, 0x12, 0x1b, 0x38, 0xc7, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x63, 0x14, 0xb9, 0x28, 0x04, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x52, 0xe3, 0x46,
+	0x10, 0xf6, 0x60, 0xc7, 0x86, 0x36, 0x09, 0xca, 0x38, 0xc1, 0x8e, 0x49, 0x8c, 0x4b, 0xe4, 0xe0,
+	0xca, 0x8f, 0x0c, 0x26, 0x2e, 0x20, 0xa7, 0xf0, 0x63, 0xc0, 0x09, 0x04, 0x97, 0xb0, 0xf3, 0x77,
+	0x51, 0xc9, 0xf2, 0x20, 0xab, 0x62, 0x4b, 0x8a, 0x66, 0x44, 0x09, 0x4e, 0x39, 0xa5, 0x2a, 0x7b,
+	0xda, 0xad, 0x7d, 0x82, 0x7d, 0x80, 0x7d, 0x8f, 0x3d, 0x72, 0xdc, 0xe3, 0x16, 0x3c, 0xc4, 0x5e,
+	0xb7, 0x34, 0x96, 0x8c, 0x7f, 0x00, 0x2f, 0x50, 0x7b, 0x50, 0x55, 0xcf, 0xd7, 0xd3, 0x5f, 0x7f,
+	0xdd, 0x33, 0xd3, 0x25, 0x58, 0xd5, 0x48, 0x87, 0x50, 0x66, 0xa8, 0x45, 0xcd, 0x72, 0x48, 0xf1,
+	0x74, 0xa5, 0xa8, 0xab, 0x54, 0xf1, 0x91, 0xae, 0xca, 0x0c, 0xcb, 0x1c, 0x5c, 0x5a, 0x8e, 0x64,
+	0x3b, 0x16, 0xb3, 0xf0, 0x62, 0x18, 0x24, 0xf9, 0x41, 0xd2, 0xe9, 0x8a, 0x34, 0x1c, 0x24, 0xea,
+	0x90, 0xae, 0xf4, 0x56, 0x64, 0x4f, 0xa5, 0x35, 0xc7, 0xd0, 0x88, 0x4c, 0xfe, 0x71, 0x09, 0x65,
+	0xf8, 0x00, 0x92, 0xcc, 0x53, 0x6c, 0xc7, 0xb0, 0x1c, 0x83, 0x9d, 0x65, 0x50, 0x1e, 0x15, 0x3e,
+	0x29, 0x7d, 0x2b, 0x4d, 0x60, 0x94, 0xea, 0x5e, 0x2d, 0x08, 0x91, 0x81, 0xf5, 0x6d, 0xf1, 0x67,
+	0xc8, 0x8c, 0x27, 0xa2, 0xb6, 0x65, 0x52, 0x82, 0x25, 0x48, 0x05, 0x04, 0xa4, 0xa5, 0xf8, 0x74,
+	0xb6, 0xef, 0xe6, 0x19, 0x91, 0xfc, 0x69, 0xdf, 0x15, 0xc6, 0x89, 0x4f, 0x10, 0x2c, 0x8e, 0x92,
+	0x6d, 0x9a, 0xad, 0x06, 0x55, 0xf5, 0x0f, 0xa3, 0x1e, 0x7f, 0x01, 0xd3, 0xcc, 0x53, 0x9a, 0x67,
+	0x8c, 0xd0, 0xcc, 0x54, 0x1e, 0x15, 0x66, 0xe5, 0x04, 0xf3, 0xb6, 0xfc, 0xa5, 0xf8, 0x2f, 0x82,
+	0xfc, 0xed, 0x62, 0x1e, 0x56, 0x21, 0xfe, 0x0e, 0xf0, 0xf0, 0x7e, 0x97, 0x92, 0x16, 0xcf, 0x1c,
+	0x93, 0x85, 0xc1, 0xed, 0x0d, 0x4a, 0x5a, 0xa2, 0x06, 0x4b, 0xa3, 0x0a, 0x76, 0x2d, 0xa7, 0x6a,
+	0x6a, 0x1d, 0x97, 0x1a, 0x96, 0x19, 0xb6, 0x64, 0x09, 0x3e, 0x66, 0xaa, 0xa3, 0x13, 0xa6, 0x34,
+	0x3b, 0x96, 0xf6, 0x37, 0xe5, 0xe9, 0x63, 0xf2, 0x6c, 0x0f, 0xdc, 0xe2, 0x18, 0x4e, 0x43, 0x82,
+	0x79, 0x0a, 0x35, 0xce, 0x49, 0x90, 0x2e, 0xce, 0xbc, 0x63, 0xe3, 0x9c, 0x88, 0xbf, 0xc1, 0xd7,
+	0x77, 0x27, 0x79, 0xe0, 0x61, 0xae, 0xc1, 0x7c, 0x68, 0xef, 0x1b, 0x94, 0x59, 0xce, 0x59, 0xa8,
+	0xf7, 0x2b, 0x00, 0xd3, 0xed, 0x0e, 0x8b, 0x9d, 0x31, 0xdd, 0x6e, 0x4f, 0xa9, 0x78, 0x02, 0xe9,
+	0xb1, 0xc0, 0x40, 0xc3, 0x2f, 0x10, 0xef, 0x47, 0x45, 0x0b, 0xc9, 0xd2, 0xea, 0xc4, 0x73, 0xe7,
+	0x9c, 0x21, 0xdd, 0x31, 0x53, 0x19, 0x95, 0x03, 0x0a, 0xf1, 0xd9, 0x14, 0xe0, 0x71, 0x37, 0x9e,
+	0x87, 0x78, 0x9b, 0x18, 0x7a, 0x9b, 0x71, 0x65, 0x51, 0x39, 0x58, 0x05, 0x57, 0x45, 0xb3, 0x5c,
+	0x93, 0x05, 0x1d, 0x4c, 0x30, 0x6f, 0xdb, 0x5f, 0xe2, 0x05, 0x98, 0xb1, 0x4f, 0x9a, 0x81, 0x2f,
+	0xca, 0x7d, 0xd3, 0xf6, 0x49, 0xb3, 0xe7, 0x5c, 0x84, 0x24, 0xb3, 0x98, 0xda, 0x09, 0x6e, 0x59,
+	0x8c, 0xbb, 0x81, 0x43, 0xfc, 0xa2, 0xe1, 0x3c, 0x24, 0x5d, 0x66, 0x74, 0x8c, 0x73, 0xae, 0x38,
+	0xf3, 0x11, 0x6f, 0xe8, 0x20, 0x84, 0xdb, 0xf0, 0x79, 0xbf, 0xe1, 0x8a, 0x4d, 0x1c, 0x8d, 0x98,
+	0xcc, 0xe8, 0x10, 0x9a, 0x89, 0xe7, 0x51, 0x21, 0x59, 0xfa, 0x61, 0x62, 0x17, 0xc2, 0x0a, 0x6b,
+	0xd7, 0xb1, 0x72, 0x4a, 0x1f, 0x07, 0xc5, 0xe7, 0x08, 0x52, 0x37, 0x6c, 0xc6, 0x02, 0x44, 0xbb,
+	0x86, 0x19, 0x1c, 0xb6, 0x6f, 0xfa, 0x88, 0xbd, 0xb2, 0xcc, 0x3b, 0x81, 0x64, 0xdf, 0xe4, 0x48,
+	0xa9, 0xcc, 0xeb, 0xf7, 0x91, 0x52, 0x99, 0x23, 0xe5, 0x65, 0x5e, 0xb2, 0x8f, 0x94, 0x7b, 0x7b,
+	0xd6, 0xca, 0x41, 0x8d, 0xbe, 0xc9, 0x91, 0x8d, 0x65, 0x5e, 0x89, 0x8f, 0x6c, 0xf0, 0x3d, 0x5d,
+	0xd5, 0xcb, 0x24, 0x82, 0x5c, 0xaa, 0xf7, 0x4d, 0x07, 0xe0, 0xfa, 0xfd, 0xe2, 0x05, 0x48, 0xd7,
+	0xff, 0x50, 0x6a, 0x72, 0xf5, 0x48, 0xae, 0xd6, 0xff, 0x54, 0x1a, 0xbf, 0x1e, 0xd7, 0x2a, 0xdb,
+	0xd5, 0xdd, 0x6a, 0x65, 0x47, 0x88, 0xe0, 0x14, 0xcc, 0x0d, 0x3a, 0x0f, 0x8e, 0x7e, 0x17, 0x10,
+	0x9e, 0x07, 0x3c, 0x08, 0x1e, 0x56, 0x76, 0xaa, 0x8d, 0x43, 0x61, 0x0a, 0x7f, 0x06, 0xc2, 0x20,
+	0xbe, 0x5f, 0xdd, 0xdb, 0x17, 0xa2, 0xa5, 0xb7, 0x31, 0x98, 0xdd, 0x53, 0x69, 0x25, 0x1c, 0xb9,
+	0xf8, 0x7f, 0x04, 0xc2, 0xe8, 0x13, 0xc1, 0xeb, 0x13, 0x9b, 0x7e, 0xcb, 0xfc, 0xcd, 0x6e, 0x3c,
+	0x20, 0xb2, 0x77, 0xff, 0xc5, 0x08, 0x7e, 0x81, 0xc6, 0xe7, 0x6d, 0x38, 0x95, 0xf0, 0x4f, 0xf7,
+	0x66, 0x1e, 0x99, 0xae, 0xd9, 0xcd, 0x47, 0x30, 0xf4, 0x35, 0xbe, 0x44, 0xf0, 0xe5, 0x5d, 0x23,
+	0x05, 0xef, 0xdc, 0x3b, 0xcb, 0x0d, 0x63, 0x2f, 0x5b, 0x79, 0x24, 0x4b, 0x5f, 0xef, 0x7f, 0x08,
+	0xe6, 0x46, 0x26, 0x0e, 0x5e, 0x7b, 0xef, 0x37, 0x35, 0x3c, 0xdc, 0xb2, 0xeb, 0xf7, 0x0f, 0x0c,
+	0x85, 0x6c, 0xd5, 0x5f, 0x5d, 0xe6, 0xd0, 0xc5, 0x65, 0x0e, 0xbd, 0xb9, 0xcc, 0xa1, 0xa7, 0x57,
+	0xb9, 0xc8, 0xc5, 0x55, 0x2e, 0xf2, 0xfa, 0x2a, 0x17, 0xf9, 0xeb, 0x47, 0xdd, 0x60, 0x6d, 0xb7,
+	0x29, 0x69, 0x56, 0xb7, 0x18, 0xf2, 0x5b, 0x8e, 0xde, 0xb7, 0xbf, 0x57, 0x6d, 0xbb, 0xe8, 0x7f,
+	0xba, 0x63, 0x6b, 0xfe, 0x1f, 0xc3, 0x75, 0xbe, 0x66, 0x9c, 0xff, 0x32, 0xac, 0xbe, 0x0b, 0x00,
+	0x00, 0xff, 0xff, 0x3a, 0x3c, 0xec, 0x9b, 0x69, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -343,6 +758,18 @@ type GasEstimatorClient interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// EstimateGasPriceForInclusion takes a number of blocks and a transaction
+	// size in bytes and estimates the gas price needed for a transaction of that
+	// size to be included within that many blocks. The estimation walks the
+	// mempool transactions ordered by gas price and accounts for the block space
+	// taken by newly arriving transactions using the fill of the recent blocks.
+	// If the transaction fits in the available block space at any gas price, the
+	// network min gas price is returned.
+	EstimateGasPriceForInclusion(ctx context.Context, in *EstimateGasPriceForInclusionRequest, opts ...grpc.CallOption) (*EstimateGasPriceForInclusionResponse, error)
+	// GasPriceHistory returns the gas price statistics of the most recent
+	// committed blocks kept by the node. The number of blocks kept is
+	// configured by the node operator.
+	GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error)
 }
 
 type gasEstimatorClient struct {
@@ -371,6 +798,24 @@ func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *gasEstimatorClient) EstimateGasPriceForInclusion(ctx context.Context, in *EstimateGasPriceForInclusionRequest, opts ...grpc.CallOption) (*EstimateGasPriceForInclusionResponse, error) {
+	out := new(EstimateGasPriceForInclusionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceForInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error) {
+	out := new(GasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/GasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GasEstimatorServer is the server API for GasEstimator service.
 type GasEstimatorServer interface {
 	// estimateGasPrice takes a transaction priority and estimates the gas price
@@ -387,6 +832,18 @@ type GasEstimatorServer interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// EstimateGasPriceForInclusion takes a number of blocks and a transaction
+	// size in bytes and estimates the gas price needed for a transaction of that
+	// size to be included within that many blocks. The estimation walks the
+	// mempool transactions ordered by gas price and accounts for the block space
+	// taken by newly arriving transactions using the fill of the recent blocks.
+	// If the transaction fits in the available block space at any gas price, the
+	// network min gas price is returned.
+	EstimateGasPriceForInclusion(context.Context, *EstimateGasPriceForInclusionRequest) (*EstimateGasPriceForInclusionResponse, error)
+	// GasPriceHistory returns the gas price statistics of the most recent
+	// committed blocks kept by the node. The number of blocks kept is
+	// configured by the node operator.
+	GasPriceHistory(context.Context, *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error)
 }
 
 // UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
@@ -399,6 +856,12 @@ func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, re
 func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
 }
+func (*UnimplementedGasEstimatorServer) EstimateGasPriceForInclusion(ctx context.Context, req *EstimateGasPriceForInclusionRequest) (*EstimateGasPriceForInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceForInclusion not implemented")
+}
+func (*UnimplementedGasEstimatorServer) GasPriceHistory(ctx context.Context, req *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
 
 func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
 	s.RegisterService(&_GasEstimator_serviceDesc, srv)
@@ -440,6 +903,42 @@ func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GasEstimator_EstimateGasPriceForInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceForInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPriceForInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceForInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPriceForInclusion(ctx, req.(*EstimateGasPriceForInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).GasPriceHistory(ctx, req.(*GasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
 var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
@@ -453,6 +952,14 @@ var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGasPriceAndUsage",
 			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
 		},
+		{
+			MethodName: "EstimateGasPriceForInclusion",
+			Handler:    _GasEstimator_EstimateGasPriceForInclusion_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _GasEstimator_GasPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
@@ -584,6 +1091,259 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *EstimateGasPriceForInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceForInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceForInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TargetBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceForInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceForInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceForInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockGasPriceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasPriceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasPriceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPricePercentiles != nil {
+		{
+			size, err := m.GasPricePercentiles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Utilization != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Utilization))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PfbCount != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.PfbCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.P90 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P90))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.P75 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P75))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.P50 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P50))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.P25 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P25))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.P10 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P10))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Min != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGasEstimator(v)
 	base := offset
@@ -644,19 +1404,593 @@ func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
 	if m.EstimatedGasPrice != 0 {
 		n += 9
 	}
-	if m.EstimatedGasUsed != 0 {
-		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceForInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TargetBlocks))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxSize))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceForInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.NumBlocks))
+	}
+	return n
+}
+
+func (m *GasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockGasPriceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Height))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxCount))
+	}
+	if m.PfbCount != 0 {
+		n += 1 + sovGasEstimator(uint64(m.PfbCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TotalBytes))
+	}
+	if m.Utilization != 0 {
+		n += 9
+	}
+	if m.GasPricePercentiles != nil {
+		l = m.GasPricePercentiles.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPricePercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.P10 != 0 {
+		n += 9
+	}
+	if m.P25 != 0 {
+		n += 9
+	}
+	if m.P50 != 0 {
+		n += 9
+	}
+	if m.P75 != 0 {
+		n += 9
+	}
+	if m.P90 != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovGasEstimator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasEstimator(x uint64) (n int) {
+	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+			}
+			m.EstimatedGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceForInclusionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceForInclusionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceForInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlocks", wireType)
+			}
+			m.TargetBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *EstimateGasPriceForInclusionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceForInclusionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceForInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGasEstimator(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGasEstimator(x uint64) (n int) {
-	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -679,17 +2013,17 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
 			}
-			m.TxPriority = 0
+			m.NumBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -699,7 +2033,7 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxPriority |= TxPriority(b&0x7F) << shift
+				m.NumBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -725,7 +2059,7 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,23 +2082,46 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+			m.Blocks = append(m.Blocks, &BlockGasPriceStats{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -786,7 +2143,7 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockGasPriceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -809,17 +2166,17 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockGasPriceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockGasPriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.TxPriority = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -829,16 +2186,84 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxPriority |= TxPriority(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbCount", wireType)
+			}
+			m.PfbCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PfbCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Utilization = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPricePercentiles", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -848,24 +2273,26 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGasEstimator
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGasEstimator
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			if m.GasPricePercentiles == nil {
+				m.GasPricePercentiles = &GasPricePercentiles{}
+			}
+			if err := m.GasPricePercentiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -889,7 +2316,7 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPricePercentiles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,15 +2339,15 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPricePercentiles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPricePercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -928,26 +2355,73 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+			m.Min = float64(math.Float64frombits(v))
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
 			}
-			m.EstimatedGasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGasEstimator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P10 = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P25", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P25 = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P50 = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P75", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P75 = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P90 = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
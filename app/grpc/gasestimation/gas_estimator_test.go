@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"math"
+	"strings"
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/test/util/random"
 	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMedian(t *testing.T) {
@@ -266,7 +271,7 @@ func TestGasEstimatorWithNetworkMinGasPrice(t *testing.T) {
 	emptyMempool := newMockMempoolClient([]types.Tx{})
 
 	server := &gasEstimatorServer{
-		mempoolClient: emptyMempool,
+		nodeClient: emptyMempool,
 		minGasPriceFn: func() (float64, error) {
 			return networkMinGasPrice, nil
 		},
@@ -282,7 +287,7 @@ func TestGasEstimatorWithNetworkMinGasPrice(t *testing.T) {
 
 	// Test when minGasPriceFn returns an error (should return default min gas price)
 	serverWithError := &gasEstimatorServer{
-		mempoolClient: emptyMempool,
+		nodeClient: emptyMempool,
 		minGasPriceFn: func() (float64, error) {
 			return 0, errors.New("min fee module unavailable")
 		},
@@ -295,9 +300,150 @@ func TestGasEstimatorWithNetworkMinGasPrice(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestEstimateGasPriceForInclusion(t *testing.T) {
+	const (
+		govMaxSquareBytes  = 10_000
+		networkMinGasPrice = 0.01
+	)
+	txDecoder := encoding.MakeConfig().TxConfig.TxDecoder()
+	// three transactions of around 4000 bytes each, so that at most two of
+	// them fit in a block.
+	mempoolTxs := []types.Tx{
+		newMockFeeTx(t, 0.1, 4000),
+		newMockFeeTx(t, 0.3, 4000),
+		newMockFeeTx(t, 0.2, 4000),
+	}
+
+	newServer := func(blockSizes ...int) *gasEstimatorServer {
+		mempool := newMockMempoolClient(mempoolTxs)
+		for _, blockSize := range blockSizes {
+			mempool.blockMetas = append(mempool.blockMetas, &types.BlockMeta{BlockSize: blockSize})
+		}
+		return &gasEstimatorServer{
+			nodeClient: mempool,
+			txDecoder:  txDecoder,
+			minGasPriceFn: func() (float64, error) {
+				return networkMinGasPrice, nil
+			},
+			govMaxSquareBytesFn: func() (uint64, error) {
+				return govMaxSquareBytes, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		server       *gasEstimatorServer
+		targetBlocks uint64
+		txSize       uint64
+		want         float64
+		wantCode     codes.Code
+	}{
+		{
+			name:         "next block outbids the first tx left out",
+			server:       newServer(),
+			targetBlocks: 1,
+			txSize:       1000,
+			want:         0.1 * inclusionOutbidRate,
+		},
+		{
+			name:         "larger tx needs to outbid more txs",
+			server:       newServer(),
+			targetBlocks: 1,
+			txSize:       3000,
+			want:         0.2 * inclusionOutbidRate,
+		},
+		{
+			name:         "half full recent blocks leave room within two blocks",
+			server:       newServer(govMaxSquareBytes/2, govMaxSquareBytes/2),
+			targetBlocks: 2,
+			txSize:       1000,
+			want:         networkMinGasPrice,
+		},
+		{
+			name:         "full recent blocks leave no room in the following blocks",
+			server:       newServer(govMaxSquareBytes, 2*govMaxSquareBytes),
+			targetBlocks: 5,
+			txSize:       1000,
+			want:         0.1 * inclusionOutbidRate,
+		},
+		{
+			name:         "zero target blocks",
+			server:       newServer(),
+			targetBlocks: 0,
+			txSize:       1000,
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "too many target blocks",
+			server:       newServer(),
+			targetBlocks: maxInclusionTargetBlocks + 1,
+			txSize:       1000,
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "zero tx size",
+			server:       newServer(),
+			targetBlocks: 1,
+			txSize:       0,
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "tx larger than the max square size",
+			server:       newServer(),
+			targetBlocks: 1,
+			txSize:       govMaxSquareBytes + 1,
+			wantCode:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.server.EstimateGasPriceForInclusion(context.Background(), &EstimateGasPriceForInclusionRequest{
+				TargetBlocks: tt.targetBlocks,
+				TxSize:       tt.txSize,
+			})
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, resp.EstimatedGasPrice, 1e-9)
+		})
+	}
+
+	t.Run("respects the network min gas price", func(t *testing.T) {
+		server := newServer()
+		server.minGasPriceFn = func() (float64, error) {
+			return 1, nil
+		}
+		resp, err := server.EstimateGasPriceForInclusion(context.Background(), &EstimateGasPriceForInclusionRequest{
+			TargetBlocks: 1,
+			TxSize:       1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), resp.EstimatedGasPrice)
+	})
+}
+
+// newMockFeeTx returns an encoded transaction with the given gas price whose
+// size is roughly the given size.
+func newMockFeeTx(t *testing.T, gasPrice float64, size int) types.Tx {
+	const gasLimit = 100_000
+	txConfig := encoding.MakeConfig().TxConfig
+	builder := txConfig.NewTxBuilder()
+	builder.SetGasLimit(gasLimit)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, int64(math.Round(gasPrice*gasLimit)))))
+	builder.SetMemo(strings.Repeat("a", size))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return txBytes
+}
+
 type mockMempoolClient struct {
 	txs        []types.Tx
 	totalBytes int64
+	blockMetas []*types.BlockMeta
 }
 
 func newMockMempoolClient(txs []types.Tx) *mockMempoolClient {
@@ -326,3 +472,10 @@ func (m *mockMempoolClient) NumUnconfirmedTxs(ctx context.Context) (*rpctypes.Re
 func (m *mockMempoolClient) CheckTx(ctx context.Context, tx types.Tx) (*rpctypes.ResultCheckTx, error) {
 	return nil, nil
 }
+
+func (m *mockMempoolClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*rpctypes.ResultBlockchainInfo, error) {
+	return &rpctypes.ResultBlockchainInfo{
+		LastHeight: int64(len(m.blockMetas)),
+		BlockMetas: m.blockMetas,
+	}, nil
+}
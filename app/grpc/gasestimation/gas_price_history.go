@@ -0,0 +1,120 @@
+package gasestimation
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagGasPriceHistoryBlocks is the flag to set the number of recent blocks
+	// whose gas price statistics are kept by the node.
+	FlagGasPriceHistoryBlocks = "gas-price-history-blocks"
+	// DefaultGasPriceHistoryBlocks is the default number of recent blocks whose
+	// gas price statistics are kept by the node.
+	DefaultGasPriceHistoryBlocks = 100
+)
+
+// GasPriceHistory keeps the gas price statistics of a rolling window of the
+// most recent committed blocks. It is safe for concurrent use.
+type GasPriceHistory struct {
+	txDecoder  sdk.TxDecoder
+	windowSize int
+
+	mu     sync.RWMutex
+	blocks []*BlockGasPriceStats
+}
+
+// NewGasPriceHistory creates a gas price history that keeps the statistics of
+// the last windowSize blocks.
+func NewGasPriceHistory(txDecoder sdk.TxDecoder, windowSize int) *GasPriceHistory {
+	if windowSize <= 0 {
+		windowSize = DefaultGasPriceHistoryBlocks
+	}
+	return &GasPriceHistory{
+		txDecoder:  txDecoder,
+		windowSize: windowSize,
+		blocks:     make([]*BlockGasPriceStats, 0, windowSize),
+	}
+}
+
+// AddBlock computes the statistics of the block at the given height from its
+// transactions and their execution results and adds them to the history,
+// evicting the oldest block if the window is full. Transactions that failed
+// are only counted in the block size. Transactions that can't be decoded are
+// not counted in the gas prices.
+// A block whose height isn't above the last added one resets the history, as
+// it means the node is replaying blocks.
+func (h *GasPriceHistory) AddBlock(height int64, txs [][]byte, results []*abci.ExecTxResult, maxSquareBytes uint64) {
+	stats := &BlockGasPriceStats{Height: height}
+	gasPrices := make([]float64, 0, len(txs))
+	for i, tx := range txs {
+		stats.TotalBytes += uint64(len(tx))
+		if i < len(results) && results[i].Code != abci.CodeTypeOK {
+			continue
+		}
+		stats.TxCount++
+		gasPrice, isBlob, err := txGasPrice(h.txDecoder, tx)
+		if err != nil {
+			continue
+		}
+		if isBlob {
+			stats.PfbCount++
+		}
+		gasPrices = append(gasPrices, gasPrice)
+	}
+	if maxSquareBytes > 0 {
+		stats.Utilization = float64(stats.TotalBytes) / float64(maxSquareBytes)
+	}
+	if len(gasPrices) > 0 {
+		sort.Float64s(gasPrices)
+		stats.GasPricePercentiles = &GasPricePercentiles{
+			Min: gasPrices[0],
+			P10: Percentile(gasPrices, 10),
+			P25: Percentile(gasPrices, 25),
+			P50: Percentile(gasPrices, 50),
+			P75: Percentile(gasPrices, 75),
+			P90: Percentile(gasPrices, 90),
+			Max: gasPrices[len(gasPrices)-1],
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.blocks) > 0 && h.blocks[len(h.blocks)-1].Height >= height {
+		h.blocks = h.blocks[:0]
+	}
+	if len(h.blocks) == h.windowSize {
+		// shift in place to reuse the backing array.
+		copy(h.blocks, h.blocks[1:])
+		h.blocks = h.blocks[:len(h.blocks)-1]
+	}
+	h.blocks = append(h.blocks, stats)
+}
+
+// Blocks returns the statistics of up to numBlocks of the most recent blocks
+// in ascending height order. If numBlocks is zero, all blocks are returned.
+func (h *GasPriceHistory) Blocks(numBlocks uint64) []*BlockGasPriceStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	start := 0
+	if numBlocks > 0 && numBlocks < uint64(len(h.blocks)) {
+		start = len(h.blocks) - int(numBlocks)
+	}
+	blocks := make([]*BlockGasPriceStats, len(h.blocks)-start)
+	copy(blocks, h.blocks[start:])
+	return blocks
+}
+
+// Percentile returns the p-th percentile of the provided gas prices using the
+// nearest-rank method. Expects a sorted, non empty slice and 0 < p <= 100.
+func Percentile(gasPrices []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(gasPrices))))
+	if rank < 1 {
+		rank = 1
+	}
+	return gasPrices[rank-1]
+}
@@ -0,0 +1,142 @@
+package gasestimation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGasPriceHistory(t *testing.T) {
+	txDecoder := encoding.MakeConfig().TxConfig.TxDecoder()
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+	require.NoError(t, err)
+	blobTx, err := blobtx.MarshalBlobTx(newMockFeeTx(t, 0.5, 100), blob)
+	require.NoError(t, err)
+
+	txs := [][]byte{
+		newMockFeeTx(t, 0.4, 100),
+		newMockFeeTx(t, 0.1, 100),
+		blobTx,
+		newMockFeeTx(t, 0.3, 100),
+		newMockFeeTx(t, 0.2, 100),
+		[]byte("not a transaction"),
+	}
+	totalBytes := 0
+	for _, tx := range txs {
+		totalBytes += len(tx)
+	}
+
+	t.Run("computes the statistics of a block", func(t *testing.T) {
+		history := NewGasPriceHistory(txDecoder, 10)
+		history.AddBlock(1, txs, nil, 2*uint64(totalBytes))
+
+		blocks := history.Blocks(0)
+		require.Len(t, blocks, 1)
+		stats := blocks[0]
+		assert.Equal(t, int64(1), stats.Height)
+		assert.Equal(t, uint64(len(txs)), stats.TxCount)
+		assert.Equal(t, uint64(1), stats.PfbCount)
+		assert.Equal(t, uint64(totalBytes), stats.TotalBytes)
+		assert.Equal(t, 0.5, stats.Utilization)
+		require.NotNil(t, stats.GasPricePercentiles)
+		assert.InDelta(t, 0.1, stats.GasPricePercentiles.Min, 1e-9)
+		assert.InDelta(t, 0.1, stats.GasPricePercentiles.P10, 1e-9)
+		assert.InDelta(t, 0.3, stats.GasPricePercentiles.P50, 1e-9)
+		assert.InDelta(t, 0.5, stats.GasPricePercentiles.P90, 1e-9)
+		assert.InDelta(t, 0.5, stats.GasPricePercentiles.Max, 1e-9)
+	})
+
+	t.Run("skips failed transactions", func(t *testing.T) {
+		results := make([]*abci.ExecTxResult, len(txs))
+		for i := range results {
+			results[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+		}
+		// the blob transaction and the transaction with the lowest gas price
+		// failed
+		results[1].Code = 1
+		results[2].Code = 1
+
+		history := NewGasPriceHistory(txDecoder, 10)
+		history.AddBlock(1, txs, results, 0)
+
+		stats := history.Blocks(0)[0]
+		assert.Equal(t, uint64(len(txs)-2), stats.TxCount)
+		assert.Zero(t, stats.PfbCount)
+		assert.Equal(t, uint64(totalBytes), stats.TotalBytes)
+		require.NotNil(t, stats.GasPricePercentiles)
+		assert.InDelta(t, 0.2, stats.GasPricePercentiles.Min, 1e-9)
+		assert.InDelta(t, 0.4, stats.GasPricePercentiles.Max, 1e-9)
+	})
+
+	t.Run("empty block has no percentiles", func(t *testing.T) {
+		history := NewGasPriceHistory(txDecoder, 10)
+		history.AddBlock(1, nil, nil, 1000)
+
+		blocks := history.Blocks(0)
+		require.Len(t, blocks, 1)
+		assert.Zero(t, blocks[0].TxCount)
+		assert.Nil(t, blocks[0].GasPricePercentiles)
+	})
+
+	t.Run("keeps a rolling window of blocks", func(t *testing.T) {
+		history := NewGasPriceHistory(txDecoder, 3)
+		for height := int64(1); height <= 5; height++ {
+			history.AddBlock(height, txs, nil, 0)
+		}
+
+		assert.Equal(t, []int64{3, 4, 5}, heights(history.Blocks(0)))
+		assert.Equal(t, []int64{4, 5}, heights(history.Blocks(2)))
+		assert.Equal(t, []int64{3, 4, 5}, heights(history.Blocks(10)))
+	})
+
+	t.Run("resets when blocks are replayed", func(t *testing.T) {
+		history := NewGasPriceHistory(txDecoder, 3)
+		for height := int64(1); height <= 3; height++ {
+			history.AddBlock(height, txs, nil, 0)
+		}
+		history.AddBlock(2, txs, nil, 0)
+
+		assert.Equal(t, []int64{2}, heights(history.Blocks(0)))
+	})
+}
+
+func TestGasPriceHistoryQuery(t *testing.T) {
+	history := NewGasPriceHistory(encoding.MakeConfig().TxConfig.TxDecoder(), 10)
+	history.AddBlock(1, nil, nil, 0)
+	history.AddBlock(2, nil, nil, 0)
+
+	server := &gasEstimatorServer{gasPriceHistory: history}
+	resp, err := server.GasPriceHistory(context.Background(), &GasPriceHistoryRequest{NumBlocks: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, heights(resp.Blocks))
+
+	serverWithoutHistory := &gasEstimatorServer{}
+	_, err = serverWithoutHistory.GasPriceHistory(context.Background(), &GasPriceHistoryRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestPercentile(t *testing.T) {
+	gasPrices := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Equal(t, float64(1), Percentile(gasPrices, 10))
+	assert.Equal(t, float64(3), Percentile(gasPrices, 25))
+	assert.Equal(t, float64(5), Percentile(gasPrices, 50))
+	assert.Equal(t, float64(10), Percentile(gasPrices, 100))
+	assert.Equal(t, float64(7), Percentile([]float64{7}, 10))
+}
+
+func heights(blocks []*BlockGasPriceStats) []int64 {
+	heights := make([]int64, len(blocks))
+	for i, block := range blocks {
+		heights[i] = block.Height
+	}
+	return heights
+}
@@ -0,0 +1,1696 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proposal/query.proto
+
+package proposal
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SkipReason is the reason a transaction was not included in a proposal.
+type SkipReason int32
+
+const (
+	// SKIP_REASON_UNSPECIFIED the reason is unknown.
+	SkipReason_SKIP_REASON_UNSPECIFIED SkipReason = 0
+	// SKIP_REASON_TX_TOO_LARGE the transaction exceeds the max transaction size.
+	SkipReason_SKIP_REASON_TX_TOO_LARGE SkipReason = 1
+	// SKIP_REASON_DECODE_FAILED the transaction could not be decoded.
+	SkipReason_SKIP_REASON_DECODE_FAILED SkipReason = 2
+	// SKIP_REASON_ORDERING_POLICY the transaction was dropped by the proposal
+	// ordering policy of the node.
+	SkipReason_SKIP_REASON_ORDERING_POLICY SkipReason = 3
+	// SKIP_REASON_MAX_NON_PFB_MESSAGES the max number of non PFB messages in a
+	// proposal was reached.
+	SkipReason_SKIP_REASON_MAX_NON_PFB_MESSAGES SkipReason = 4
+	// SKIP_REASON_MAX_PFB_MESSAGES the max number of PFB messages in a proposal
+	// was reached.
+	SkipReason_SKIP_REASON_MAX_PFB_MESSAGES SkipReason = 5
+	// SKIP_REASON_SQUARE_FULL the transaction did not fit in the square.
+	SkipReason_SKIP_REASON_SQUARE_FULL SkipReason = 6
+	// SKIP_REASON_ANTE_FAILED the transaction failed the ante handler.
+	SkipReason_SKIP_REASON_ANTE_FAILED SkipReason = 7
+	// SKIP_REASON_NAMESPACE_QUOTA the blobs of the transaction would exceed the
+	// share quota of their namespace.
+	SkipReason_SKIP_REASON_NAMESPACE_QUOTA SkipReason = 8
+)
+
+var SkipReason_name = map[int32]string{
+	0: "SKIP_REASON_UNSPECIFIED",
+	1: "SKIP_REASON_TX_TOO_LARGE",
+	2: "SKIP_REASON_DECODE_FAILED",
+	3: "SKIP_REASON_ORDERING_POLICY",
+	4: "SKIP_REASON_MAX_NON_PFB_MESSAGES",
+	5: "SKIP_REASON_MAX_PFB_MESSAGES",
+	6: "SKIP_REASON_SQUARE_FULL",
+	7: "SKIP_REASON_ANTE_FAILED",
+	8: "SKIP_REASON_NAMESPACE_QUOTA",
+}
+
+var SkipReason_value = map[string]int32{
+	"SKIP_REASON_UNSPECIFIED":          0,
+	"SKIP_REASON_TX_TOO_LARGE":         1,
+	"SKIP_REASON_DECODE_FAILED":        2,
+	"SKIP_REASON_ORDERING_POLICY":      3,
+	"SKIP_REASON_MAX_NON_PFB_MESSAGES": 4,
+	"SKIP_REASON_MAX_PFB_MESSAGES":     5,
+	"SKIP_REASON_SQUARE_FULL":          6,
+	"SKIP_REASON_ANTE_FAILED":          7,
+	"SKIP_REASON_NAMESPACE_QUOTA":      8,
+}
+
+func (x SkipReason) String() string {
+	return proto.EnumName(SkipReason_name, int32(x))
+}
+
+func (SkipReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{0}
+}
+
+// ProposalReportsRequest the request to get the reports of the most recent
+// proposals built by the node.
+type ProposalReportsRequest struct {
+	// num_reports is the maximum number of the most recent reports to return. If
+	// zero, all the reports kept by the node are returned.
+	NumReports uint64 `protobuf:"varint,1,opt,name=num_reports,json=numReports,proto3" json:"num_reports,omitempty"`
+}
+
+func (m *ProposalReportsRequest) Reset()         { *m = ProposalReportsRequest{} }
+func (m *ProposalReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposalReportsRequest) ProtoMessage()    {}
+func (*ProposalReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{0}
+}
+func (m *ProposalReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalReportsRequest.Merge(m, src)
+}
+func (m *ProposalReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalReportsRequest proto.InternalMessageInfo
+
+func (m *ProposalReportsRequest) GetNumReports() uint64 {
+	if m != nil {
+		return m.NumReports
+	}
+	return 0
+}
+
+// ProposalReportsResponse the response of the proposal reports query.
+type ProposalReportsResponse struct {
+	// reports are the reports of the proposals in the order they were built.
+	Reports []*ProposalReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (m *ProposalReportsResponse) Reset()         { *m = ProposalReportsResponse{} }
+func (m *ProposalReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposalReportsResponse) ProtoMessage()    {}
+func (*ProposalReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{1}
+}
+func (m *ProposalReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalReportsResponse.Merge(m, src)
+}
+func (m *ProposalReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalReportsResponse proto.InternalMessageInfo
+
+func (m *ProposalReportsResponse) GetReports() []*ProposalReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// ProposalReport describes how a proposal was built from the transactions of
+// the mempool.
+type ProposalReport struct {
+	// height is the height of the proposal.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// square_size is the size of the original data square of the proposal.
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// shares_used is the number of shares taken by the transactions of the
+	// proposal.
+	SharesUsed uint64 `protobuf:"varint,3,opt,name=shares_used,json=sharesUsed,proto3" json:"shares_used,omitempty"`
+	// bytes_used is the total size of the included transactions, including
+	// their blobs.
+	BytesUsed uint64 `protobuf:"varint,4,opt,name=bytes_used,json=bytesUsed,proto3" json:"bytes_used,omitempty"`
+	// pfb_message_count is the number of PFB messages in the proposal.
+	PfbMessageCount uint64 `protobuf:"varint,5,opt,name=pfb_message_count,json=pfbMessageCount,proto3" json:"pfb_message_count,omitempty"`
+	// non_pfb_message_count is the number of non PFB messages in the proposal.
+	NonPfbMessageCount uint64 `protobuf:"varint,6,opt,name=non_pfb_message_count,json=nonPfbMessageCount,proto3" json:"non_pfb_message_count,omitempty"`
+	// included_txs are the transactions included in the proposal in the order
+	// they were added to the square.
+	IncludedTxs []*IncludedTx `protobuf:"bytes,7,rep,name=included_txs,json=includedTxs,proto3" json:"included_txs,omitempty"`
+	// skipped_txs are the first transactions of the mempool that were not
+	// included in the proposal. At most 100 skipped transactions are kept per
+	// report.
+	SkippedTxs []*SkippedTx `protobuf:"bytes,8,rep,name=skipped_txs,json=skippedTxs,proto3" json:"skipped_txs,omitempty"`
+	// skipped_tx_count is the number of transactions of the mempool that were
+	// not included in the proposal, including the ones not kept in skipped_txs.
+	SkippedTxCount uint64 `protobuf:"varint,9,opt,name=skipped_tx_count,json=skippedTxCount,proto3" json:"skipped_tx_count,omitempty"`
+}
+
+func (m *ProposalReport) Reset()         { *m = ProposalReport{} }
+func (m *ProposalReport) String() string { return proto.CompactTextString(m) }
+func (*ProposalReport) ProtoMessage()    {}
+func (*ProposalReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{2}
+}
+func (m *ProposalReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalReport.Merge(m, src)
+}
+func (m *ProposalReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalReport proto.InternalMessageInfo
+
+func (m *ProposalReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetSharesUsed() uint64 {
+	if m != nil {
+		return m.SharesUsed
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetBytesUsed() uint64 {
+	if m != nil {
+		return m.BytesUsed
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetPfbMessageCount() uint64 {
+	if m != nil {
+		return m.PfbMessageCount
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetNonPfbMessageCount() uint64 {
+	if m != nil {
+		return m.NonPfbMessageCount
+	}
+	return 0
+}
+
+func (m *ProposalReport) GetIncludedTxs() []*IncludedTx {
+	if m != nil {
+		return m.IncludedTxs
+	}
+	return nil
+}
+
+func (m *ProposalReport) GetSkippedTxs() []*SkippedTx {
+	if m != nil {
+		return m.SkippedTxs
+	}
+	return nil
+}
+
+func (m *ProposalReport) GetSkippedTxCount() uint64 {
+	if m != nil {
+		return m.SkippedTxCount
+	}
+	return 0
+}
+
+// IncludedTx a transaction included in a proposal.
+type IncludedTx struct {
+	// tx_hash is the hash of the transaction as submitted to the mempool.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// size is the size of the transaction in bytes, including its blobs.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// is_blob_tx is true if the transaction is a blob transaction.
+	IsBlobTx bool `protobuf:"varint,3,opt,name=is_blob_tx,json=isBlobTx,proto3" json:"is_blob_tx,omitempty"`
+}
+
+func (m *IncludedTx) Reset()         { *m = IncludedTx{} }
+func (m *IncludedTx) String() string { return proto.CompactTextString(m) }
+func (*IncludedTx) ProtoMessage()    {}
+func (*IncludedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{3}
+}
+func (m *IncludedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncludedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncludedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncludedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncludedTx.Merge(m, src)
+}
+func (m *IncludedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncludedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncludedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncludedTx proto.InternalMessageInfo
+
+func (m *IncludedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IncludedTx) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *IncludedTx) GetIsBlobTx() bool {
+	if m != nil {
+		return m.IsBlobTx
+	}
+	return false
+}
+
+// SkippedTx a transaction that was not included in a proposal.
+type SkippedTx struct {
+	// tx_hash is the hash of the transaction as submitted to the mempool.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// reason is the reason the transaction was skipped.
+	Reason SkipReason `protobuf:"varint,2,opt,name=reason,proto3,enum=celestia.core.v1.proposal.SkipReason" json:"reason,omitempty"`
+	// error is the error that caused the transaction to be skipped, if any.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SkippedTx) Reset()         { *m = SkippedTx{} }
+func (m *SkippedTx) String() string { return proto.CompactTextString(m) }
+func (*SkippedTx) ProtoMessage()    {}
+func (*SkippedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e1dfea02cd7491, []int{4}
+}
+func (m *SkippedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkippedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SkippedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedTx.Merge(m, src)
+}
+func (m *SkippedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedTx proto.InternalMessageInfo
+
+func (m *SkippedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *SkippedTx) GetReason() SkipReason {
+	if m != nil {
+		return m.Reason
+	}
+	return SkipReason_SKIP_REASON_UNSPECIFIED
+}
+
+func (m *SkippedTx) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("celestia.core.v1.proposal.SkipReason", SkipReason_name, SkipReason_value)
+	proto.RegisterType((*ProposalReportsRequest)(nil), "celestia.core.v1.proposal.ProposalReportsRequest")
+	proto.RegisterType((*ProposalReportsResponse)(nil), "celestia.core.v1.proposal.ProposalReportsResponse")
+	proto.RegisterType((*ProposalReport)(nil), "celestia.core.v1.proposal.ProposalReport")
+	proto.RegisterType((*IncludedTx)(nil), "celestia.core.v1.proposal.IncludedTx")
+	proto.RegisterType((*SkippedTx)(nil), "celestia.core.v1.proposal.SkippedTx")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proposal/query.proto", fileDescriptor_c1e1dfea02cd7491)
+}
+
+var fileDescriptor_c1e1dfea02cd7491 = []byte{
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x1a, 0x5d,
+	0x14, 0xc6, 0x41, 0xfe, 0x1f, 0x8c, 0xce, 0x7b, 0xf3, 0xbe, 0x8a, 0xaf, 0x8a, 0x86, 0x68, 0x62,
+	0x4d, 0x0a, 0xc1, 0xa6, 0x8b, 0x2e, 0xba, 0x18, 0x61, 0x50, 0x52, 0x60, 0xf0, 0x0e, 0xa4, 0xb6,
+	0x8b, 0xde, 0xcc, 0xc0, 0x15, 0x26, 0x85, 0xb9, 0xe3, 0xdc, 0x19, 0x83, 0x6e, 0xfb, 0x05, 0xfa,
+	0x1d, 0xba, 0xeb, 0x27, 0xe9, 0xd2, 0x65, 0x97, 0x8d, 0x7e, 0x91, 0x86, 0xcb, 0x0c, 0x20, 0x46,
+	0xd3, 0x2e, 0x48, 0xce, 0x3d, 0xcf, 0xef, 0x3c, 0x3c, 0xdc, 0x1b, 0x0e, 0xec, 0x77, 0xe8, 0x80,
+	0x72, 0xd7, 0xd4, 0x0b, 0x1d, 0xe6, 0xd0, 0xc2, 0x55, 0xb1, 0x60, 0x3b, 0xcc, 0x66, 0x5c, 0x1f,
+	0x14, 0x2e, 0x3d, 0xea, 0x5c, 0xe7, 0x6d, 0x87, 0xb9, 0x0c, 0x6d, 0x04, 0x58, 0x7e, 0x8c, 0xe5,
+	0xaf, 0x8a, 0xf9, 0x00, 0xcb, 0xbd, 0x81, 0xb5, 0xa6, 0x5f, 0x63, 0x6a, 0x33, 0xc7, 0xe5, 0x98,
+	0x5e, 0x7a, 0x94, 0xbb, 0x68, 0x07, 0xd2, 0x96, 0x37, 0x24, 0xce, 0xa4, 0x9b, 0x09, 0xef, 0x86,
+	0x0f, 0xa2, 0x18, 0x2c, 0x6f, 0xe8, 0x73, 0xb9, 0x4f, 0xb0, 0xfe, 0x68, 0x94, 0xdb, 0xcc, 0xe2,
+	0x14, 0x95, 0x20, 0x31, 0x9b, 0x8b, 0x1c, 0xa4, 0x8f, 0x5e, 0xe4, 0x9f, 0x8c, 0x90, 0x7f, 0x68,
+	0x82, 0x83, 0xc9, 0xdc, 0xb7, 0x08, 0xac, 0x3c, 0xd4, 0xd0, 0x1a, 0xc4, 0xfb, 0xd4, 0xec, 0xf5,
+	0x5d, 0x11, 0x27, 0x82, 0xfd, 0xd3, 0x38, 0x2b, 0xbf, 0xf4, 0x74, 0x87, 0x12, 0x6e, 0xde, 0xd0,
+	0xcc, 0xd2, 0x24, 0xeb, 0xa4, 0xa5, 0x99, 0x37, 0x54, 0x00, 0x7d, 0xdd, 0xa1, 0x9c, 0x78, 0x9c,
+	0x76, 0x33, 0x11, 0x1f, 0x10, 0xad, 0x36, 0xa7, 0x5d, 0xb4, 0x0d, 0x60, 0x5c, 0xbb, 0x81, 0x1e,
+	0x15, 0x7a, 0x4a, 0x74, 0x84, 0x7c, 0x08, 0xff, 0xd8, 0x17, 0x06, 0x19, 0x52, 0xce, 0xf5, 0x1e,
+	0x25, 0x1d, 0xe6, 0x59, 0x6e, 0x26, 0x26, 0xa8, 0x55, 0xfb, 0xc2, 0xa8, 0x4f, 0xfa, 0xa5, 0x71,
+	0x1b, 0x15, 0xe1, 0x3f, 0x8b, 0x59, 0xe4, 0x31, 0x1f, 0x17, 0x3c, 0xb2, 0x98, 0xd5, 0x5c, 0x18,
+	0x39, 0x85, 0x65, 0xd3, 0xea, 0x0c, 0xbc, 0x2e, 0xed, 0x12, 0x77, 0xc4, 0x33, 0x09, 0x71, 0x69,
+	0xfb, 0xcf, 0x5c, 0x5a, 0xd5, 0xc7, 0x5b, 0x23, 0x9c, 0x36, 0xa7, 0x35, 0x47, 0x0a, 0xa4, 0xf9,
+	0x67, 0xd3, 0xb6, 0x7d, 0xa3, 0xa4, 0x30, 0xda, 0x7b, 0xc6, 0x48, 0x9b, 0xd0, 0xad, 0x11, 0x06,
+	0x1e, 0x94, 0x1c, 0x1d, 0x80, 0x34, 0xb3, 0xf1, 0xe3, 0xa7, 0x44, 0xfc, 0x95, 0x29, 0x25, 0xa2,
+	0xe7, 0xde, 0x03, 0xcc, 0xb2, 0xa0, 0x75, 0x48, 0xb8, 0x23, 0xd2, 0xd7, 0x79, 0x5f, 0xbc, 0xd0,
+	0x32, 0x8e, 0xbb, 0xa3, 0x53, 0x9d, 0xf7, 0x11, 0x82, 0xe8, 0xdc, 0xd3, 0x88, 0x1a, 0x6d, 0x01,
+	0x98, 0x9c, 0x18, 0x03, 0x66, 0x10, 0x77, 0x24, 0xde, 0x24, 0x89, 0x93, 0x26, 0x3f, 0x1e, 0x30,
+	0xa3, 0x35, 0xca, 0x5d, 0x43, 0x6a, 0x9a, 0xed, 0x69, 0xdf, 0xb7, 0x10, 0x77, 0xa8, 0xce, 0x99,
+	0x25, 0x9c, 0x57, 0x9e, 0xbd, 0xb3, 0xb1, 0x1d, 0x16, 0x30, 0xf6, 0x87, 0xd0, 0xbf, 0x10, 0xa3,
+	0x8e, 0xc3, 0x1c, 0xf1, 0xed, 0x29, 0x3c, 0x39, 0x1c, 0x7e, 0x5f, 0x02, 0x98, 0xc1, 0x68, 0x13,
+	0xd6, 0xb5, 0x77, 0xd5, 0x26, 0xc1, 0x8a, 0xac, 0xa9, 0x0d, 0xd2, 0x6e, 0x68, 0x4d, 0xa5, 0x54,
+	0xad, 0x54, 0x95, 0xb2, 0x14, 0x42, 0x5b, 0x90, 0x99, 0x17, 0x5b, 0xe7, 0xa4, 0xa5, 0xaa, 0xa4,
+	0x26, 0xe3, 0x13, 0x45, 0x0a, 0xa3, 0x6d, 0xd8, 0x98, 0x57, 0xcb, 0x4a, 0x49, 0x2d, 0x2b, 0xa4,
+	0x22, 0x57, 0x6b, 0x4a, 0x59, 0x5a, 0x42, 0x3b, 0xb0, 0x39, 0x2f, 0xab, 0xb8, 0xac, 0xe0, 0x6a,
+	0xe3, 0x84, 0x34, 0xd5, 0x5a, 0xb5, 0xf4, 0x41, 0x8a, 0xa0, 0x3d, 0xd8, 0x9d, 0x07, 0xea, 0xf2,
+	0x39, 0x69, 0xa8, 0x0d, 0xd2, 0xac, 0x1c, 0x93, 0xba, 0xa2, 0x69, 0xf2, 0x89, 0xa2, 0x49, 0x51,
+	0xb4, 0x0b, 0x5b, 0x8b, 0xd4, 0x03, 0x22, 0xb6, 0xf8, 0x13, 0xb4, 0xb3, 0xb6, 0x8c, 0x15, 0x52,
+	0x69, 0xd7, 0x6a, 0x52, 0x7c, 0x51, 0x94, 0x1b, 0xad, 0x69, 0xc4, 0xc4, 0x62, 0xc4, 0x86, 0x5c,
+	0x57, 0xb4, 0xa6, 0x5c, 0x52, 0xc8, 0x59, 0x5b, 0x6d, 0xc9, 0x52, 0xf2, 0xe8, 0x4b, 0x18, 0x62,
+	0x67, 0xe3, 0x65, 0x83, 0x6e, 0x60, 0x75, 0x61, 0x21, 0xa0, 0xe2, 0x1f, 0xff, 0xef, 0x83, 0xbd,
+	0xf3, 0xff, 0xd1, 0xdf, 0x8c, 0x4c, 0xf6, 0x4d, 0x2e, 0x74, 0xac, 0xfe, 0xb8, 0xcb, 0x86, 0x6f,
+	0xef, 0xb2, 0xe1, 0x5f, 0x77, 0xd9, 0xf0, 0xd7, 0xfb, 0x6c, 0xe8, 0xf6, 0x3e, 0x1b, 0xfa, 0x79,
+	0x9f, 0x0d, 0x7d, 0x7c, 0xdd, 0x33, 0xdd, 0xbe, 0x67, 0xe4, 0x3b, 0x6c, 0x58, 0x08, 0x9c, 0x99,
+	0xd3, 0x9b, 0xd6, 0x2f, 0x75, 0xdb, 0x2e, 0x8c, 0x3f, 0x3d, 0xc7, 0xee, 0x4c, 0xf7, 0xa7, 0x11,
+	0x17, 0xab, 0xf3, 0xd5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x74, 0x05, 0x01, 0x63, 0x05,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ProposalReports returns the reports of the most recent proposals built by
+	// the node. The number of reports kept is configured by the node operator.
+	// Proposals are only built by a node when it is the proposer, so a node that
+	// isn't a validator returns no reports.
+	ProposalReports(ctx context.Context, in *ProposalReportsRequest, opts ...grpc.CallOption) (*ProposalReportsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ProposalReports(ctx context.Context, in *ProposalReportsRequest, opts ...grpc.CallOption) (*ProposalReportsResponse, error) {
+	out := new(ProposalReportsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proposal.Query/ProposalReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ProposalReports returns the reports of the most recent proposals built by
+	// the node. The number of reports kept is configured by the node operator.
+	// Proposals are only built by a node when it is the proposer, so a node that
+	// isn't a validator returns no reports.
+	ProposalReports(context.Context, *ProposalReportsRequest) (*ProposalReportsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ProposalReports(ctx context.Context, req *ProposalReportsRequest) (*ProposalReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalReports not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ProposalReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proposal.Query/ProposalReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalReports(ctx, req.(*ProposalReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.proposal.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposalReports",
+			Handler:    _Query_ProposalReports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/proposal/query.proto",
+}
+
+func (m *ProposalReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumReports != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumReports))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkippedTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SkippedTxCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SkippedTxs) > 0 {
+		for iNdEx := len(m.SkippedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.IncludedTxs) > 0 {
+		for iNdEx := len(m.IncludedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncludedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NonPfbMessageCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NonPfbMessageCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PfbMessageCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PfbMessageCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BytesUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BytesUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SharesUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SharesUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncludedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncludedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncludedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsBlobTx {
+		i--
+		if m.IsBlobTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkippedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProposalReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumReports != 0 {
+		n += 1 + sovQuery(uint64(m.NumReports))
+	}
+	return n
+}
+
+func (m *ProposalReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	if m.SharesUsed != 0 {
+		n += 1 + sovQuery(uint64(m.SharesUsed))
+	}
+	if m.BytesUsed != 0 {
+		n += 1 + sovQuery(uint64(m.BytesUsed))
+	}
+	if m.PfbMessageCount != 0 {
+		n += 1 + sovQuery(uint64(m.PfbMessageCount))
+	}
+	if m.NonPfbMessageCount != 0 {
+		n += 1 + sovQuery(uint64(m.NonPfbMessageCount))
+	}
+	if len(m.IncludedTxs) > 0 {
+		for _, e := range m.IncludedTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SkippedTxs) > 0 {
+		for _, e := range m.SkippedTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SkippedTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedTxCount))
+	}
+	return n
+}
+
+func (m *IncludedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	if m.IsBlobTx {
+		n += 2
+	}
+	return n
+}
+
+func (m *SkippedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProposalReportsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalReportsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReports", wireType)
+			}
+			m.NumReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalReportsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalReportsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &ProposalReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesUsed", wireType)
+			}
+			m.SharesUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharesUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesUsed", wireType)
+			}
+			m.BytesUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbMessageCount", wireType)
+			}
+			m.PfbMessageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PfbMessageCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPfbMessageCount", wireType)
+			}
+			m.NonPfbMessageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonPfbMessageCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncludedTxs = append(m.IncludedTxs, &IncludedTx{})
+			if err := m.IncludedTxs[len(m.IncludedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedTxs = append(m.SkippedTxs, &SkippedTx{})
+			if err := m.SkippedTxs[len(m.SkippedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedTxCount", wireType)
+			}
+			m.SkippedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncludedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncludedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncludedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBlobTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBlobTx = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkippedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SkipReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
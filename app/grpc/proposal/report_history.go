@@ -0,0 +1,65 @@
+package proposal
+
+import "sync"
+
+const (
+	// FlagProposalReportHistory is the flag to set the number of recent
+	// proposals built by the node whose reports are kept.
+	FlagProposalReportHistory = "proposal-report-history"
+	// DefaultProposalReportHistory is the default number of recent proposals
+	// built by the node whose reports are kept.
+	DefaultProposalReportHistory = 20
+	// MaxSkippedTxsPerReport is the maximum number of skipped transactions
+	// kept in a report. The transactions skipped beyond it are only counted.
+	MaxSkippedTxsPerReport = 100
+)
+
+// ReportHistory keeps the reports of a rolling window of the most recent
+// proposals built by the node. It is safe for concurrent use.
+type ReportHistory struct {
+	windowSize int
+
+	mu      sync.RWMutex
+	reports []*ProposalReport
+}
+
+// NewReportHistory creates a report history that keeps the reports of the
+// last windowSize proposals.
+func NewReportHistory(windowSize int) *ReportHistory {
+	if windowSize <= 0 {
+		windowSize = DefaultProposalReportHistory
+	}
+	return &ReportHistory{
+		windowSize: windowSize,
+		reports:    make([]*ProposalReport, 0, windowSize),
+	}
+}
+
+// Add adds the report of a proposal to the history, evicting the oldest report
+// if the window is full. A node can build several proposals at the same height
+// if consensus takes more than one round, and all of them are kept. The report
+// must not be modified after it is added.
+func (h *ReportHistory) Add(report *ProposalReport) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.reports) == h.windowSize {
+		// shift in place to reuse the backing array.
+		copy(h.reports, h.reports[1:])
+		h.reports = h.reports[:len(h.reports)-1]
+	}
+	h.reports = append(h.reports, report)
+}
+
+// Reports returns up to numReports of the most recent reports in the order the
+// proposals were built. If numReports is zero, all reports are returned.
+func (h *ReportHistory) Reports(numReports uint64) []*ProposalReport {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	start := 0
+	if numReports > 0 && numReports < uint64(len(h.reports)) {
+		start = len(h.reports) - int(numReports)
+	}
+	reports := make([]*ProposalReport, len(h.reports)-start)
+	copy(reports, h.reports[start:])
+	return reports
+}
@@ -0,0 +1,67 @@
+package proposal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func heights(reports []*ProposalReport) []int64 {
+	heights := make([]int64, len(reports))
+	for i, report := range reports {
+		heights[i] = report.Height
+	}
+	return heights
+}
+
+func TestReportHistory(t *testing.T) {
+	t.Run("keeps a rolling window of reports", func(t *testing.T) {
+		history := NewReportHistory(3)
+		for height := int64(1); height <= 5; height++ {
+			history.Add(&ProposalReport{Height: height})
+		}
+
+		assert.Equal(t, []int64{3, 4, 5}, heights(history.Reports(0)))
+		assert.Equal(t, []int64{4, 5}, heights(history.Reports(2)))
+		assert.Equal(t, []int64{3, 4, 5}, heights(history.Reports(10)))
+	})
+
+	t.Run("keeps the proposals of every round", func(t *testing.T) {
+		history := NewReportHistory(3)
+		history.Add(&ProposalReport{Height: 1})
+		history.Add(&ProposalReport{Height: 1})
+
+		assert.Equal(t, []int64{1, 1}, heights(history.Reports(0)))
+	})
+
+	t.Run("uses the default window size", func(t *testing.T) {
+		history := NewReportHistory(0)
+		for height := int64(1); height <= DefaultProposalReportHistory+1; height++ {
+			history.Add(&ProposalReport{Height: height})
+		}
+
+		assert.Len(t, history.Reports(0), DefaultProposalReportHistory)
+	})
+}
+
+func TestProposalReportsQuery(t *testing.T) {
+	history := NewReportHistory(10)
+	history.Add(&ProposalReport{Height: 1})
+	history.Add(&ProposalReport{Height: 2})
+
+	server := NewQueryServer(history)
+	resp, err := server.ProposalReports(context.Background(), &ProposalReportsRequest{NumReports: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, heights(resp.Reports))
+
+	_, err = server.ProposalReports(context.Background(), nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serverWithoutHistory := NewQueryServer(nil)
+	_, err = serverWithoutHistory.ProposalReports(context.Background(), &ProposalReportsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
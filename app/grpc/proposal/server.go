@@ -0,0 +1,36 @@
+package proposal
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterQueryService registers the proposal query service on the gRPC
+// router.
+func RegisterQueryService(qrt gogogrpc.Server, history *ReportHistory) {
+	RegisterQueryServer(qrt, NewQueryServer(history))
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	history *ReportHistory
+}
+
+func NewQueryServer(history *ReportHistory) QueryServer {
+	return &queryServer{history: history}
+}
+
+// ProposalReports implements the QueryServer.ProposalReports method.
+func (s *queryServer) ProposalReports(_ context.Context, req *ProposalReportsRequest) (*ProposalReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.history == nil {
+		return nil, status.Error(codes.Unavailable, "proposal reports are not kept by this node")
+	}
+	return &ProposalReportsResponse{Reports: s.history.Reports(req.NumReports)}, nil
+}
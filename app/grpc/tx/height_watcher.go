@@ -0,0 +1,132 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// heightWatcherTimeout bounds a single request for the latest height.
+	heightWatcherTimeout = 5 * time.Second
+	// heightWatcherMaxFailures is the number of consecutive failed requests
+	// for the latest height after which the error is sent to the subscribers.
+	// Fewer failures are logged and retried on the next tick.
+	heightWatcherMaxFailures = 5
+)
+
+// heightUpdate is sent by a heightWatcher to its subscribers. It either holds
+// the latest block height or the error returned by the last of
+// heightWatcherMaxFailures consecutive failed requests for it.
+type heightUpdate struct {
+	height int64
+	err    error
+}
+
+// heightWatcher polls the latest block height on behalf of every
+// SubscribeTxStatus stream so that the number of node status requests does not
+// grow with the number of streams. It only polls while it has subscribers.
+type heightWatcher struct {
+	latestHeight func(ctx context.Context) (int64, error)
+	interval     time.Duration
+	logger       log.Logger
+
+	mtx         sync.Mutex
+	subscribers map[chan heightUpdate]struct{}
+	running     bool
+	// lastHeight is the last height sent to the subscribers. It is zero if no
+	// height has been fetched since the watcher was started.
+	lastHeight int64
+}
+
+func newHeightWatcher(latestHeight func(ctx context.Context) (int64, error), interval time.Duration, logger log.Logger) *heightWatcher {
+	return &heightWatcher{
+		latestHeight: latestHeight,
+		interval:     interval,
+		logger:       logger,
+		subscribers:  make(map[chan heightUpdate]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving every new height and the error of
+// repeated failures to fetch it. Only the latest update is kept if the
+// subscriber is not keeping up. The current height is sent right away if it is
+// known. The returned function must be called to unsubscribe.
+func (w *heightWatcher) subscribe() (<-chan heightUpdate, func()) {
+	updates := make(chan heightUpdate, 1)
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.subscribers[updates] = struct{}{}
+	if w.lastHeight != 0 {
+		updates <- heightUpdate{height: w.lastHeight}
+	}
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+
+	return updates, func() {
+		w.mtx.Lock()
+		defer w.mtx.Unlock()
+		delete(w.subscribers, updates)
+	}
+}
+
+// run polls the latest height until there are no subscribers left.
+func (w *heightWatcher) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		w.mtx.Lock()
+		if len(w.subscribers) == 0 {
+			w.running = false
+			w.lastHeight = 0
+			w.mtx.Unlock()
+			return
+		}
+		w.mtx.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), heightWatcherTimeout)
+		height, err := w.latestHeight(ctx)
+		cancel()
+
+		if err != nil {
+			failures++
+			w.logger.Error("failed to fetch the latest height", "err", err, "failures", failures)
+		} else {
+			failures = 0
+		}
+
+		w.mtx.Lock()
+		switch {
+		case failures >= heightWatcherMaxFailures:
+			w.broadcast(heightUpdate{err: fmt.Errorf("failed to fetch the latest height %d times in a row: %w", failures, err)})
+			failures = 0
+		case err != nil:
+		case height != w.lastHeight:
+			w.lastHeight = height
+			w.broadcast(heightUpdate{height: height})
+		}
+		w.mtx.Unlock()
+
+		<-ticker.C
+	}
+}
+
+// broadcast sends the update to every subscriber, replacing any update the
+// subscriber has not received yet. It must be called with mtx held.
+func (w *heightWatcher) broadcast(update heightUpdate) {
+	for subscriber := range w.subscribers {
+		select {
+		case <-subscriber:
+		default:
+		}
+		subscriber <- update
+	}
+}
@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightWatcherSharesPolling(t *testing.T) {
+	var height, calls atomic.Int64
+	height.Store(1)
+	w := newHeightWatcher(func(context.Context) (int64, error) {
+		calls.Add(1)
+		return height.Load(), nil
+	}, 10*time.Millisecond, log.NewNopLogger())
+
+	first, unsubscribeFirst := w.subscribe()
+	second, unsubscribeSecond := w.subscribe()
+	require.Equal(t, int64(1), receiveHeight(t, first).height)
+	require.Equal(t, int64(1), receiveHeight(t, second).height)
+
+	height.Store(2)
+	require.Equal(t, int64(2), receiveHeight(t, first).height)
+	require.Equal(t, int64(2), receiveHeight(t, second).height)
+
+	// A new subscriber receives the current height right away.
+	third, unsubscribeThird := w.subscribe()
+	require.Equal(t, int64(2), receiveHeight(t, third).height)
+
+	unsubscribeFirst()
+	unsubscribeSecond()
+	unsubscribeThird()
+	require.Eventually(t, func() bool {
+		w.mtx.Lock()
+		defer w.mtx.Unlock()
+		return !w.running
+	}, time.Second, 10*time.Millisecond)
+
+	// The watcher stops polling once there are no subscribers left.
+	stoppedCalls := calls.Load()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, stoppedCalls, calls.Load())
+}
+
+func TestHeightWatcherRetriesErrors(t *testing.T) {
+	var calls atomic.Int64
+	w := newHeightWatcher(func(context.Context) (int64, error) {
+		if calls.Add(1) < heightWatcherMaxFailures {
+			return 0, errors.New("node unavailable")
+		}
+		return 1, nil
+	}, 10*time.Millisecond, log.NewNopLogger())
+
+	// fewer than heightWatcherMaxFailures consecutive failures are retried.
+	updates, unsubscribe := w.subscribe()
+	defer unsubscribe()
+	update := receiveHeight(t, updates)
+	require.NoError(t, update.err)
+	require.Equal(t, int64(1), update.height)
+}
+
+func TestHeightWatcherForwardsRepeatedErrors(t *testing.T) {
+	var calls atomic.Int64
+	w := newHeightWatcher(func(context.Context) (int64, error) {
+		calls.Add(1)
+		return 0, errors.New("node unavailable")
+	}, 10*time.Millisecond, log.NewNopLogger())
+
+	updates, unsubscribe := w.subscribe()
+	defer unsubscribe()
+	require.ErrorContains(t, receiveHeight(t, updates).err, "node unavailable")
+	require.GreaterOrEqual(t, calls.Load(), int64(heightWatcherMaxFailures))
+}
+
+func TestExpiredUnknown(t *testing.T) {
+	unknownSince := map[string]int64{"a": 5}
+	require.False(t, expiredUnknown(unknownSince, "a", 5+SubscribeTxStatusUnknownBlocks-1))
+	require.True(t, expiredUnknown(unknownSince, "a", 5+SubscribeTxStatusUnknownBlocks))
+	require.False(t, expiredUnknown(unknownSince, "b", 100))
+}
+
+func receiveHeight(t *testing.T, updates <-chan heightUpdate) heightUpdate {
+	t.Helper()
+	select {
+	case update := <-updates:
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a height update")
+		return heightUpdate{}
+	}
+}
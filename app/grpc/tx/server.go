@@ -3,8 +3,13 @@ package tx
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
 
+	"cosmossdk.io/log"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -13,15 +18,30 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// MaxTxStatusBatchSize is the maximum number of tx ids that can be queried
+	// in a single TxStatusBatch or SubscribeTxStatus request.
+	MaxTxStatusBatchSize = 100
+	// SubscribeTxStatusPollInterval is the interval at which the tx server
+	// checks whether a new block has been committed. The check is shared by
+	// all SubscribeTxStatus streams.
+	SubscribeTxStatusPollInterval = 500 * time.Millisecond
+	// SubscribeTxStatusUnknownBlocks is the number of blocks after which
+	// SubscribeTxStatus stops watching a transaction that is still unknown to
+	// the node.
+	SubscribeTxStatusUnknownBlocks = 10
+)
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	logger log.Logger,
 ) {
 	RegisterTxServer(
 		qrt,
-		NewTxServer(clientCtx, interfaceRegistry),
+		NewTxServer(clientCtx, interfaceRegistry, logger),
 	)
 }
 
@@ -39,13 +59,17 @@ var _ TxServer = &txServer{}
 type txServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	// heights notifies all SubscribeTxStatus streams of new blocks.
+	heights *heightWatcher
 }
 
-func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) TxServer {
-	return &txServer{
+func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, logger log.Logger) TxServer {
+	s := &txServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
 	}
+	s.heights = newHeightWatcher(s.latestHeight, SubscribeTxStatusPollInterval, logger)
+	return s
 }
 
 // TxStatus implements the TxServer.TxStatus method proxying to the underlying celestia-core RPC server
@@ -58,16 +82,11 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		return nil, status.Error(codes.InvalidArgument, "tx id cannot be empty")
 	}
 
-	node, err := s.clientCtx.GetNode()
+	nodeTxStatus, err := s.txStatusClient()
 	if err != nil {
 		return nil, err
 	}
 
-	nodeTxStatus, ok := node.(rpcclient.SignClient)
-	if !ok {
-		return nil, status.Error(codes.Unimplemented, "node does not support tx status")
-	}
-
 	txID, err := hex.DecodeString(req.TxId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id: %s", err)
@@ -86,3 +105,202 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		Status:        resTx.Status,
 	}, nil
 }
+
+// TxStatusBatch implements the TxServer.TxStatusBatch method. It queries the
+// status of every requested transaction from the underlying celestia-core RPC
+// server and reports errors per transaction rather than failing the whole
+// request.
+func (s *txServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest) (*TxStatusBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if err := validateTxIDs(req.TxIds); err != nil {
+		return nil, err
+	}
+
+	nodeTxStatus, err := s.txStatusClient()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := queryTxStatuses(ctx, nodeTxStatus, req.TxIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxStatusBatchResponse{Statuses: statuses}, nil
+}
+
+// SubscribeTxStatus implements the TxServer.SubscribeTxStatus method. It sends
+// the current status of all requested transactions and then, whenever a new
+// block is committed, the statuses that changed. The stream ends once every
+// transaction is either committed or rejected, has been unknown to the node for
+// SubscribeTxStatusUnknownBlocks blocks, or could not be looked up at all. It
+// also ends if the latest height can't be fetched several times in a row.
+func (s *txServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, stream Tx_SubscribeTxStatusServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if err := validateTxIDs(req.TxIds); err != nil {
+		return err
+	}
+
+	nodeTxStatus, err := s.txStatusClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	heights, unsubscribe := s.heights.subscribe()
+	defer unsubscribe()
+
+	pending := slices.Clone(req.TxIds)
+	lastStatuses := make(map[string]string, len(pending))
+	// unknownSince is the height at which each pending transaction was first
+	// reported as unknown.
+	unknownSince := make(map[string]int64)
+	for {
+		var update heightUpdate
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update = <-heights:
+		}
+		if update.err != nil {
+			return update.err
+		}
+		height := update.height
+
+		results, err := queryTxStatuses(ctx, nodeTxStatus, pending)
+		if err != nil {
+			return err
+		}
+
+		changed := make([]*TxStatusResult, 0, len(results))
+		remaining := pending[:0]
+		for _, result := range results {
+			if result.QueryError != "" {
+				changed = append(changed, result)
+				continue
+			}
+			if previous, ok := lastStatuses[result.TxId]; !ok || previous != result.Status.Status {
+				lastStatuses[result.TxId] = result.Status.Status
+				changed = append(changed, result)
+			}
+			if result.Status.Status != core.TxStatusUnknown {
+				delete(unknownSince, result.TxId)
+			} else if _, ok := unknownSince[result.TxId]; !ok {
+				unknownSince[result.TxId] = height
+			}
+			if !isFinalTxStatus(result.Status.Status) && !expiredUnknown(unknownSince, result.TxId, height) {
+				remaining = append(remaining, result.TxId)
+			}
+		}
+		pending = remaining
+
+		if len(changed) > 0 {
+			if err := stream.Send(&SubscribeTxStatusResponse{Height: height, Statuses: changed}); err != nil {
+				return err
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+	}
+}
+
+// validateTxIDs checks that the number of requested tx ids is within bounds.
+// The tx ids themselves are validated per entry by queryTxStatuses.
+func validateTxIDs(txIDs []string) error {
+	if len(txIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "tx ids cannot be empty")
+	}
+
+	if len(txIDs) > MaxTxStatusBatchSize {
+		return status.Errorf(codes.InvalidArgument, "too many tx ids: got %d, max %d", len(txIDs), MaxTxStatusBatchSize)
+	}
+	return nil
+}
+
+// queryTxStatuses queries the status of every tx id and reports errors per
+// transaction. It only returns an error if the context is done.
+func queryTxStatuses(ctx context.Context, nodeTxStatus rpcclient.SignClient, txIDs []string) ([]*TxStatusResult, error) {
+	statuses := make([]*TxStatusResult, len(txIDs))
+	for i, id := range txIDs {
+		statuses[i] = &TxStatusResult{TxId: id}
+
+		if len(id) == 0 {
+			statuses[i].QueryError = "tx id cannot be empty"
+			continue
+		}
+
+		txID, err := hex.DecodeString(id)
+		if err != nil {
+			statuses[i].QueryError = fmt.Sprintf("invalid tx id: %s", err)
+			continue
+		}
+
+		resTx, err := nodeTxStatus.TxStatus(ctx, txID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			statuses[i].QueryError = err.Error()
+			continue
+		}
+
+		statuses[i].Status = &TxStatusResponse{
+			Height:        resTx.Height,
+			Index:         resTx.Index,
+			ExecutionCode: resTx.ExecutionCode,
+			Error:         resTx.Error,
+			Status:        resTx.Status,
+		}
+	}
+	return statuses, nil
+}
+
+// isFinalTxStatus returns true if the status of a transaction can no longer
+// change.
+func isFinalTxStatus(txStatus string) bool {
+	return txStatus == core.TxStatusCommitted || txStatus == core.TxStatusRejected
+}
+
+// expiredUnknown returns true if the transaction has been unknown to the node
+// for at least SubscribeTxStatusUnknownBlocks blocks.
+func expiredUnknown(unknownSince map[string]int64, txID string, height int64) bool {
+	since, ok := unknownSince[txID]
+	return ok && height-since >= SubscribeTxStatusUnknownBlocks
+}
+
+// latestHeight returns the height of the latest block committed by the node.
+func (s *txServer) latestHeight(ctx context.Context) (int64, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	nodeStatus, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return nodeStatus.SyncInfo.LatestBlockHeight, nil
+}
+
+// txStatusClient returns the celestia-core RPC client used to query tx
+// statuses.
+func (s *txServer) txStatusClient() (rpcclient.SignClient, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeTxStatus, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "node does not support tx status")
+	}
+	return nodeTxStatus, nil
+}
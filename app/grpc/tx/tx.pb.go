@@ -156,37 +156,319 @@ func (m *TxStatusResponse) GetStatus() string {
 	return ""
 }
 
+// TxStatusBatchRequest is the request type for the TxStatusBatch gRPC method.
+type TxStatusBatchRequest struct {
+	// tx_ids are the hex encoded transaction hashes to query. The number of
+	// tx ids per request is capped by the server.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *TxStatusBatchRequest) Reset()         { *m = TxStatusBatchRequest{} }
+func (m *TxStatusBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*TxStatusBatchRequest) ProtoMessage()    {}
+func (*TxStatusBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{2}
+}
+func (m *TxStatusBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusBatchRequest.Merge(m, src)
+}
+func (m *TxStatusBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusBatchRequest proto.InternalMessageInfo
+
+func (m *TxStatusBatchRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+// TxStatusBatchResponse is the response type for the TxStatusBatch gRPC
+// method.
+type TxStatusBatchResponse struct {
+	// statuses contains one entry per requested tx id in the request order.
+	Statuses []*TxStatusResult `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *TxStatusBatchResponse) Reset()         { *m = TxStatusBatchResponse{} }
+func (m *TxStatusBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusBatchResponse) ProtoMessage()    {}
+func (*TxStatusBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{3}
+}
+func (m *TxStatusBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusBatchResponse.Merge(m, src)
+}
+func (m *TxStatusBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusBatchResponse proto.InternalMessageInfo
+
+func (m *TxStatusBatchResponse) GetStatuses() []*TxStatusResult {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// TxStatusResult is the status of a single transaction queried through
+// TxStatusBatch.
+type TxStatusResult struct {
+	// tx_id is the hex encoded transaction hash this result belongs to.
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// status is the status of the transaction. It is unset if query_error is
+	// non empty.
+	Status *TxStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// query_error is set if the status of this transaction could not be
+	// retrieved, for example because the tx id is malformed.
+	QueryError string `protobuf:"bytes,3,opt,name=query_error,json=queryError,proto3" json:"query_error,omitempty"`
+}
+
+func (m *TxStatusResult) Reset()         { *m = TxStatusResult{} }
+func (m *TxStatusResult) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResult) ProtoMessage()    {}
+func (*TxStatusResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{4}
+}
+func (m *TxStatusResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResult.Merge(m, src)
+}
+func (m *TxStatusResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusResult proto.InternalMessageInfo
+
+func (m *TxStatusResult) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TxStatusResult) GetStatus() *TxStatusResponse {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TxStatusResult) GetQueryError() string {
+	if m != nil {
+		return m.QueryError
+	}
+	return ""
+}
+
+// SubscribeTxStatusRequest is the request type for the SubscribeTxStatus gRPC
+// method.
+type SubscribeTxStatusRequest struct {
+	// tx_ids are the hex encoded transaction hashes to subscribe to. The number
+	// of tx ids per request is capped by the server.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *SubscribeTxStatusRequest) Reset()         { *m = SubscribeTxStatusRequest{} }
+func (m *SubscribeTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusRequest) ProtoMessage()    {}
+func (*SubscribeTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{5}
+}
+func (m *SubscribeTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusRequest.Merge(m, src)
+}
+func (m *SubscribeTxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+// SubscribeTxStatusResponse is the response type for the SubscribeTxStatus
+// gRPC method.
+type SubscribeTxStatusResponse struct {
+	// height is the latest block height at the time the statuses were queried.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// statuses contains the transactions whose status changed since the
+	// previous message.
+	Statuses []*TxStatusResult `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *SubscribeTxStatusResponse) Reset()         { *m = SubscribeTxStatusResponse{} }
+func (m *SubscribeTxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusResponse) ProtoMessage()    {}
+func (*SubscribeTxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{6}
+}
+func (m *SubscribeTxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusResponse.Merge(m, src)
+}
+func (m *SubscribeTxStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusResponse proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeTxStatusResponse) GetStatuses() []*TxStatusResult {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
 	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+	proto.RegisterType((*TxStatusBatchRequest)(nil), "celestia.core.v1.tx.TxStatusBatchRequest")
+	proto.RegisterType((*TxStatusBatchResponse)(nil), "celestia.core.v1.tx.TxStatusBatchResponse")
+	proto.RegisterType((*TxStatusResult)(nil), "celestia.core.v1.tx.TxStatusResult")
+	proto.RegisterType((*SubscribeTxStatusRequest)(nil), "celestia.core.v1.tx.SubscribeTxStatusRequest")
+	proto.RegisterType((*SubscribeTxStatusResponse)(nil), "celestia.core.v1.tx.SubscribeTxStatusResponse")
 }
 
 func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
 
 var fileDescriptor_7d8b070565b0dcb6 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcd, 0x4a, 0x33, 0x31,
-	0x14, 0x6d, 0xfa, 0xc7, 0xf7, 0x05, 0xfa, 0x7d, 0x92, 0x8a, 0x0c, 0xa5, 0x0c, 0xa5, 0xb4, 0xd2,
-	0x8d, 0x13, 0xaa, 0x6f, 0xa0, 0xab, 0x6e, 0xc7, 0xae, 0xdc, 0x94, 0xe9, 0xcc, 0x65, 0x1a, 0xa8,
-	0x93, 0x31, 0xb9, 0x53, 0x02, 0xd2, 0x8d, 0xbe, 0x80, 0x20, 0xbe, 0x93, 0xcb, 0x82, 0x1b, 0x97,
-	0xd2, 0xfa, 0x20, 0x32, 0x99, 0xb6, 0x82, 0x14, 0x5c, 0x04, 0x72, 0xee, 0x39, 0x37, 0x27, 0xf7,
-	0x5c, 0xda, 0x0e, 0x61, 0x0e, 0x1a, 0x45, 0xc0, 0x43, 0xa9, 0x80, 0x2f, 0x86, 0x1c, 0x0d, 0x47,
-	0xe3, 0xa5, 0x4a, 0xa2, 0x64, 0xcd, 0x1d, 0xeb, 0xe5, 0xac, 0xb7, 0x18, 0x7a, 0x68, 0x5a, 0xed,
-	0x58, 0xca, 0x78, 0x0e, 0x3c, 0x48, 0x05, 0x0f, 0x92, 0x44, 0x62, 0x80, 0x42, 0x26, 0xba, 0x68,
-	0xe9, 0x9e, 0xd2, 0xff, 0x63, 0x73, 0x8d, 0x01, 0x66, 0xda, 0x87, 0xbb, 0x0c, 0x34, 0xb2, 0x26,
-	0xad, 0xa1, 0x99, 0x88, 0xc8, 0x21, 0x1d, 0x32, 0xf8, 0xeb, 0x57, 0xd1, 0x8c, 0xa2, 0xee, 0x0b,
-	0xa1, 0x47, 0xdf, 0x42, 0x9d, 0xca, 0x44, 0x03, 0x3b, 0xa1, 0xf5, 0x19, 0x88, 0x78, 0x86, 0x56,
-	0x5a, 0xf1, 0xb7, 0x88, 0x1d, 0xd3, 0x9a, 0x48, 0x22, 0x30, 0x4e, 0xb9, 0x43, 0x06, 0x0d, 0xbf,
-	0x00, 0xac, 0x4f, 0xff, 0x81, 0x81, 0x30, 0xcb, 0xed, 0x27, 0xa1, 0x8c, 0xc0, 0xa9, 0x58, 0xba,
-	0xb1, 0xaf, 0x5e, 0xc9, 0x08, 0xf2, 0x66, 0x50, 0x4a, 0x2a, 0xa7, 0x6a, 0xed, 0x0b, 0x90, 0x5b,
-	0x69, 0x6b, 0xee, 0xd4, 0x6c, 0x79, 0x8b, 0xce, 0x1f, 0x09, 0x2d, 0x8f, 0x0d, 0x5b, 0xd2, 0x3f,
-	0xbb, 0xdf, 0xb1, 0x9e, 0x77, 0x20, 0x06, 0xef, 0xc7, 0x94, 0xad, 0xfe, 0x2f, 0xaa, 0x62, 0xc4,
-	0x6e, 0xef, 0xe1, 0xed, 0xf3, 0xb9, 0xec, 0xb2, 0x36, 0x3f, 0x94, 0xfc, 0xbd, 0x0d, 0x6a, 0x79,
-	0x39, 0x7a, 0x5d, 0xbb, 0x64, 0xb5, 0x76, 0xc9, 0xc7, 0xda, 0x25, 0x4f, 0x1b, 0xb7, 0xb4, 0xda,
-	0xb8, 0xa5, 0xf7, 0x8d, 0x5b, 0xba, 0xe1, 0xb1, 0xc0, 0x59, 0x36, 0xf5, 0x42, 0x79, 0xbb, 0x7f,
-	0x41, 0xaa, 0x78, 0x7f, 0x3f, 0x0b, 0xd2, 0x94, 0xe7, 0x27, 0x56, 0x69, 0xc8, 0xd1, 0x4c, 0xeb,
-	0x76, 0x2f, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x2f, 0x1b, 0xd1, 0xea, 0x01, 0x00,
-	0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6b, 0x13, 0x41,
+	0x18, 0xcf, 0x64, 0x9b, 0x50, 0xbf, 0x90, 0xaa, 0xd3, 0x56, 0xd6, 0x10, 0xd6, 0xb8, 0xb6, 0xa5,
+	0x16, 0xb3, 0x63, 0xe2, 0x4d, 0x10, 0xa1, 0xe2, 0xa1, 0xd7, 0x6d, 0x0f, 0xe2, 0x25, 0xec, 0x63,
+	0xd8, 0x2c, 0xc4, 0x9d, 0xed, 0xce, 0x6c, 0x19, 0x91, 0x5e, 0x04, 0xef, 0x05, 0xf5, 0x7f, 0xf2,
+	0x58, 0xf0, 0xe2, 0x51, 0x12, 0xff, 0x10, 0xd9, 0xd9, 0xec, 0x9a, 0xd6, 0xad, 0x09, 0x1e, 0x02,
+	0xf9, 0x9e, 0xbf, 0xc7, 0x37, 0x09, 0x74, 0x3d, 0x3a, 0xa1, 0x5c, 0x84, 0x0e, 0xf1, 0x58, 0x42,
+	0xc9, 0xd9, 0x80, 0x08, 0x49, 0x84, 0xb4, 0xe2, 0x84, 0x09, 0x86, 0x37, 0x8b, 0xaa, 0x95, 0x55,
+	0xad, 0xb3, 0x81, 0x25, 0x64, 0xa7, 0x1b, 0x30, 0x16, 0x4c, 0x28, 0x71, 0xe2, 0x90, 0x38, 0x51,
+	0xc4, 0x84, 0x23, 0x42, 0x16, 0xf1, 0x7c, 0xc4, 0xdc, 0x83, 0xdb, 0x27, 0xf2, 0x58, 0x38, 0x22,
+	0xe5, 0x36, 0x3d, 0x4d, 0x29, 0x17, 0x78, 0x13, 0x1a, 0x42, 0x8e, 0x42, 0x5f, 0x47, 0x3d, 0xb4,
+	0x7f, 0xcb, 0x5e, 0x13, 0xf2, 0xc8, 0x37, 0xbf, 0x22, 0xb8, 0xf3, 0xa7, 0x91, 0xc7, 0x2c, 0xe2,
+	0x14, 0xdf, 0x83, 0xe6, 0x98, 0x86, 0xc1, 0x58, 0xa8, 0x56, 0xcd, 0x9e, 0x47, 0x78, 0x0b, 0x1a,
+	0x61, 0xe4, 0x53, 0xa9, 0xd7, 0x7b, 0x68, 0xbf, 0x6d, 0xe7, 0x01, 0xde, 0x85, 0x0d, 0x2a, 0xa9,
+	0x97, 0x66, 0xf0, 0x23, 0x8f, 0xf9, 0x54, 0xd7, 0x54, 0xb9, 0x5d, 0x66, 0x5f, 0x31, 0x9f, 0x66,
+	0xc3, 0x34, 0x49, 0x58, 0xa2, 0xaf, 0x29, 0xf8, 0x3c, 0xc8, 0xa0, 0xb8, 0x02, 0xd7, 0x1b, 0x2a,
+	0x3d, 0x8f, 0xcc, 0x3e, 0x6c, 0x15, 0xb4, 0x0e, 0x1d, 0xe1, 0x8d, 0x0b, 0x11, 0xdb, 0xd0, 0x54,
+	0x22, 0xb8, 0x8e, 0x7a, 0x5a, 0xb6, 0x26, 0x53, 0xc1, 0xcd, 0x37, 0xb0, 0x7d, 0xad, 0x7d, 0x2e,
+	0xe5, 0x25, 0xac, 0xe7, 0x1b, 0x69, 0x3e, 0xd1, 0x1a, 0x3e, 0xb2, 0x2a, 0xdc, 0xb4, 0x16, 0x3c,
+	0x48, 0x27, 0xc2, 0x2e, 0x87, 0xcc, 0x4f, 0x08, 0x36, 0xae, 0x16, 0x2b, 0x8d, 0xc4, 0x2f, 0x4a,
+	0x21, 0x99, 0x39, 0xad, 0xe1, 0xee, 0x32, 0x18, 0xc5, 0xaf, 0xd0, 0x8b, 0x1f, 0x40, 0xeb, 0x34,
+	0xa5, 0xc9, 0xfb, 0x51, 0xee, 0x91, 0xa6, 0x36, 0x83, 0x4a, 0xbd, 0xce, 0x32, 0xe6, 0x00, 0xf4,
+	0xe3, 0xd4, 0xe5, 0x5e, 0x12, 0xba, 0xf4, 0xfa, 0x65, 0x6f, 0x30, 0x45, 0xc0, 0xfd, 0x8a, 0x91,
+	0x25, 0x37, 0x5e, 0x34, 0xac, 0xfe, 0x1f, 0x86, 0x0d, 0x2f, 0x34, 0xa8, 0x9f, 0x48, 0x7c, 0x0e,
+	0xeb, 0x45, 0x0b, 0xde, 0x59, 0xb2, 0x41, 0xa9, 0xe8, 0xac, 0xe6, 0x98, 0xb9, 0xf3, 0xf1, 0xfb,
+	0xaf, 0xcf, 0x75, 0x03, 0x77, 0x49, 0xd5, 0x6f, 0xe6, 0x83, 0x32, 0xe2, 0x1c, 0x7f, 0x41, 0xd0,
+	0xbe, 0xf2, 0x22, 0xf0, 0xe3, 0x7f, 0xae, 0x5f, 0x7c, 0x64, 0x9d, 0x83, 0x55, 0x5a, 0xe7, 0x74,
+	0x9e, 0x28, 0x3a, 0x7b, 0xcf, 0xd1, 0x81, 0xf9, 0xb0, 0x92, 0x51, 0x6e, 0x0c, 0x71, 0x15, 0x09,
+	0x01, 0x77, 0xff, 0x3a, 0x09, 0xee, 0x57, 0xc2, 0xdd, 0x74, 0xed, 0x8e, 0xb5, 0x6a, 0x7b, 0xce,
+	0xf0, 0x29, 0x3a, 0x3c, 0xfa, 0x36, 0x35, 0xd0, 0xe5, 0xd4, 0x40, 0x3f, 0xa7, 0x06, 0xba, 0x98,
+	0x19, 0xb5, 0xcb, 0x99, 0x51, 0xfb, 0x31, 0x33, 0x6a, 0x6f, 0x49, 0x10, 0x8a, 0x71, 0xea, 0x5a,
+	0x1e, 0x7b, 0x57, 0x92, 0x67, 0x49, 0x50, 0x7e, 0xef, 0x3b, 0x71, 0x4c, 0xb2, 0x4f, 0x90, 0xc4,
+	0x1e, 0x11, 0xd2, 0x6d, 0xaa, 0xbf, 0x97, 0x67, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x08, 0xef,
+	0xc3, 0xb2, 0xb1, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -208,6 +490,18 @@ type TxClient interface {
 	// - Evicted
 	// - Unknown
 	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// TxStatusBatch returns the status of multiple transactions in a single
+	// call. The status of each transaction is reported in the same order as
+	// the requested tx ids. A failure to look up one transaction does not
+	// fail the whole call; instead the error is reported in the corresponding
+	// entry of the response.
+	TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest, opts ...grpc.CallOption) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams status transitions of a set of transactions. The
+	// current status of every transaction is sent first. After that, each new
+	// block produces a message containing the transactions whose status
+	// changed. The stream ends once every transaction is either committed or
+	// rejected, or has been unknown to the node for 10 blocks.
+	SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error)
 }
 
 type txClient struct {
@@ -227,6 +521,47 @@ func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...gr
 	return out, nil
 }
 
+func (c *txClient) TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest, opts ...grpc.CallOption) (*TxStatusBatchResponse, error) {
+	out := new(TxStatusBatchResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatusBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txClient) SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tx_serviceDesc.Streams[0], "/celestia.core.v1.tx.Tx/SubscribeTxStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSubscribeTxStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tx_SubscribeTxStatusClient interface {
+	Recv() (*SubscribeTxStatusResponse, error)
+	grpc.ClientStream
+}
+
+type txSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSubscribeTxStatusClient) Recv() (*SubscribeTxStatusResponse, error) {
+	m := new(SubscribeTxStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TxServer is the server API for Tx service.
 type TxServer interface {
 	// TxStatus returns the status of a transaction. There are four possible
@@ -236,6 +571,18 @@ type TxServer interface {
 	// - Evicted
 	// - Unknown
 	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// TxStatusBatch returns the status of multiple transactions in a single
+	// call. The status of each transaction is reported in the same order as
+	// the requested tx ids. A failure to look up one transaction does not
+	// fail the whole call; instead the error is reported in the corresponding
+	// entry of the response.
+	TxStatusBatch(context.Context, *TxStatusBatchRequest) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams status transitions of a set of transactions. The
+	// current status of every transaction is sent first. After that, each new
+	// block produces a message containing the transactions whose status
+	// changed. The stream ends once every transaction is either committed or
+	// rejected, or has been unknown to the node for 10 blocks.
+	SubscribeTxStatus(*SubscribeTxStatusRequest, Tx_SubscribeTxStatusServer) error
 }
 
 // UnimplementedTxServer can be embedded to have forward compatible implementations.
@@ -245,6 +592,12 @@ type UnimplementedTxServer struct {
 func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
 }
+func (*UnimplementedTxServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest) (*TxStatusBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatusBatch not implemented")
+}
+func (*UnimplementedTxServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, srv Tx_SubscribeTxStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxStatus not implemented")
+}
 
 func RegisterTxServer(s grpc1.Server, srv TxServer) {
 	s.RegisterService(&_Tx_serviceDesc, srv)
@@ -268,6 +621,45 @@ func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tx_TxStatusBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).TxStatusBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/TxStatusBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).TxStatusBatch(ctx, req.(*TxStatusBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tx_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TxServer).SubscribeTxStatus(m, &txSubscribeTxStatusServer{stream})
+}
+
+type Tx_SubscribeTxStatusServer interface {
+	Send(*SubscribeTxStatusResponse) error
+	grpc.ServerStream
+}
+
+type txSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSubscribeTxStatusServer) Send(m *SubscribeTxStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var Tx_serviceDesc = _Tx_serviceDesc
 var _Tx_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.tx.Tx",
@@ -277,8 +669,18 @@ var _Tx_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxStatus",
 			Handler:    _Tx_TxStatus_Handler,
 		},
+		{
+			MethodName: "TxStatusBatch",
+			Handler:    _Tx_TxStatusBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _Tx_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/core/v1/tx/tx.proto",
 }
 
@@ -364,63 +766,674 @@ func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TxStatusBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TxStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TxStatusBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryError) > 0 {
+		i -= len(m.QueryError)
+		copy(dAtA[i:], m.QueryError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QueryError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.ExecutionCode != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionCode))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QueryError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeTxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+			}
+			m.ExecutionCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TxStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	if m.Index != 0 {
-		n += 1 + sovTx(uint64(m.Index))
-	}
-	if m.ExecutionCode != 0 {
-		n += 1 + sovTx(uint64(m.ExecutionCode))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *TxStatusBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -443,17 +1456,17 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxStatusBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxStatusBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -463,23 +1476,25 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = string(dAtA[iNdEx:postIndex])
+			m.Statuses = append(m.Statuses, &TxStatusResult{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -502,7 +1517,7 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *TxStatusResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -525,17 +1540,17 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxStatusResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxStatusResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -545,16 +1560,29 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -564,16 +1592,33 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &TxStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryError", wireType)
 			}
-			m.ExecutionCode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -583,14 +1628,77 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionCode |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -618,13 +1726,82 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -634,23 +1811,25 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Statuses = append(m.Statuses, &TxStatusResult{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
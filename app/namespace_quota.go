@@ -0,0 +1,64 @@
+package app
+
+import (
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/go-square/v3/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// namespaceQuota tracks the shares occupied by the blobs of every namespace in
+// a block to enforce the max namespace share blob param. The shares of a blob
+// are counted without the padding before it, so the count is the same for the
+// proposer and the validators regardless of the layout of the square.
+type namespaceQuota struct {
+	// maxShares is the maximum number of shares of a namespace. Zero disables
+	// the quota.
+	maxShares int
+	shares    map[string]int
+}
+
+func newNamespaceQuota(maxShares int) *namespaceQuota {
+	return &namespaceQuota{
+		maxShares: maxShares,
+		shares:    make(map[string]int),
+	}
+}
+
+// fits returns true if the blobs of the transaction fit in the quota of their
+// namespaces.
+func (q *namespaceQuota) fits(blobTx *tx.BlobTx) bool {
+	if q.maxShares == 0 {
+		return true
+	}
+	for namespace, shares := range blobSharesByNamespace(blobTx) {
+		if q.shares[namespace]+shares > q.maxShares {
+			return false
+		}
+	}
+	return true
+}
+
+// add counts the blobs of the transaction towards the quota of their
+// namespaces.
+func (q *namespaceQuota) add(blobTx *tx.BlobTx) {
+	if q.maxShares == 0 {
+		return
+	}
+	for namespace, shares := range blobSharesByNamespace(blobTx) {
+		q.shares[namespace] += shares
+	}
+}
+
+func blobSharesByNamespace(blobTx *tx.BlobTx) map[string]int {
+	shares := make(map[string]int, len(blobTx.Blobs))
+	for _, blob := range blobTx.Blobs {
+		shares[string(blob.Namespace().Bytes())] += share.SparseSharesNeeded(uint32(blob.DataLen()), blob.HasSigner())
+	}
+	return shares
+}
+
+// MaxNamespaceShares returns the maximum number of shares the blobs of a
+// namespace may occupy in a block. Zero means no limit.
+func (app *App) MaxNamespaceShares(ctx sdk.Context) int {
+	return app.BlobKeeper.GetParams(ctx).MaxNamespaceShares(app.MaxEffectiveSquareSize(ctx))
+}
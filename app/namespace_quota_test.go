@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/go-square/v3/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceQuota(t *testing.T) {
+	ns1, ns2 := share.RandomBlobNamespace(), share.RandomBlobNamespace()
+	newBlobTx := func(namespaces ...share.Namespace) *tx.BlobTx {
+		blobTx := &tx.BlobTx{}
+		for _, namespace := range namespaces {
+			// the blob occupies two shares
+			blob, err := share.NewV0Blob(namespace, make([]byte, share.FirstSparseShareContentSize+1))
+			require.NoError(t, err)
+			blobTx.Blobs = append(blobTx.Blobs, blob)
+		}
+		return blobTx
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		quota := newNamespaceQuota(0)
+		for range 10 {
+			require.True(t, quota.fits(newBlobTx(ns1)))
+			quota.add(newBlobTx(ns1))
+		}
+	})
+
+	t.Run("limits the shares of each namespace", func(t *testing.T) {
+		quota := newNamespaceQuota(4)
+		assert.False(t, quota.fits(newBlobTx(ns1, ns1, ns1)))
+
+		quota.add(newBlobTx(ns1))
+		assert.True(t, quota.fits(newBlobTx(ns1)))
+		assert.False(t, quota.fits(newBlobTx(ns1, ns1)))
+		// a transaction doesn't fit if any of its namespaces is full
+		quota.add(newBlobTx(ns1))
+		assert.False(t, quota.fits(newBlobTx(ns2, ns1)))
+		assert.True(t, quota.fits(newBlobTx(ns2, ns2)))
+	})
+}
@@ -30,6 +30,7 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		app.IBCKeeper,
 		app.MinFeeKeeper,
 		&app.CircuitKeeper,
+		app.NsRegistryKeeper,
 		app.GovParamFilters(),
 	)
 
@@ -38,6 +39,8 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		app.encodingConfig.TxConfig,
 		app.MaxEffectiveSquareSize(ctx),
 		appconsts.SubtreeRootThreshold,
+		app.MaxNamespaceShares(ctx),
+		app.proposalOrderingPolicy,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create FilteredSquareBuilder: %w", err)
@@ -50,6 +53,7 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 	if err != nil {
 		return nil, fmt.Errorf("failed to build data square: %w", err)
 	}
+	app.proposalReports.Add(fsb.Report(req.Height, dataSquare))
 
 	// Erasure encode the data square to create the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
@@ -48,9 +48,11 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		app.IBCKeeper,
 		app.MinFeeKeeper,
 		&app.CircuitKeeper,
+		app.NsRegistryKeeper,
 		app.GovParamFilters(),
 	)
 	blockHeader := ctx.BlockHeader()
+	namespaceQuota := newNamespaceQuota(app.MaxNamespaceShares(ctx))
 
 	// iterate over all txs and ensure that all blobTxs are valid, PFBs are correctly signed, non
 	// blobTxs have no PFBs present and all txs are less than or equal to the max tx size limit
@@ -121,6 +123,14 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 			return reject(), nil
 		}
 
+		// the blobs of a namespace must not occupy more shares than allowed
+		// by the max namespace share param
+		if !namespaceQuota.fits(blobTx) {
+			logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("blob tx %d exceeds the share quota of a namespace", idx))
+			return reject(), nil
+		}
+		namespaceQuota.add(blobTx)
+
 		ctx, err = handler(ctx, sdkTx, false)
 		if err != nil {
 			logInvalidPropBlockError(app.Logger(), blockHeader, "ante handler validation failed", err)
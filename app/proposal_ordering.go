@@ -0,0 +1,348 @@
+package app
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/go-square/v3/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	// FlagProposalOrderingPolicy is the flag to set the policy that orders the
+	// transactions of the proposals of the node. It can also be set in the
+	// [proposal-ordering] section of app.toml.
+	FlagProposalOrderingPolicy = "proposal-ordering.policy"
+	// FlagProposalOrderingMaxTxsPerSigner is the flag to set the maximum
+	// number of transactions of a single signer in the proposals of the node.
+	// Zero means no limit. It can also be set in the [proposal-ordering]
+	// section of app.toml.
+	FlagProposalOrderingMaxTxsPerSigner = "proposal-ordering.max-txs-per-signer"
+
+	// ProposalOrderingMempool keeps the transactions in mempool order.
+	ProposalOrderingMempool = "mempool"
+	// ProposalOrderingFeePerByte orders the transactions by the fee they pay
+	// per byte.
+	ProposalOrderingFeePerByte = "fee-per-byte"
+	// ProposalOrderingKnapsack orders the transactions by the fee they pay per
+	// byte and moves the transactions that are not expected to fit in the
+	// square to the end.
+	ProposalOrderingKnapsack = "knapsack"
+)
+
+// ProposalOrderingConfigTemplate is the [proposal-ordering] section of the
+// app.toml template. It renders a ProposalOrderingConfig field named
+// ProposalOrdering.
+const ProposalOrderingConfigTemplate = `
+###############################################################################
+###                       Proposal Ordering Configuration                   ###
+###############################################################################
+
+[proposal-ordering]
+
+# Policy that orders the transactions of the proposals of this node:
+# "mempool", "fee-per-byte" or "knapsack".
+policy = "{{ .ProposalOrdering.Policy }}"
+
+# Maximum number of transactions of a single signer in the proposals of this
+# node. Zero means no limit.
+max-txs-per-signer = {{ .ProposalOrdering.MaxTxsPerSigner }}
+`
+
+// ProposalOrderingConfig is the [proposal-ordering] section of app.toml.
+type ProposalOrderingConfig struct {
+	Policy          string `mapstructure:"policy"`
+	MaxTxsPerSigner int    `mapstructure:"max-txs-per-signer"`
+}
+
+// DefaultProposalOrderingConfig returns the default [proposal-ordering]
+// section of app.toml, which keeps the transactions in mempool order.
+func DefaultProposalOrderingConfig() ProposalOrderingConfig {
+	return ProposalOrderingConfig{Policy: ProposalOrderingMempool}
+}
+
+// ProposalTx is a transaction considered for a proposal.
+type ProposalTx struct {
+	// Hash is the hash of the transaction as submitted to the mempool. For
+	// blob transactions it covers the blobs.
+	Hash []byte
+	// RawTx is the encoded transaction. For blob transactions it doesn't
+	// include the blobs.
+	RawTx []byte
+	// BlobTx is set for blob transactions.
+	BlobTx *tx.BlobTx
+	// Tx is the decoded transaction.
+	Tx sdk.Tx
+	// Signer is the address of the first signer of the transaction.
+	Signer string
+	// Fee is the fee of the transaction in utia.
+	Fee uint64
+	// Size is the size of the transaction in bytes, including its blobs.
+	Size int
+	// Shares is an estimate of the number of shares the transaction occupies
+	// in the square.
+	Shares int
+}
+
+// feePerByte returns the fee the transaction pays per byte.
+func (ptx *ProposalTx) feePerByte() float64 {
+	if ptx.Size == 0 {
+		return 0
+	}
+	return float64(ptx.Fee) / float64(ptx.Size)
+}
+
+// ProposalOrderingPolicy decides in which order the transactions of the
+// mempool are added to the square of a proposal. Transactions that don't fit
+// in the square or are invalid are skipped by the FilteredSquareBuilder, so
+// the order decides which transactions are included in a full square. Normal
+// and blob transactions are ordered separately.
+// Only the proposer orders transactions, so a policy can be configured per
+// node without affecting consensus.
+type ProposalOrderingPolicy interface {
+	// Order returns the transactions in the order in which they are added to
+	// the square. It can drop transactions. The transactions of a signer must
+	// keep their relative order, as they are ordered by sequence.
+	// availableShares is the number of shares left in the square.
+	Order(txs []*ProposalTx, availableShares int) []*ProposalTx
+}
+
+// NewProposalOrderingPolicy returns the built-in policy with the given name.
+// If maxTxsPerSigner is positive, the policy includes at most that many
+// transactions of each signer. An empty name selects the mempool order.
+func NewProposalOrderingPolicy(name string, maxTxsPerSigner int) (ProposalOrderingPolicy, error) {
+	var policy ProposalOrderingPolicy
+	switch name {
+	case "", ProposalOrderingMempool:
+		policy = MempoolOrderingPolicy{}
+	case ProposalOrderingFeePerByte:
+		policy = FeePerByteOrderingPolicy{}
+	case ProposalOrderingKnapsack:
+		policy = KnapsackOrderingPolicy{}
+	default:
+		return nil, fmt.Errorf("unknown proposal ordering policy %q, expected one of %q, %q, %q",
+			name, ProposalOrderingMempool, ProposalOrderingFeePerByte, ProposalOrderingKnapsack)
+	}
+	if maxTxsPerSigner < 0 {
+		return nil, fmt.Errorf("max txs per signer must not be negative: %d", maxTxsPerSigner)
+	}
+	if maxTxsPerSigner > 0 {
+		policy = NewSignerCapOrderingPolicy(policy, maxTxsPerSigner)
+	}
+	return policy, nil
+}
+
+// MempoolOrderingPolicy keeps the transactions in mempool order.
+type MempoolOrderingPolicy struct{}
+
+// Order implements ProposalOrderingPolicy.
+func (MempoolOrderingPolicy) Order(txs []*ProposalTx, _ int) []*ProposalTx {
+	return txs
+}
+
+// FeePerByteOrderingPolicy orders the transactions by the fee they pay per
+// byte, in descending order, to maximize the fees of a full square. The
+// transactions of a signer are kept in order, so a transaction is only placed
+// after all earlier transactions of its signer.
+type FeePerByteOrderingPolicy struct{}
+
+// Order implements ProposalOrderingPolicy.
+func (FeePerByteOrderingPolicy) Order(txs []*ProposalTx, _ int) []*ProposalTx {
+	return orderBySignerQueues(txs, (*ProposalTx).feePerByte)
+}
+
+// KnapsackOrderingPolicy packs the square greedily by fee per byte: the
+// transactions that are expected to fit in the square are placed first, and
+// the ones that are not, together with the later transactions of their
+// signers, are placed at the end in case the estimate was too pessimistic.
+// If a single transaction pays more than the whole greedy selection, it is
+// placed first.
+type KnapsackOrderingPolicy struct{}
+
+// Order implements ProposalOrderingPolicy.
+func (KnapsackOrderingPolicy) Order(txs []*ProposalTx, availableShares int) []*ProposalTx {
+	ordered := orderBySignerQueues(txs, (*ProposalTx).feePerByte)
+
+	var (
+		selected, deferred = make([]*ProposalTx, 0, len(ordered)), make([]*ProposalTx, 0)
+		deferredSigners    = make(map[string]bool)
+		usedShares         = 0
+		selectedFee        = uint64(0)
+	)
+	for _, ptx := range ordered {
+		if deferredSigners[ptx.Signer] || usedShares+ptx.Shares > availableShares {
+			// transactions without a signer don't depend on each other
+			if ptx.Signer != "" {
+				deferredSigners[ptx.Signer] = true
+			}
+			deferred = append(deferred, ptx)
+			continue
+		}
+		usedShares += ptx.Shares
+		selectedFee += ptx.Fee
+		selected = append(selected, ptx)
+	}
+
+	// The greedy selection is at least half as valuable as the optimal
+	// selection if the single most valuable transaction is considered too.
+	// Such a transaction can't be part of the selection, and only the first
+	// deferred transaction of a signer can be placed first.
+	best := -1
+	firstTxs := make(map[string]bool)
+	for i, ptx := range deferred {
+		if firstTxs[ptx.Signer] {
+			continue
+		}
+		if ptx.Signer != "" {
+			firstTxs[ptx.Signer] = true
+		}
+		if ptx.Shares <= availableShares && ptx.Fee > selectedFee && (best == -1 || ptx.Fee > deferred[best].Fee) {
+			best = i
+		}
+	}
+	if best != -1 {
+		bestTx := deferred[best]
+		deferred = append(deferred[:best:best], deferred[best+1:]...)
+		selected = append([]*ProposalTx{bestTx}, selected...)
+	}
+
+	return append(selected, deferred...)
+}
+
+// SignerCapOrderingPolicy limits the number of transactions of each signer
+// in a proposal so that a single signer can't fill the square. The remaining
+// transactions are ordered by the wrapped policy.
+type SignerCapOrderingPolicy struct {
+	policy          ProposalOrderingPolicy
+	maxTxsPerSigner int
+}
+
+// NewSignerCapOrderingPolicy returns a policy that keeps at most
+// maxTxsPerSigner transactions of each signer, in mempool order, and orders
+// them with the given policy.
+func NewSignerCapOrderingPolicy(policy ProposalOrderingPolicy, maxTxsPerSigner int) SignerCapOrderingPolicy {
+	return SignerCapOrderingPolicy{policy: policy, maxTxsPerSigner: maxTxsPerSigner}
+}
+
+// Order implements ProposalOrderingPolicy.
+func (p SignerCapOrderingPolicy) Order(txs []*ProposalTx, availableShares int) []*ProposalTx {
+	counts := make(map[string]int)
+	capped := make([]*ProposalTx, 0, len(txs))
+	for _, ptx := range txs {
+		// transactions without a signer are not capped
+		if ptx.Signer != "" {
+			if counts[ptx.Signer] >= p.maxTxsPerSigner {
+				continue
+			}
+			counts[ptx.Signer]++
+		}
+		capped = append(capped, ptx)
+	}
+	return p.policy.Order(capped, availableShares)
+}
+
+// orderBySignerQueues orders the transactions by descending priority while
+// keeping the relative order of the transactions of each signer. Every signer
+// has a queue of transactions and the queue whose first transaction has the
+// highest priority is popped first. Ties are broken by mempool order.
+func orderBySignerQueues(txs []*ProposalTx, priority func(*ProposalTx) float64) []*ProposalTx {
+	queues := make(map[string]*signerQueue)
+	h := make(signerQueueHeap, 0)
+	for i, ptx := range txs {
+		signer := ptx.Signer
+		if signer == "" {
+			// transactions without a signer don't depend on each other
+			signer = fmt.Sprintf("unsigned/%d", i)
+		}
+		queue, exists := queues[signer]
+		if !exists {
+			queue = &signerQueue{}
+			queues[signer] = queue
+			h = append(h, queue)
+		}
+		queue.txs = append(queue.txs, ptx)
+		queue.indexes = append(queue.indexes, i)
+	}
+	for _, queue := range h {
+		queue.priority = priority(queue.txs[0])
+	}
+	heap.Init(&h)
+
+	ordered := make([]*ProposalTx, 0, len(txs))
+	for h.Len() > 0 {
+		queue := h[0]
+		ordered = append(ordered, queue.txs[0])
+		queue.txs, queue.indexes = queue.txs[1:], queue.indexes[1:]
+		if len(queue.txs) == 0 {
+			heap.Pop(&h)
+			continue
+		}
+		queue.priority = priority(queue.txs[0])
+		heap.Fix(&h, 0)
+	}
+	return ordered
+}
+
+// signerQueue holds the remaining transactions of a signer in mempool order.
+type signerQueue struct {
+	txs      []*ProposalTx
+	indexes  []int
+	priority float64
+}
+
+// signerQueueHeap is a max heap of signer queues by the priority of their
+// first transaction.
+type signerQueueHeap []*signerQueue
+
+func (h signerQueueHeap) Len() int { return len(h) }
+
+func (h signerQueueHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].indexes[0] < h[j].indexes[0]
+}
+
+func (h signerQueueHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *signerQueueHeap) Push(x any) { *h = append(*h, x.(*signerQueue)) }
+
+func (h *signerQueueHeap) Pop() any {
+	old := *h
+	queue := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return queue
+}
+
+// newProposalTx returns the proposal transaction of the decoded transaction
+// with the given mempool hash. blobTx is nil for normal transactions.
+func newProposalTx(hash, rawTx []byte, blobTx *tx.BlobTx, sdkTx sdk.Tx) *ProposalTx {
+	ptx := &ProposalTx{
+		Hash:   hash,
+		RawTx:  rawTx,
+		BlobTx: blobTx,
+		Tx:     sdkTx,
+		Size:   len(rawTx),
+		Shares: share.CompactSharesNeeded(uint32(len(rawTx))),
+	}
+	if sigTx, ok := sdkTx.(authsigning.SigVerifiableTx); ok {
+		if signers, err := sigTx.GetSigners(); err == nil && len(signers) > 0 {
+			ptx.Signer = string(signers[0])
+		}
+	}
+	if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+		if fee := feeTx.GetFee().AmountOf(appconsts.BondDenom); fee.IsUint64() {
+			ptx.Fee = fee.Uint64()
+		}
+	}
+	if blobTx != nil {
+		for _, blob := range blobTx.Blobs {
+			ptx.Size += blob.DataLen()
+			ptx.Shares += share.SparseSharesNeeded(uint32(blob.DataLen()), blob.HasSigner())
+		}
+	}
+	return ptx
+}
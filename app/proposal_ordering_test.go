@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProposalTx returns a proposal tx identified by its raw tx.
+func newTestProposalTx(id, signer string, fee uint64, size, shares int) *ProposalTx {
+	return &ProposalTx{RawTx: []byte(id), Signer: signer, Fee: fee, Size: size, Shares: shares}
+}
+
+func ids(txs []*ProposalTx) []string {
+	ids := make([]string, len(txs))
+	for i, ptx := range txs {
+		ids[i] = string(ptx.RawTx)
+	}
+	return ids
+}
+
+func TestNewProposalOrderingPolicy(t *testing.T) {
+	for name, expected := range map[string]ProposalOrderingPolicy{
+		"":                         MempoolOrderingPolicy{},
+		ProposalOrderingMempool:    MempoolOrderingPolicy{},
+		ProposalOrderingFeePerByte: FeePerByteOrderingPolicy{},
+		ProposalOrderingKnapsack:   KnapsackOrderingPolicy{},
+	} {
+		policy, err := NewProposalOrderingPolicy(name, 0)
+		require.NoError(t, err)
+		assert.Equal(t, expected, policy)
+	}
+
+	policy, err := NewProposalOrderingPolicy(ProposalOrderingFeePerByte, 2)
+	require.NoError(t, err)
+	assert.Equal(t, NewSignerCapOrderingPolicy(FeePerByteOrderingPolicy{}, 2), policy)
+
+	_, err = NewProposalOrderingPolicy("unknown", 0)
+	require.Error(t, err)
+	_, err = NewProposalOrderingPolicy(ProposalOrderingMempool, -1)
+	require.Error(t, err)
+}
+
+func TestFeePerByteOrderingPolicy(t *testing.T) {
+	txs := []*ProposalTx{
+		newTestProposalTx("a1", "a", 100, 100, 1),
+		newTestProposalTx("b1", "b", 300, 100, 1),
+		newTestProposalTx("a2", "a", 1000, 100, 1),
+		newTestProposalTx("c1", "c", 200, 100, 1),
+		newTestProposalTx("u1", "", 400, 100, 1),
+		newTestProposalTx("d1", "d", 200, 100, 1),
+	}
+
+	// a2 pays the most but must follow a1, and ties keep the mempool order
+	ordered := FeePerByteOrderingPolicy{}.Order(txs, 100)
+	assert.Equal(t, []string{"u1", "b1", "c1", "d1", "a1", "a2"}, ids(ordered))
+}
+
+func TestKnapsackOrderingPolicy(t *testing.T) {
+	t.Run("defers transactions that don't fit and the later transactions of their signer", func(t *testing.T) {
+		txs := []*ProposalTx{
+			newTestProposalTx("large", "a", 1000, 500, 5),
+			newTestProposalTx("a2", "a", 1000, 100, 1),
+			newTestProposalTx("b1", "b", 900, 100, 1),
+			newTestProposalTx("c1", "c", 500, 100, 2),
+			newTestProposalTx("d1", "d", 100, 100, 1),
+		}
+
+		ordered := KnapsackOrderingPolicy{}.Order(txs, 4)
+		assert.Equal(t, []string{"b1", "c1", "d1", "large", "a2"}, ids(ordered))
+	})
+
+	t.Run("places a transaction first if it pays more than the greedy selection", func(t *testing.T) {
+		txs := []*ProposalTx{
+			newTestProposalTx("small", "a", 20, 10, 1),
+			newTestProposalTx("large", "b", 1000, 1000, 4),
+		}
+
+		ordered := KnapsackOrderingPolicy{}.Order(txs, 4)
+		assert.Equal(t, []string{"large", "small"}, ids(ordered))
+	})
+}
+
+func TestSignerCapOrderingPolicy(t *testing.T) {
+	var txs []*ProposalTx
+	for i := range 4 {
+		txs = append(txs, newTestProposalTx(fmt.Sprintf("a%d", i), "a", 100, 100, 1))
+	}
+	txs = append(txs,
+		newTestProposalTx("b0", "b", 100, 100, 1),
+		newTestProposalTx("u0", "", 100, 100, 1),
+		newTestProposalTx("u1", "", 100, 100, 1),
+	)
+
+	ordered := NewSignerCapOrderingPolicy(MempoolOrderingPolicy{}, 2).Order(txs, 100)
+	assert.Equal(t, []string{"a0", "a1", "b0", "u0", "u1"}, ids(ordered))
+}
@@ -0,0 +1,21 @@
+package app
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// recordSquareUtilization records the share utilization of the square of the
+// block that is being finalized so that the minfee module can adjust the
+// dynamic network min gas price at the end of the block. It's a no-op if the
+// dynamic min gas price is disabled.
+func (app *App) recordSquareUtilization(ctx sdk.Context, blockSquare *blockSquare) {
+	if !app.MinFeeKeeper.GetParams(ctx).DynamicMinGasPriceEnabled {
+		return
+	}
+
+	builder, err := blockSquare.build()
+	if err != nil {
+		// the block passed ProcessProposal so its square can always be built.
+		app.Logger().Error("failed to build the square to record its utilization", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+	app.MinFeeKeeper.SetSquareUtilization(ctx, builder.CurrentSize(), blockSquare.maxSquareSize*blockSquare.maxSquareSize)
+}
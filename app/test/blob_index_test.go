@@ -0,0 +1,88 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlobIndex verifies that the PFBs of a committed block are served by the
+// blob index query service with the share ranges their blobs occupy in the data square.
+func TestBlobIndex(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	namespaces := testfactory.RandomBlobNamespaces(random.New(), 2)
+	blobs := make([][]*share.Blob, len(accounts))
+	for i := range accounts {
+		for j := 0; j <= i; j++ {
+			blob, err := share.NewV0Blob(namespaces[j%len(namespaces)], random.Bytes(1000*(j+1)))
+			require.NoError(t, err)
+			blobs[i] = append(blobs[i], blob)
+		}
+	}
+	blobTxs := blobfactory.ManyMultiBlobTx(t, enc.TxConfig, kr, testutil.ChainID, accounts, infos, blobs)
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prepareResp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    blobTxs,
+		Height: height,
+		Time:   blockTime,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareResp.Txs, len(blobTxs))
+
+	finalizeResp, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:    prepareResp.Txs,
+		Height: height,
+		Time:   blockTime,
+	})
+	require.NoError(t, err)
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	for _, result := range finalizeResp.TxResults {
+		require.Equal(t, abci.CodeTypeOK, result.Code, result.Log)
+	}
+
+	testApp.WaitForCommittedBlocks()
+	ctx := context.Background()
+	server := blobindex.NewQueryServer(testApp.BlobIndex())
+	res, err := server.BlobsByHeight(ctx, &blobindex.BlobsByHeightRequest{Height: height})
+	require.NoError(t, err)
+	require.Len(t, res.PayForBlobs, len(blobTxs))
+	for _, pfb := range res.PayForBlobs {
+		rawTx := prepareResp.Txs[pfb.TxIndex]
+		require.Equal(t, height, pfb.Height)
+		require.Equal(t, coretypes.Tx(rawTx).Hash(), pfb.TxHash)
+		require.NotEmpty(t, pfb.Signer)
+		for blobIdx, blob := range pfb.Blobs {
+			shareRange, err := square.BlobShareRange(prepareResp.Txs, int(pfb.TxIndex), blobIdx, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+			require.NoError(t, err)
+			require.Equal(t, uint64(shareRange.Start), blob.StartShare)
+			require.Equal(t, uint64(shareRange.End), blob.EndShare)
+			require.Len(t, blob.ShareCommitment, 32)
+		}
+	}
+
+	nsRes, err := server.BlobsByNamespace(ctx, &blobindex.BlobsByNamespaceRequest{Namespace: namespaces[1].Bytes()})
+	require.NoError(t, err)
+	// every account but the first pays for a blob in the second namespace.
+	require.Len(t, nsRes.PayForBlobs, len(accounts)-1)
+}
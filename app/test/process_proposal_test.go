@@ -318,3 +318,79 @@ func calculateNewDataHash(t *testing.T, txs [][]byte) []byte {
 	require.NoError(t, err)
 	return dah.Hash()
 }
+
+func TestNamespaceShareQuota(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(2)
+
+	// allow a namespace to occupy a quarter of a 64x64 square
+	testApp := testutil.NewTestApp()
+	genesisState, valSet, kr := testutil.GenesisStateWithSingleValidator(testApp, accounts...)
+	blobParams := blobtypes.DefaultParams()
+	blobParams.GovMaxSquareSize = 64
+	blobParams.MaxNamespaceShareBps = 2500
+	genesisState[blobtypes.ModuleName] = enc.Codec.MustMarshalJSON(&blobtypes.GenesisState{Params: blobParams})
+	testApp = testutil.InitialiseTestAppWithGenesis(testApp, app.DefaultConsensusParams(), genesisState)
+	_, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Time:               testutil.GenesisTime,
+		Height:             testApp.LastBlockHeight() + 1,
+		Hash:               testApp.LastCommitID().Hash,
+		NextValidatorsHash: valSet.Hash(),
+	})
+	require.NoError(t, err)
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	// every blob occupies 600 of the 1024 shares a namespace may occupy
+	blobSize := 600 * share.ContinuationSparseShareContentSize
+	namespaces := testfactory.RandomBlobNamespaces(random.New(), 2)
+	newBlobTxs := func(namespaces ...share.Namespace) [][]byte {
+		blobs := make([][]*share.Blob, len(namespaces))
+		for i, namespace := range namespaces {
+			blob, err := share.NewV0Blob(namespace, random.Bytes(blobSize))
+			require.NoError(t, err)
+			blobs[i] = []*share.Blob{blob}
+		}
+		return blobfactory.ManyMultiBlobTx(t, enc.TxConfig, kr, testutil.ChainID, accounts, infos, blobs)
+	}
+	sameNamespaceTxs := newBlobTxs(namespaces[0], namespaces[0])
+	differentNamespaceTxs := newBlobTxs(namespaces[0], namespaces[1])
+
+	processProposal := func(txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+		dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		res, err := testApp.ProcessProposal(&abci.RequestProcessProposal{
+			Time:         time.Now(),
+			Height:       testApp.LastBlockHeight() + 1,
+			Txs:          txs,
+			DataRootHash: calculateNewDataHash(t, txs),
+			SquareSize:   uint64(dataSquare.Size()),
+		})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	t.Run("prepare proposal skips blob txs that exceed the quota", func(t *testing.T) {
+		resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+			Txs:    sameNamespaceTxs,
+			Height: testApp.LastBlockHeight() + 1,
+			Time:   time.Now(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, sameNamespaceTxs[:1], resp.Txs)
+
+		resp, err = testApp.PrepareProposal(&abci.RequestPrepareProposal{
+			Txs:    differentNamespaceTxs,
+			Height: testApp.LastBlockHeight() + 1,
+			Time:   time.Now(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Txs, 2)
+	})
+
+	t.Run("process proposal rejects blocks that exceed the quota", func(t *testing.T) {
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(differentNamespaceTxs))
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, processProposal(sameNamespaceTxs))
+	})
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/celestiaorg/celestia-app/v6/app"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// appConfigTemplate is the template of app.toml. It extends the Cosmos SDK
+// template with the sections specific to celestia-app.
+const appConfigTemplate = serverconfig.DefaultConfigTemplate + app.ProposalOrderingConfigTemplate
+
+// appConfig is the configuration stored in app.toml. It extends the Cosmos SDK
+// server configuration with the sections specific to celestia-app.
+type appConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	ProposalOrdering app.ProposalOrderingConfig `mapstructure:"proposal-ordering"`
+}
+
+// defaultAppConfig returns the default configuration of app.toml.
+func defaultAppConfig() *appConfig {
+	return &appConfig{
+		Config:           *app.DefaultAppConfig(),
+		ProposalOrdering: app.DefaultProposalOrderingConfig(),
+	}
+}
@@ -84,6 +84,7 @@ func modifyRootCommand(rootCommand *cobra.Command) {
 
 	rootCommand.AddCommand(
 		multiplexer.NewPassthroughCmd(versions),
+		multiplexer.NewMultiplexerCmd(versions, NewAppServer),
 	)
 
 	// Add the following commands to the rootCommand: start, tendermint, export, version, and rollback and wire multiplexer.
@@ -93,7 +94,10 @@ func modifyRootCommand(rootCommand *cobra.Command) {
 		NewAppServer,
 		appExporter,
 		server.StartCmdOptions{
-			AddFlags:            addStartFlags,
+			AddFlags: func(startCmd *cobra.Command) {
+				addStartFlags(startCmd)
+				multiplexer.AddFlags(startCmd)
+			},
 			StartCommandHandler: multiplexer.New(versions),
 		},
 	)
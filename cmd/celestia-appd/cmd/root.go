@@ -7,6 +7,9 @@ import (
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/proposal"
 	"github.com/cometbft/cometbft/cmd/cometbft/commands"
 	tmcli "github.com/cometbft/cometbft/libs/cli"
 	dbm "github.com/cosmos/cosmos-db"
@@ -17,7 +20,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -78,12 +80,10 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
-			appTemplate := serverconfig.DefaultConfigTemplate
-			appConfig := app.DefaultAppConfig()
 			tmConfig := app.DefaultConsensusConfig()
 
 			// Override the default tendermint config and app config for celestia-app
-			err = server.InterceptConfigsPreRunHandler(command, appTemplate, appConfig, tmConfig)
+			err = server.InterceptConfigsPreRunHandler(command, appConfigTemplate, defaultAppConfig(), tmConfig)
 			if err != nil {
 				return err
 			}
@@ -155,6 +155,11 @@ func addStartFlags(startCmd *cobra.Command) {
 
 	startCmd.Flags().Duration(DelayedPrecommitTimeoutFlag, 0, "Override the DelayedPrecommitTimeout to control block time. Note: only for testing purposes.")
 	startCmd.Flags().Bool(FlagForceNoBBR, false, "bypass the requirement to use bbr locally")
+	startCmd.Flags().String(app.FlagProposalOrderingPolicy, app.ProposalOrderingMempool, fmt.Sprintf("Policy that orders the transactions of the proposals of this node: %q, %q or %q", app.ProposalOrderingMempool, app.ProposalOrderingFeePerByte, app.ProposalOrderingKnapsack))
+	startCmd.Flags().Int(app.FlagProposalOrderingMaxTxsPerSigner, 0, "Maximum number of transactions of a single signer in the proposals of this node. Zero means no limit")
+	startCmd.Flags().Int(gasestimation.FlagGasPriceHistoryBlocks, gasestimation.DefaultGasPriceHistoryBlocks, "Number of recent blocks whose gas price statistics are kept for the gas estimation service")
+	startCmd.Flags().Int(proposal.FlagProposalReportHistory, proposal.DefaultProposalReportHistory, "Number of recent proposals built by this node whose reports are kept for the proposal query service")
+	startCmd.Flags().Int(blobindex.FlagBlobIndexBlocks, blobindex.DefaultBlobIndexBlocks, "Number of recent blocks whose PFBs are kept in memory for the blob index query service")
 }
 
 // replaceLogger optionally replaces the logger with a file logger if the flag
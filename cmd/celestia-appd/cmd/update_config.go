@@ -89,9 +89,12 @@ func updateConfig(homeDir, targetVersion string, backup bool) error {
 
 	fmt.Printf("Loaded configs successfully. Applying %s config updates...\n", targetVersion)
 
-	cometConfig, serverConfig = updater(cometConfig, serverConfig)
+	var updatedServerConfig *serverconfig.Config
+	cometConfig, updatedServerConfig = updater(cometConfig, &serverConfig.Config)
+	serverConfig.Config = *updatedServerConfig
 
 	config.WriteConfigFile(cometConfigPath, cometConfig)
+	serverconfig.SetConfigTemplate(appConfigTemplate)
 	serverconfig.WriteConfigFile(appConfigPath, serverConfig)
 
 	fmt.Printf("Successfully updated configuration to version %s values\n", targetVersion)
@@ -118,9 +121,10 @@ func loadCometBFTConfig(configPath, homeDir string) (*config.Config, error) {
 	return cfg, nil
 }
 
-// loadServerConfig loads the Cosmos SDK server configuration from app.toml
-func loadServerConfig(configPath string) (*serverconfig.Config, error) {
-	cfg := app.DefaultAppConfig()
+// loadServerConfig loads the Cosmos SDK server configuration and the sections
+// specific to celestia-app from app.toml
+func loadServerConfig(configPath string) (*appConfig, error) {
+	cfg := defaultAppConfig()
 
 	v := viper.New()
 	v.SetConfigFile(configPath)
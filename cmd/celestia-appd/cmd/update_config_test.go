@@ -60,6 +60,34 @@ func TestUpdateConfig(t *testing.T) {
 	}
 }
 
+func TestUpdateConfigKeepsProposalOrdering(t *testing.T) {
+	tempDir := t.TempDir()
+	configDir := filepath.Join(tempDir, "config")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	setupTestConfigFiles(t, configDir)
+
+	appConfigPath := filepath.Join(configDir, "app.toml")
+	appCfg, err := loadServerConfig(appConfigPath)
+	require.NoError(t, err)
+	require.Equal(t, app.DefaultProposalOrderingConfig(), appCfg.ProposalOrdering)
+
+	appCfg.ProposalOrdering = app.ProposalOrderingConfig{
+		Policy:          app.ProposalOrderingKnapsack,
+		MaxTxsPerSigner: 2,
+	}
+	serverconfig.WriteConfigFile(appConfigPath, appCfg)
+
+	require.NoError(t, updateConfig(tempDir, "6", false))
+
+	contents, err := os.ReadFile(appConfigPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "[proposal-ordering]")
+	appCfg, err = loadServerConfig(appConfigPath)
+	require.NoError(t, err)
+	assert.Equal(t, app.ProposalOrderingKnapsack, appCfg.ProposalOrdering.Policy)
+	assert.Equal(t, 2, appCfg.ProposalOrdering.MaxTxsPerSigner)
+}
+
 func TestLoadAndWriteConfigs(t *testing.T) {
 	tempDir := t.TempDir()
 	configDir := filepath.Join(tempDir, "config")
@@ -117,8 +145,8 @@ func setupTestConfigFiles(t *testing.T, configDir string) {
 	config.WriteConfigFile(cometConfigPath, cometConfig)
 
 	// Create server config
-	serverConfig := app.DefaultAppConfig()
-	serverconfig.WriteConfigFile(appConfigPath, serverConfig)
+	serverconfig.SetConfigTemplate(appConfigTemplate)
+	serverconfig.WriteConfigFile(appConfigPath, defaultAppConfig())
 }
 
 // verifyUpdatedConfigs verifies that configs were properly updated for the given version
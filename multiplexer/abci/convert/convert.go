@@ -0,0 +1,480 @@
+// Package convert translates ABCI types between ABCI v1 (Tendermint v0.34) and
+// ABCI v2 (CometBFT v0.38).
+//
+// Conversions are lossless: converting a value and converting the result back
+// returns the original value. When the source holds data that the target type
+// has no field for, the conversion fails with an error wrapping
+// ErrUnrepresentable that names every such field. Callers that knowingly drop
+// that data can clear the fields before converting. Fields of the target that
+// have no counterpart in the source are left empty.
+//
+// The following differences are not reported as errors:
+//   - ABCI v1 records whether a validator signed the last block while ABCI v2
+//     records the block ID flag of its vote. Like Tendermint v0.34, every flag
+//     except BlockIDFlagAbsent is converted to a signed vote, which is converted
+//     back to BlockIDFlagCommit.
+//   - RequestInfo.AbciVersion describes the protocol of the request itself and
+//     is dropped when converting to ABCI v1.
+//   - ABCI v2 does not record whether a block event was emitted by BeginBlock
+//     or EndBlock, so the events of a FinalizeBlock response are all returned
+//     by EndBlock when converting to ABCI v1.
+//
+// BeginBlock, DeliverTx, EndBlock and the app hash of Commit in ABCI v1 are
+// converted from and to FinalizeBlock in ABCI v2 using RequestBlockV1 and
+// ResponseBlockV1. SetOption in ABCI v1 and ExtendVote, VerifyVoteExtension and
+// QuerySequence in ABCI v2 have no equivalent in the other version.
+package convert
+
+import (
+	"errors"
+	"fmt"
+
+	abciv2 "github.com/cometbft/cometbft/abci/types"
+	cryptov2 "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	typesv2 "github.com/cometbft/cometbft/proto/tendermint/types"
+	versionv2 "github.com/cometbft/cometbft/proto/tendermint/version"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	cryptov1 "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	typesv1 "github.com/tendermint/tendermint/proto/tendermint/types"
+	versionv1 "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+// ErrUnrepresentable is returned when a field cannot be represented in the
+// target version.
+var ErrUnrepresentable = errors.New("field cannot be represented")
+
+// fields collects the fields of a type that cannot be represented.
+type fields struct {
+	typ  string
+	errs []error
+}
+
+func newFields(typ string) *fields {
+	return &fields{typ: typ}
+}
+
+// check records field if it is set.
+func (f *fields) check(field string, set bool) {
+	if set {
+		f.errs = append(f.errs, fmt.Errorf("%w: %s.%s", ErrUnrepresentable, f.typ, field))
+	}
+}
+
+// add records an error returned by the conversion of a nested field.
+func (f *fields) add(field string, err error) {
+	if err != nil {
+		f.errs = append(f.errs, fmt.Errorf("%s.%s: %w", f.typ, field, err))
+	}
+}
+
+func (f *fields) err() error {
+	return errors.Join(f.errs...)
+}
+
+// EventsV1ToV2 converts ABCI v1 events to ABCI v2 events.
+func EventsV1ToV2(events []abciv1.Event) []abciv2.Event {
+	if events == nil {
+		return nil
+	}
+
+	v2Events := make([]abciv2.Event, len(events))
+	for i, event := range events {
+		v2Events[i] = abciv2.Event{Type: event.Type}
+		if event.Attributes != nil {
+			v2Events[i].Attributes = make([]abciv2.EventAttribute, len(event.Attributes))
+			for j, attr := range event.Attributes {
+				v2Events[i].Attributes[j] = abciv2.EventAttribute{
+					Key:   attr.Key,
+					Value: attr.Value,
+					Index: attr.Index,
+				}
+			}
+		}
+	}
+	return v2Events
+}
+
+// EventsV2ToV1 converts ABCI v2 events to ABCI v1 events.
+func EventsV2ToV1(events []abciv2.Event) []abciv1.Event {
+	if events == nil {
+		return nil
+	}
+
+	v1Events := make([]abciv1.Event, len(events))
+	for i, event := range events {
+		v1Events[i] = abciv1.Event{Type: event.Type}
+		if event.Attributes != nil {
+			v1Events[i].Attributes = make([]abciv1.EventAttribute, len(event.Attributes))
+			for j, attr := range event.Attributes {
+				v1Events[i].Attributes[j] = abciv1.EventAttribute{
+					Key:   attr.Key,
+					Value: attr.Value,
+					Index: attr.Index,
+				}
+			}
+		}
+	}
+	return v1Events
+}
+
+// ValidatorUpdatesV1ToV2 converts ABCI v1 validator updates to ABCI v2
+// validator updates.
+func ValidatorUpdatesV1ToV2(validators []abciv1.ValidatorUpdate) []abciv2.ValidatorUpdate {
+	if validators == nil {
+		return nil
+	}
+
+	v2Updates := make([]abciv2.ValidatorUpdate, len(validators))
+	for i, validator := range validators {
+		v2Updates[i] = abciv2.ValidatorUpdate{Power: validator.Power}
+		switch sum := validator.PubKey.Sum.(type) {
+		case *cryptov1.PublicKey_Ed25519:
+			v2Updates[i].PubKey.Sum = &cryptov2.PublicKey_Ed25519{Ed25519: sum.Ed25519}
+		case *cryptov1.PublicKey_Secp256K1:
+			v2Updates[i].PubKey.Sum = &cryptov2.PublicKey_Secp256K1{Secp256K1: sum.Secp256K1}
+		}
+	}
+	return v2Updates
+}
+
+// ValidatorUpdatesV2ToV1 converts ABCI v2 validator updates to ABCI v1
+// validator updates.
+func ValidatorUpdatesV2ToV1(validators []abciv2.ValidatorUpdate) []abciv1.ValidatorUpdate {
+	if validators == nil {
+		return nil
+	}
+
+	v1Updates := make([]abciv1.ValidatorUpdate, len(validators))
+	for i, validator := range validators {
+		v1Updates[i] = abciv1.ValidatorUpdate{Power: validator.Power}
+		switch sum := validator.PubKey.Sum.(type) {
+		case *cryptov2.PublicKey_Ed25519:
+			v1Updates[i].PubKey.Sum = &cryptov1.PublicKey_Ed25519{Ed25519: sum.Ed25519}
+		case *cryptov2.PublicKey_Secp256K1:
+			v1Updates[i].PubKey.Sum = &cryptov1.PublicKey_Secp256K1{Secp256K1: sum.Secp256K1}
+		}
+	}
+	return v1Updates
+}
+
+// ConsensusParamsV1ToV2 converts ABCI v1 consensus params to ABCI v2 consensus params.
+func ConsensusParamsV1ToV2(params *abciv1.ConsensusParams) *typesv2.ConsensusParams {
+	if params == nil {
+		return nil
+	}
+
+	v2Params := &typesv2.ConsensusParams{}
+	if block := params.Block; block != nil {
+		v2Params.Block = &typesv2.BlockParams{
+			MaxBytes: block.MaxBytes,
+			MaxGas:   block.MaxGas,
+		}
+	}
+	if evidence := params.Evidence; evidence != nil {
+		v2Params.Evidence = &typesv2.EvidenceParams{
+			MaxAgeNumBlocks: evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  evidence.MaxAgeDuration,
+			MaxBytes:        evidence.MaxBytes,
+		}
+	}
+	if validator := params.Validator; validator != nil {
+		v2Params.Validator = &typesv2.ValidatorParams{
+			PubKeyTypes: validator.PubKeyTypes,
+		}
+	}
+	if version := params.Version; version != nil {
+		v2Params.Version = &typesv2.VersionParams{
+			App: version.AppVersion,
+		}
+	}
+	return v2Params
+}
+
+// ConsensusParamsV2ToV1 converts ABCI v2 consensus params to ABCI v1 consensus
+// params. ABCI v1 has no ABCI params, so they must be nil or empty.
+func ConsensusParamsV2ToV1(params *typesv2.ConsensusParams) (*abciv1.ConsensusParams, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	f := newFields("ConsensusParams")
+	f.check("Abci", params.Abci != nil && params.Abci.VoteExtensionsEnableHeight != 0)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	v1Params := &abciv1.ConsensusParams{}
+	if block := params.Block; block != nil {
+		v1Params.Block = &abciv1.BlockParams{
+			MaxBytes: block.MaxBytes,
+			MaxGas:   block.MaxGas,
+		}
+	}
+	if evidence := params.Evidence; evidence != nil {
+		v1Params.Evidence = &typesv1.EvidenceParams{
+			MaxAgeNumBlocks: evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  evidence.MaxAgeDuration,
+			MaxBytes:        evidence.MaxBytes,
+		}
+	}
+	if validator := params.Validator; validator != nil {
+		v1Params.Validator = &typesv1.ValidatorParams{
+			PubKeyTypes: validator.PubKeyTypes,
+		}
+	}
+	if version := params.Version; version != nil {
+		v1Params.Version = &typesv1.VersionParams{
+			AppVersion: version.App,
+		}
+	}
+	return v1Params, nil
+}
+
+// CommitInfoV1ToV2 converts the ABCI v1 last commit info to the ABCI v2 commit
+// info. Signed votes are converted to BlockIDFlagCommit and the others to
+// BlockIDFlagAbsent.
+func CommitInfoV1ToV2(info abciv1.LastCommitInfo) abciv2.CommitInfo {
+	v2Info := abciv2.CommitInfo{Round: info.Round}
+	if info.Votes != nil {
+		v2Info.Votes = make([]abciv2.VoteInfo, len(info.Votes))
+		for i, vote := range info.Votes {
+			flag := typesv2.BlockIDFlagAbsent
+			if vote.SignedLastBlock {
+				flag = typesv2.BlockIDFlagCommit
+			}
+			v2Info.Votes[i] = abciv2.VoteInfo{
+				Validator:   abciv2.Validator(vote.Validator),
+				BlockIdFlag: flag,
+			}
+		}
+	}
+	return v2Info
+}
+
+// CommitInfoV2ToV1 converts the ABCI v2 commit info to the ABCI v1 last commit
+// info. Every vote that is not BlockIDFlagAbsent is converted to a signed vote.
+func CommitInfoV2ToV1(info abciv2.CommitInfo) abciv1.LastCommitInfo {
+	v1Info := abciv1.LastCommitInfo{Round: info.Round}
+	if info.Votes != nil {
+		v1Info.Votes = make([]abciv1.VoteInfo, len(info.Votes))
+		for i, vote := range info.Votes {
+			v1Info.Votes[i] = abciv1.VoteInfo{
+				Validator:       abciv1.Validator(vote.Validator),
+				SignedLastBlock: vote.BlockIdFlag != typesv2.BlockIDFlagAbsent,
+			}
+		}
+	}
+	return v1Info
+}
+
+// EvidenceV1ToV2 converts ABCI v1 evidence to ABCI v2 misbehavior.
+func EvidenceV1ToV2(evidence []abciv1.Evidence) []abciv2.Misbehavior {
+	if evidence == nil {
+		return nil
+	}
+
+	misbehavior := make([]abciv2.Misbehavior, len(evidence))
+	for i, ev := range evidence {
+		misbehavior[i] = abciv2.Misbehavior{
+			Type:             abciv2.MisbehaviorType(ev.Type),
+			Validator:        abciv2.Validator(ev.Validator),
+			Height:           ev.Height,
+			Time:             ev.Time,
+			TotalVotingPower: ev.TotalVotingPower,
+		}
+	}
+	return misbehavior
+}
+
+// EvidenceV2ToV1 converts ABCI v2 misbehavior to ABCI v1 evidence.
+func EvidenceV2ToV1(misbehavior []abciv2.Misbehavior) []abciv1.Evidence {
+	if misbehavior == nil {
+		return nil
+	}
+
+	evidence := make([]abciv1.Evidence, len(misbehavior))
+	for i, ev := range misbehavior {
+		evidence[i] = abciv1.Evidence{
+			Type:             abciv1.EvidenceType(ev.Type),
+			Validator:        abciv1.Validator(ev.Validator),
+			Height:           ev.Height,
+			Time:             ev.Time,
+			TotalVotingPower: ev.TotalVotingPower,
+		}
+	}
+	return evidence
+}
+
+// TimeoutInfoV1ToV2 converts the ABCI v1 timeouts to the ABCI v2 timeout info.
+func TimeoutInfoV1ToV2(info abciv1.TimeoutsInfo) abciv2.TimeoutInfo {
+	return abciv2.TimeoutInfo{
+		TimeoutPropose: info.TimeoutPropose,
+		TimeoutCommit:  info.TimeoutCommit,
+	}
+}
+
+// TimeoutInfoV2ToV1 converts the ABCI v2 timeout info to the ABCI v1 timeouts.
+// ABCI v1 only has the propose and commit timeouts, so the others must be zero.
+func TimeoutInfoV2ToV1(info abciv2.TimeoutInfo) (abciv1.TimeoutsInfo, error) {
+	f := newFields("TimeoutInfo")
+	f.check("TimeoutProposeDelta", info.TimeoutProposeDelta != 0)
+	f.check("TimeoutPrevote", info.TimeoutPrevote != 0)
+	f.check("TimeoutPrevoteDelta", info.TimeoutPrevoteDelta != 0)
+	f.check("TimeoutPrecommit", info.TimeoutPrecommit != 0)
+	f.check("TimeoutPrecommitDelta", info.TimeoutPrecommitDelta != 0)
+	f.check("DelayedPrecommitTimeout", info.DelayedPrecommitTimeout != 0)
+	if err := f.err(); err != nil {
+		return abciv1.TimeoutsInfo{}, err
+	}
+
+	return abciv1.TimeoutsInfo{
+		TimeoutPropose: info.TimeoutPropose,
+		TimeoutCommit:  info.TimeoutCommit,
+	}, nil
+}
+
+// HeaderV1ToV2 converts a Tendermint v0.34 block header to a CometBFT v0.38
+// block header.
+func HeaderV1ToV2(header typesv1.Header) typesv2.Header {
+	return typesv2.Header{
+		Version: versionv2.Consensus(header.Version),
+		ChainID: header.ChainID,
+		Height:  header.Height,
+		Time:    header.Time,
+		LastBlockId: typesv2.BlockID{
+			Hash:          header.LastBlockId.Hash,
+			PartSetHeader: typesv2.PartSetHeader(header.LastBlockId.PartSetHeader),
+		},
+		LastCommitHash:     header.LastCommitHash,
+		DataHash:           header.DataHash,
+		ValidatorsHash:     header.ValidatorsHash,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ConsensusHash:      header.ConsensusHash,
+		AppHash:            header.AppHash,
+		LastResultsHash:    header.LastResultsHash,
+		EvidenceHash:       header.EvidenceHash,
+		ProposerAddress:    header.ProposerAddress,
+	}
+}
+
+// HeaderV2ToV1 converts a CometBFT v0.38 block header to a Tendermint v0.34
+// block header.
+func HeaderV2ToV1(header typesv2.Header) typesv1.Header {
+	return typesv1.Header{
+		Version: versionv1.Consensus(header.Version),
+		ChainID: header.ChainID,
+		Height:  header.Height,
+		Time:    header.Time,
+		LastBlockId: typesv1.BlockID{
+			Hash:          header.LastBlockId.Hash,
+			PartSetHeader: typesv1.PartSetHeader(header.LastBlockId.PartSetHeader),
+		},
+		LastCommitHash:     header.LastCommitHash,
+		DataHash:           header.DataHash,
+		ValidatorsHash:     header.ValidatorsHash,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ConsensusHash:      header.ConsensusHash,
+		AppHash:            header.AppHash,
+		LastResultsHash:    header.LastResultsHash,
+		EvidenceHash:       header.EvidenceHash,
+		ProposerAddress:    header.ProposerAddress,
+	}
+}
+
+// SnapshotV1ToV2 converts an ABCI v1 snapshot to an ABCI v2 snapshot.
+func SnapshotV1ToV2(snapshot *abciv1.Snapshot) *abciv2.Snapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &abciv2.Snapshot{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Chunks:   snapshot.Chunks,
+		Hash:     snapshot.Hash,
+		Metadata: snapshot.Metadata,
+	}
+}
+
+// SnapshotV2ToV1 converts an ABCI v2 snapshot to an ABCI v1 snapshot.
+func SnapshotV2ToV1(snapshot *abciv2.Snapshot) *abciv1.Snapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &abciv1.Snapshot{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Chunks:   snapshot.Chunks,
+		Hash:     snapshot.Hash,
+		Metadata: snapshot.Metadata,
+	}
+}
+
+// ProofOpsV1ToV2 converts Tendermint v0.34 proof operations to CometBFT v0.38
+// proof operations.
+func ProofOpsV1ToV2(proofOps *cryptov1.ProofOps) *cryptov2.ProofOps {
+	if proofOps == nil {
+		return nil
+	}
+
+	v2ProofOps := &cryptov2.ProofOps{}
+	if proofOps.Ops != nil {
+		v2ProofOps.Ops = make([]cryptov2.ProofOp, len(proofOps.Ops))
+		for i, op := range proofOps.Ops {
+			v2ProofOps.Ops[i] = cryptov2.ProofOp(op)
+		}
+	}
+	return v2ProofOps
+}
+
+// ProofOpsV2ToV1 converts CometBFT v0.38 proof operations to Tendermint v0.34
+// proof operations.
+func ProofOpsV2ToV1(proofOps *cryptov2.ProofOps) *cryptov1.ProofOps {
+	if proofOps == nil {
+		return nil
+	}
+
+	v1ProofOps := &cryptov1.ProofOps{}
+	if proofOps.Ops != nil {
+		v1ProofOps.Ops = make([]cryptov1.ProofOp, len(proofOps.Ops))
+		for i, op := range proofOps.Ops {
+			v1ProofOps.Ops[i] = cryptov1.ProofOp(op)
+		}
+	}
+	return v1ProofOps
+}
+
+// TxResultV1ToV2 converts the ABCI v1 DeliverTx response to the ABCI v2
+// transaction result.
+func TxResultV1ToV2(resp *abciv1.ResponseDeliverTx) *abciv2.ExecTxResult {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ExecTxResult{
+		Code:      resp.Code,
+		Data:      resp.Data,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		GasWanted: resp.GasWanted,
+		GasUsed:   resp.GasUsed,
+		Events:    EventsV1ToV2(resp.Events),
+		Codespace: resp.Codespace,
+	}
+}
+
+// TxResultV2ToV1 converts the ABCI v2 transaction result to the ABCI v1
+// DeliverTx response.
+func TxResultV2ToV1(result *abciv2.ExecTxResult) *abciv1.ResponseDeliverTx {
+	if result == nil {
+		return nil
+	}
+	return &abciv1.ResponseDeliverTx{
+		Code:      result.Code,
+		Data:      result.Data,
+		Log:       result.Log,
+		Info:      result.Info,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Events:    EventsV2ToV1(result.Events),
+		Codespace: result.Codespace,
+	}
+}
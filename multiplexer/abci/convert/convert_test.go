@@ -0,0 +1,737 @@
+package convert
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	abciv2 "github.com/cometbft/cometbft/abci/types"
+	cryptov2 "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	typesv2 "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	cryptov1 "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	typesv1 "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// roundTrips is the number of random values converted by each round-trip test.
+const roundTrips = 200
+
+func TestRoundTripV1(t *testing.T) {
+	t.Run("Events", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(EventsV1ToV2), noErr(EventsV2ToV1))
+	})
+	t.Run("ValidatorUpdates", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ValidatorUpdatesV1ToV2), noErr(ValidatorUpdatesV2ToV1))
+	})
+	t.Run("ConsensusParams", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ConsensusParamsV1ToV2), ConsensusParamsV2ToV1)
+	})
+	t.Run("CommitInfo", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(CommitInfoV1ToV2), noErr(CommitInfoV2ToV1))
+	})
+	t.Run("Evidence", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(EvidenceV1ToV2), noErr(EvidenceV2ToV1))
+	})
+	t.Run("TimeoutInfo", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(TimeoutInfoV1ToV2), TimeoutInfoV2ToV1)
+	})
+	t.Run("Header", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(HeaderV1ToV2), noErr(HeaderV2ToV1))
+	})
+	t.Run("Snapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(SnapshotV1ToV2), noErr(SnapshotV2ToV1))
+	})
+	t.Run("ProofOps", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ProofOpsV1ToV2), noErr(ProofOpsV2ToV1))
+	})
+	t.Run("TxResult", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(TxResultV1ToV2), noErr(TxResultV2ToV1))
+	})
+
+	t.Run("RequestEcho", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestEchoV1ToV2), noErr(RequestEchoV2ToV1))
+	})
+	t.Run("RequestFlush", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestFlushV1ToV2), noErr(RequestFlushV2ToV1))
+	})
+	t.Run("RequestInfo", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestInfoV1ToV2), noErr(RequestInfoV2ToV1))
+	})
+	t.Run("RequestInitChain", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestInitChainV1ToV2), RequestInitChainV2ToV1)
+	})
+	t.Run("RequestQuery", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestQueryV1ToV2), noErr(RequestQueryV2ToV1))
+	})
+	t.Run("RequestCheckTx", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestCheckTxV1ToV2), noErr(RequestCheckTxV2ToV1))
+	})
+	t.Run("RequestCommit", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestCommitV1ToV2), noErr(RequestCommitV2ToV1))
+	})
+	t.Run("RequestListSnapshots", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestListSnapshotsV1ToV2), noErr(RequestListSnapshotsV2ToV1))
+	})
+	t.Run("RequestOfferSnapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestOfferSnapshotV1ToV2), noErr(RequestOfferSnapshotV2ToV1))
+	})
+	t.Run("RequestLoadSnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestLoadSnapshotChunkV1ToV2), noErr(RequestLoadSnapshotChunkV2ToV1))
+	})
+	t.Run("RequestApplySnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestApplySnapshotChunkV1ToV2), noErr(RequestApplySnapshotChunkV2ToV1))
+	})
+	t.Run("RequestPrepareProposal", func(t *testing.T) {
+		normalize := func(req *abciv1.RequestPrepareProposal) *abciv1.RequestPrepareProposal {
+			req.ChainId = ""
+			req.BlockData = &typesv1.Data{Txs: req.BlockData.GetTxs()}
+			return req
+		}
+		testRoundTrip(t, normalize, RequestPrepareProposalV1ToV2, RequestPrepareProposalV2ToV1)
+	})
+	t.Run("RequestProcessProposal", func(t *testing.T) {
+		normalize := func(req *abciv1.RequestProcessProposal) *abciv1.RequestProcessProposal {
+			if req.BlockData == nil {
+				req.BlockData = &typesv1.Data{}
+			}
+			return req
+		}
+		testRoundTrip(t, normalize, noErr(RequestProcessProposalV1ToV2), RequestProcessProposalV2ToV1)
+	})
+	t.Run("RequestFinalizeBlock", func(t *testing.T) {
+		normalize := func(block *RequestBlockV1) *RequestBlockV1 {
+			if block.BeginBlock == nil {
+				block.BeginBlock = &abciv1.RequestBeginBlock{}
+			}
+			block.EndBlock = &abciv1.RequestEndBlock{Height: block.BeginBlock.Header.Height}
+			return block
+		}
+		testRoundTrip(t, normalize, RequestFinalizeBlockV1ToV2, RequestFinalizeBlockV2ToV1)
+	})
+
+	t.Run("ResponseException", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseExceptionV1ToV2), noErr(ResponseExceptionV2ToV1))
+	})
+	t.Run("ResponseEcho", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseEchoV1ToV2), noErr(ResponseEchoV2ToV1))
+	})
+	t.Run("ResponseFlush", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseFlushV1ToV2), noErr(ResponseFlushV2ToV1))
+	})
+	t.Run("ResponseInfo", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseInfoV1ToV2), ResponseInfoV2ToV1)
+	})
+	t.Run("ResponseInitChain", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseInitChainV1ToV2), ResponseInitChainV2ToV1)
+	})
+	t.Run("ResponseQuery", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseQueryV1ToV2), noErr(ResponseQueryV2ToV1))
+	})
+	t.Run("ResponseCheckTx", func(t *testing.T) {
+		normalize := func(resp *abciv1.ResponseCheckTx) *abciv1.ResponseCheckTx {
+			resp.Sender = ""
+			resp.MempoolError = ""
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseCheckTxV1ToV2, ResponseCheckTxV2ToV1)
+	})
+	t.Run("ResponseCommit", func(t *testing.T) {
+		to := func(resp *abciv1.ResponseCommit) (commitWithAppHash, error) {
+			commit, appHash := ResponseCommitV1ToV2(resp)
+			return commitWithAppHash{Commit: commit, AppHash: appHash}, nil
+		}
+		from := func(c commitWithAppHash) (*abciv1.ResponseCommit, error) {
+			return ResponseCommitV2ToV1(c.Commit, c.AppHash), nil
+		}
+		testRoundTrip(t, nil, to, from)
+	})
+	t.Run("ResponseListSnapshots", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseListSnapshotsV1ToV2), noErr(ResponseListSnapshotsV2ToV1))
+	})
+	t.Run("ResponseOfferSnapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseOfferSnapshotV1ToV2), noErr(ResponseOfferSnapshotV2ToV1))
+	})
+	t.Run("ResponseLoadSnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseLoadSnapshotChunkV1ToV2), noErr(ResponseLoadSnapshotChunkV2ToV1))
+	})
+	t.Run("ResponseApplySnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseApplySnapshotChunkV1ToV2), noErr(ResponseApplySnapshotChunkV2ToV1))
+	})
+	t.Run("ResponsePrepareProposal", func(t *testing.T) {
+		normalize := func(resp *abciv1.ResponsePrepareProposal) *abciv1.ResponsePrepareProposal {
+			if resp.BlockData == nil {
+				resp.BlockData = &typesv1.Data{}
+			}
+			return resp
+		}
+		testRoundTrip(t, normalize, noErr(ResponsePrepareProposalV1ToV2), noErr(ResponsePrepareProposalV2ToV1))
+	})
+	t.Run("ResponseProcessProposal", func(t *testing.T) {
+		normalize := func(resp *abciv1.ResponseProcessProposal) *abciv1.ResponseProcessProposal {
+			resp.Evidence = nil
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseProcessProposalV1ToV2, noErr(ResponseProcessProposalV2ToV1))
+	})
+	t.Run("ResponseFinalizeBlock", func(t *testing.T) {
+		normalize := func(block *ResponseBlockV1) *ResponseBlockV1 {
+			// the events of BeginBlock are returned by EndBlock.
+			block.BeginBlock = &abciv1.ResponseBeginBlock{}
+			if block.EndBlock == nil {
+				block.EndBlock = &abciv1.ResponseEndBlock{}
+			}
+			return block
+		}
+		testRoundTrip(t, normalize, noErr(ResponseFinalizeBlockV1ToV2), ResponseFinalizeBlockV2ToV1)
+	})
+}
+
+func TestRoundTripV2(t *testing.T) {
+	t.Run("Events", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(EventsV2ToV1), noErr(EventsV1ToV2))
+	})
+	t.Run("ValidatorUpdates", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ValidatorUpdatesV2ToV1), noErr(ValidatorUpdatesV1ToV2))
+	})
+	t.Run("ConsensusParams", func(t *testing.T) {
+		normalize := func(params *typesv2.ConsensusParams) *typesv2.ConsensusParams {
+			return normalizeConsensusParams(params)
+		}
+		testRoundTrip(t, normalize, ConsensusParamsV2ToV1, noErr(ConsensusParamsV1ToV2))
+	})
+	t.Run("CommitInfo", func(t *testing.T) {
+		testRoundTrip(t, normalizeCommitInfo, noErr(CommitInfoV2ToV1), noErr(CommitInfoV1ToV2))
+	})
+	t.Run("Evidence", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(EvidenceV2ToV1), noErr(EvidenceV1ToV2))
+	})
+	t.Run("TimeoutInfo", func(t *testing.T) {
+		testRoundTrip(t, normalizeTimeoutInfo, TimeoutInfoV2ToV1, noErr(TimeoutInfoV1ToV2))
+	})
+	t.Run("Header", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(HeaderV2ToV1), noErr(HeaderV1ToV2))
+	})
+	t.Run("Snapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(SnapshotV2ToV1), noErr(SnapshotV1ToV2))
+	})
+	t.Run("ProofOps", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ProofOpsV2ToV1), noErr(ProofOpsV1ToV2))
+	})
+	t.Run("TxResult", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(TxResultV2ToV1), noErr(TxResultV1ToV2))
+	})
+
+	t.Run("RequestEcho", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestEchoV2ToV1), noErr(RequestEchoV1ToV2))
+	})
+	t.Run("RequestFlush", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestFlushV2ToV1), noErr(RequestFlushV1ToV2))
+	})
+	t.Run("RequestInfo", func(t *testing.T) {
+		normalize := func(req *abciv2.RequestInfo) *abciv2.RequestInfo {
+			req.AbciVersion = ""
+			return req
+		}
+		testRoundTrip(t, normalize, noErr(RequestInfoV2ToV1), noErr(RequestInfoV1ToV2))
+	})
+	t.Run("RequestInitChain", func(t *testing.T) {
+		normalize := func(req *abciv2.RequestInitChain) *abciv2.RequestInitChain {
+			req.ConsensusParams = normalizeConsensusParams(req.ConsensusParams)
+			return req
+		}
+		testRoundTrip(t, normalize, RequestInitChainV2ToV1, noErr(RequestInitChainV1ToV2))
+	})
+	t.Run("RequestQuery", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestQueryV2ToV1), noErr(RequestQueryV1ToV2))
+	})
+	t.Run("RequestCheckTx", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestCheckTxV2ToV1), noErr(RequestCheckTxV1ToV2))
+	})
+	t.Run("RequestCommit", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestCommitV2ToV1), noErr(RequestCommitV1ToV2))
+	})
+	t.Run("RequestListSnapshots", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestListSnapshotsV2ToV1), noErr(RequestListSnapshotsV1ToV2))
+	})
+	t.Run("RequestOfferSnapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestOfferSnapshotV2ToV1), noErr(RequestOfferSnapshotV1ToV2))
+	})
+	t.Run("RequestLoadSnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestLoadSnapshotChunkV2ToV1), noErr(RequestLoadSnapshotChunkV1ToV2))
+	})
+	t.Run("RequestApplySnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(RequestApplySnapshotChunkV2ToV1), noErr(RequestApplySnapshotChunkV1ToV2))
+	})
+	t.Run("RequestPrepareProposal", func(t *testing.T) {
+		normalize := func(req *abciv2.RequestPrepareProposal) *abciv2.RequestPrepareProposal {
+			req.LocalLastCommit = abciv2.ExtendedCommitInfo{}
+			req.Misbehavior = nil
+			req.NextValidatorsHash = nil
+			req.ProposerAddress = nil
+			return req
+		}
+		testRoundTrip(t, normalize, RequestPrepareProposalV2ToV1, RequestPrepareProposalV1ToV2)
+	})
+	t.Run("RequestProcessProposal", func(t *testing.T) {
+		normalize := func(req *abciv2.RequestProcessProposal) *abciv2.RequestProcessProposal {
+			if req.Header == nil {
+				req.Header = &typesv2.Header{}
+			}
+			req.ProposedLastCommit = abciv2.CommitInfo{}
+			req.Misbehavior = nil
+			req.Hash = nil
+			req.Height = req.Header.Height
+			req.Time = req.Header.Time
+			req.NextValidatorsHash = req.Header.NextValidatorsHash
+			req.ProposerAddress = req.Header.ProposerAddress
+			return req
+		}
+		testRoundTrip(t, normalize, RequestProcessProposalV2ToV1, noErr(RequestProcessProposalV1ToV2))
+	})
+	t.Run("RequestFinalizeBlock", func(t *testing.T) {
+		normalize := func(req *abciv2.RequestFinalizeBlock) *abciv2.RequestFinalizeBlock {
+			if req.Header == nil {
+				req.Header = &typesv2.Header{}
+			}
+			req.DecidedLastCommit = normalizeCommitInfo(req.DecidedLastCommit)
+			req.Height = req.Header.Height
+			req.Time = req.Header.Time
+			req.NextValidatorsHash = req.Header.NextValidatorsHash
+			req.ProposerAddress = req.Header.ProposerAddress
+			return req
+		}
+		testRoundTrip(t, normalize, RequestFinalizeBlockV2ToV1, RequestFinalizeBlockV1ToV2)
+	})
+
+	t.Run("ResponseException", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseExceptionV2ToV1), noErr(ResponseExceptionV1ToV2))
+	})
+	t.Run("ResponseEcho", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseEchoV2ToV1), noErr(ResponseEchoV1ToV2))
+	})
+	t.Run("ResponseFlush", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseFlushV2ToV1), noErr(ResponseFlushV1ToV2))
+	})
+	t.Run("ResponseInfo", func(t *testing.T) {
+		normalize := func(resp *abciv2.ResponseInfo) *abciv2.ResponseInfo {
+			resp.TimeoutInfo = normalizeTimeoutInfo(resp.TimeoutInfo)
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseInfoV2ToV1, noErr(ResponseInfoV1ToV2))
+	})
+	t.Run("ResponseInitChain", func(t *testing.T) {
+		normalize := func(resp *abciv2.ResponseInitChain) *abciv2.ResponseInitChain {
+			resp.ConsensusParams = normalizeConsensusParams(resp.ConsensusParams)
+			resp.TimeoutInfo = normalizeTimeoutInfo(resp.TimeoutInfo)
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseInitChainV2ToV1, noErr(ResponseInitChainV1ToV2))
+	})
+	t.Run("ResponseQuery", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseQueryV2ToV1), noErr(ResponseQueryV1ToV2))
+	})
+	t.Run("ResponseCheckTx", func(t *testing.T) {
+		normalize := func(resp *abciv2.ResponseCheckTx) *abciv2.ResponseCheckTx {
+			resp.Address = nil
+			resp.Sequence = 0
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseCheckTxV2ToV1, ResponseCheckTxV1ToV2)
+	})
+	t.Run("ResponseCommit", func(t *testing.T) {
+		to := func(c commitWithAppHash) (*abciv1.ResponseCommit, error) {
+			return ResponseCommitV2ToV1(c.Commit, c.AppHash), nil
+		}
+		from := func(resp *abciv1.ResponseCommit) (commitWithAppHash, error) {
+			commit, appHash := ResponseCommitV1ToV2(resp)
+			return commitWithAppHash{Commit: commit, AppHash: appHash}, nil
+		}
+		normalize := func(c commitWithAppHash) commitWithAppHash {
+			if c.Commit == nil {
+				c.Commit = &abciv2.ResponseCommit{}
+			}
+			return c
+		}
+		testRoundTrip(t, normalize, to, from)
+	})
+	t.Run("ResponseListSnapshots", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseListSnapshotsV2ToV1), noErr(ResponseListSnapshotsV1ToV2))
+	})
+	t.Run("ResponseOfferSnapshot", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseOfferSnapshotV2ToV1), noErr(ResponseOfferSnapshotV1ToV2))
+	})
+	t.Run("ResponseLoadSnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseLoadSnapshotChunkV2ToV1), noErr(ResponseLoadSnapshotChunkV1ToV2))
+	})
+	t.Run("ResponseApplySnapshotChunk", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseApplySnapshotChunkV2ToV1), noErr(ResponseApplySnapshotChunkV1ToV2))
+	})
+	t.Run("ResponsePrepareProposal", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponsePrepareProposalV2ToV1), noErr(ResponsePrepareProposalV1ToV2))
+	})
+	t.Run("ResponseProcessProposal", func(t *testing.T) {
+		testRoundTrip(t, nil, noErr(ResponseProcessProposalV2ToV1), ResponseProcessProposalV1ToV2)
+	})
+	t.Run("ResponseFinalizeBlock", func(t *testing.T) {
+		normalize := func(resp *abciv2.ResponseFinalizeBlock) *abciv2.ResponseFinalizeBlock {
+			resp.ConsensusParamUpdates = normalizeConsensusParams(resp.ConsensusParamUpdates)
+			resp.TimeoutInfo = normalizeTimeoutInfo(resp.TimeoutInfo)
+			return resp
+		}
+		testRoundTrip(t, normalize, ResponseFinalizeBlockV2ToV1, noErr(ResponseFinalizeBlockV1ToV2))
+	})
+}
+
+func TestUnrepresentable(t *testing.T) {
+	testCases := []struct {
+		name    string
+		convert func() error
+		// fields are the fields that must be named in the error.
+		fields []string
+	}{
+		{
+			name: "ABCI params",
+			convert: func() error {
+				_, err := ConsensusParamsV2ToV1(&typesv2.ConsensusParams{
+					Abci: &typesv2.ABCIParams{VoteExtensionsEnableHeight: 1},
+				})
+				return err
+			},
+			fields: []string{"ConsensusParams.Abci"},
+		},
+		{
+			name: "timeouts of a nested field",
+			convert: func() error {
+				_, err := ResponseInitChainV2ToV1(&abciv2.ResponseInitChain{
+					TimeoutInfo: abciv2.TimeoutInfo{TimeoutPrevote: time.Second, DelayedPrecommitTimeout: time.Second},
+				})
+				return err
+			},
+			fields: []string{"ResponseInitChain.TimeoutInfo", "TimeoutInfo.TimeoutPrevote", "TimeoutInfo.DelayedPrecommitTimeout"},
+		},
+		{
+			name: "sender and mempool error of CheckTx",
+			convert: func() error {
+				_, err := ResponseCheckTxV1ToV2(&abciv1.ResponseCheckTx{Sender: "sender", MempoolError: "full"})
+				return err
+			},
+			fields: []string{"ResponseCheckTx.Sender", "ResponseCheckTx.MempoolError"},
+		},
+		{
+			name: "sequence of CheckTx",
+			convert: func() error {
+				_, err := ResponseCheckTxV2ToV1(&abciv2.ResponseCheckTx{Sequence: 1})
+				return err
+			},
+			fields: []string{"ResponseCheckTx.Sequence"},
+		},
+		{
+			name: "chain ID of PrepareProposal",
+			convert: func() error {
+				_, err := RequestPrepareProposalV1ToV2(&abciv1.RequestPrepareProposal{ChainId: "test"})
+				return err
+			},
+			fields: []string{"RequestPrepareProposal.ChainId"},
+		},
+		{
+			name: "proposer of PrepareProposal",
+			convert: func() error {
+				_, err := RequestPrepareProposalV2ToV1(&abciv2.RequestPrepareProposal{ProposerAddress: []byte{1}})
+				return err
+			},
+			fields: []string{"RequestPrepareProposal.ProposerAddress"},
+		},
+		{
+			name: "height of ProcessProposal not matching the header",
+			convert: func() error {
+				_, err := RequestProcessProposalV2ToV1(&abciv2.RequestProcessProposal{Height: 2, Header: &typesv2.Header{Height: 1}})
+				return err
+			},
+			fields: []string{"RequestProcessProposal.Height"},
+		},
+		{
+			name: "evidence of ProcessProposal",
+			convert: func() error {
+				_, err := ResponseProcessProposalV1ToV2(&abciv1.ResponseProcessProposal{Evidence: [][]byte{{1}}})
+				return err
+			},
+			fields: []string{"ResponseProcessProposal.Evidence"},
+		},
+		{
+			name: "proposer of FinalizeBlock not matching the header",
+			convert: func() error {
+				_, err := RequestFinalizeBlockV2ToV1(&abciv2.RequestFinalizeBlock{ProposerAddress: []byte{1}, Header: &typesv2.Header{}})
+				return err
+			},
+			fields: []string{"RequestFinalizeBlock.ProposerAddress"},
+		},
+		{
+			name: "height of EndBlock not matching BeginBlock",
+			convert: func() error {
+				_, err := RequestFinalizeBlockV1ToV2(&RequestBlockV1{
+					BeginBlock: &abciv1.RequestBeginBlock{Header: typesv1.Header{Height: 1}},
+					EndBlock:   &abciv1.RequestEndBlock{Height: 2},
+				})
+				return err
+			},
+			fields: []string{"RequestBlockV1.EndBlock.Height"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.convert()
+			require.ErrorIs(t, err, ErrUnrepresentable)
+			for _, field := range tc.fields {
+				assert.ErrorContains(t, err, field)
+			}
+		})
+	}
+}
+
+func TestMissingHeader(t *testing.T) {
+	_, err := RequestProcessProposalV2ToV1(&abciv2.RequestProcessProposal{})
+	assert.ErrorContains(t, err, "Header is required")
+
+	_, err = RequestFinalizeBlockV2ToV1(&abciv2.RequestFinalizeBlock{})
+	assert.ErrorContains(t, err, "Header is required")
+
+	_, err = RequestFinalizeBlockV1ToV2(&RequestBlockV1{})
+	assert.ErrorContains(t, err, "are required")
+}
+
+func TestConsensusParamsV2ToV1(t *testing.T) {
+	t.Run("should return nil if params are nil", func(t *testing.T) {
+		got, err := ConsensusParamsV2ToV1(nil)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("should drop empty ABCI params", func(t *testing.T) {
+		got, err := ConsensusParamsV2ToV1(&typesv2.ConsensusParams{
+			Version: &typesv2.VersionParams{App: 3},
+			Abci:    &typesv2.ABCIParams{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &abciv1.ConsensusParams{Version: &typesv1.VersionParams{AppVersion: 3}}, got)
+	})
+}
+
+func TestConsensusParamsV1ToV2(t *testing.T) {
+	t.Run("should return nil if params are nil", func(t *testing.T) {
+		got := ConsensusParamsV1ToV2(nil)
+		assert.Nil(t, got)
+	})
+}
+
+func TestTimeoutInfoV1ToV2(t *testing.T) {
+	info := abciv1.TimeoutsInfo{
+		TimeoutPropose: 1,
+		TimeoutCommit:  2,
+	}
+	want := abciv2.TimeoutInfo{
+		TimeoutPropose: 1,
+		TimeoutCommit:  2,
+	}
+	got := TimeoutInfoV1ToV2(info)
+	assert.Equal(t, want, got)
+}
+
+func TestCommitInfoV2ToV1(t *testing.T) {
+	info := abciv2.CommitInfo{
+		Round: 1,
+		Votes: []abciv2.VoteInfo{
+			{Validator: abciv2.Validator{Power: 1}, BlockIdFlag: typesv2.BlockIDFlagCommit},
+			{Validator: abciv2.Validator{Power: 2}, BlockIdFlag: typesv2.BlockIDFlagNil},
+			{Validator: abciv2.Validator{Power: 3}, BlockIdFlag: typesv2.BlockIDFlagAbsent},
+		},
+	}
+	want := abciv1.LastCommitInfo{
+		Round: 1,
+		Votes: []abciv1.VoteInfo{
+			{Validator: abciv1.Validator{Power: 1}, SignedLastBlock: true},
+			{Validator: abciv1.Validator{Power: 2}, SignedLastBlock: true},
+			{Validator: abciv1.Validator{Power: 3}, SignedLastBlock: false},
+		},
+	}
+	assert.Equal(t, want, CommitInfoV2ToV1(info))
+}
+
+func TestResponseFinalizeBlockV1ToV2(t *testing.T) {
+	block := &ResponseBlockV1{
+		BeginBlock: &abciv1.ResponseBeginBlock{Events: []abciv1.Event{{Type: "begin"}}},
+		DeliverTxs: []*abciv1.ResponseDeliverTx{{Events: []abciv1.Event{{Type: "tx"}}}},
+		EndBlock:   &abciv1.ResponseEndBlock{Events: []abciv1.Event{{Type: "end"}}},
+	}
+	got := ResponseFinalizeBlockV1ToV2(block)
+	assert.Equal(t, []abciv2.Event{{Type: "begin"}, {Type: "end"}}, got.Events)
+	assert.Equal(t, []abciv2.Event{{Type: "tx"}}, got.TxResults[0].Events)
+}
+
+// commitWithAppHash is an ABCI v2 Commit response with the app hash of the
+// preceding FinalizeBlock response.
+type commitWithAppHash struct {
+	Commit  *abciv2.ResponseCommit
+	AppHash []byte
+}
+
+// testRoundTrip converts random values of type A to B and back and checks that
+// the result is the original value. normalize clears the fields of A that
+// cannot be represented in B.
+func testRoundTrip[A, B any](t *testing.T, normalize func(A) A, to func(A) (B, error), from func(B) (A, error)) {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < roundTrips; i++ {
+		want := randomValue[A](r)
+		if normalize != nil {
+			want = normalize(want)
+		}
+
+		converted, err := to(want)
+		require.NoError(t, err)
+		got, err := from(converted)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
+
+// noErr adapts a conversion that cannot fail to testRoundTrip.
+func noErr[A, B any](convert func(A) B) func(A) (B, error) {
+	return func(a A) (B, error) {
+		return convert(a), nil
+	}
+}
+
+func normalizeConsensusParams(params *typesv2.ConsensusParams) *typesv2.ConsensusParams {
+	if params != nil {
+		params.Abci = nil
+	}
+	return params
+}
+
+func normalizeTimeoutInfo(info abciv2.TimeoutInfo) abciv2.TimeoutInfo {
+	return abciv2.TimeoutInfo{
+		TimeoutPropose: info.TimeoutPropose,
+		TimeoutCommit:  info.TimeoutCommit,
+	}
+}
+
+func normalizeCommitInfo(info abciv2.CommitInfo) abciv2.CommitInfo {
+	for i := range info.Votes {
+		if info.Votes[i].BlockIdFlag != typesv2.BlockIDFlagAbsent {
+			info.Votes[i].BlockIdFlag = typesv2.BlockIDFlagCommit
+		}
+	}
+	return info
+}
+
+// randomValue returns a value of type T with all fields set to random values.
+// Nested pointers and slices are randomly nil or empty.
+func randomValue[T any](r *rand.Rand) T {
+	var value T
+	v := reflect.ValueOf(&value).Elem()
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	fillRandom(r, v)
+	return value
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	publicKeyV1Type = reflect.TypeOf(cryptov1.PublicKey{})
+	publicKeyV2Type = reflect.TypeOf(cryptov2.PublicKey{})
+)
+
+func fillRandom(r *rand.Rand, v reflect.Value) {
+	switch v.Type() {
+	case timeType:
+		v.Set(reflect.ValueOf(time.Unix(r.Int63n(1<<32), r.Int63n(int64(time.Second))).UTC()))
+		return
+	case publicKeyV1Type:
+		v.Set(reflect.ValueOf(randomPublicKeyV1(r)))
+		return
+	case publicKeyV2Type:
+		v.Set(reflect.ValueOf(randomPublicKeyV2(r)))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(r.Int63())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(r.Uint64())
+	case reflect.String:
+		v.SetString(randomString(r))
+	case reflect.Pointer:
+		if r.Intn(4) > 0 {
+			v.Set(reflect.New(v.Type().Elem()))
+			fillRandom(r, v.Elem())
+		}
+	case reflect.Slice:
+		// nil, empty or up to 3 elements
+		n := r.Intn(5) - 1
+		if n < 0 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n; i++ {
+			elem := v.Index(i)
+			// elements of slices of pointers are never nil.
+			if elem.Kind() == reflect.Pointer {
+				elem.Set(reflect.New(elem.Type().Elem()))
+				elem = elem.Elem()
+			}
+			fillRandom(r, elem)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillRandom(r, v.Field(i))
+		}
+	default:
+		panic("cannot fill " + v.Type().String())
+	}
+}
+
+func randomString(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	b := make([]byte, 1+r.Intn(8))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+func randomBytes(r *rand.Rand) []byte {
+	b := make([]byte, 32)
+	r.Read(b)
+	return b
+}
+
+func randomPublicKeyV1(r *rand.Rand) cryptov1.PublicKey {
+	switch r.Intn(3) {
+	case 0:
+		return cryptov1.PublicKey{Sum: &cryptov1.PublicKey_Ed25519{Ed25519: randomBytes(r)}}
+	case 1:
+		return cryptov1.PublicKey{Sum: &cryptov1.PublicKey_Secp256K1{Secp256K1: randomBytes(r)}}
+	default:
+		return cryptov1.PublicKey{}
+	}
+}
+
+func randomPublicKeyV2(r *rand.Rand) cryptov2.PublicKey {
+	switch r.Intn(3) {
+	case 0:
+		return cryptov2.PublicKey{Sum: &cryptov2.PublicKey_Ed25519{Ed25519: randomBytes(r)}}
+	case 1:
+		return cryptov2.PublicKey{Sum: &cryptov2.PublicKey_Secp256K1{Secp256K1: randomBytes(r)}}
+	default:
+		return cryptov2.PublicKey{}
+	}
+}
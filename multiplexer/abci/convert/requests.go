@@ -0,0 +1,450 @@
+package convert
+
+import (
+	"bytes"
+	"errors"
+
+	abciv2 "github.com/cometbft/cometbft/abci/types"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	typesv1 "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// RequestBlockV1 holds the ABCI v1 requests that execute a block, which are
+// replaced by a single FinalizeBlock request in ABCI v2.
+type RequestBlockV1 struct {
+	BeginBlock *abciv1.RequestBeginBlock
+	DeliverTxs []*abciv1.RequestDeliverTx
+	EndBlock   *abciv1.RequestEndBlock
+}
+
+// RequestEchoV1ToV2 converts an ABCI v1 Echo request to ABCI v2.
+func RequestEchoV1ToV2(req *abciv1.RequestEcho) *abciv2.RequestEcho {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestEcho{Message: req.Message}
+}
+
+// RequestEchoV2ToV1 converts an ABCI v2 Echo request to ABCI v1.
+func RequestEchoV2ToV1(req *abciv2.RequestEcho) *abciv1.RequestEcho {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestEcho{Message: req.Message}
+}
+
+// RequestFlushV1ToV2 converts an ABCI v1 Flush request to ABCI v2.
+func RequestFlushV1ToV2(req *abciv1.RequestFlush) *abciv2.RequestFlush {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestFlush{}
+}
+
+// RequestFlushV2ToV1 converts an ABCI v2 Flush request to ABCI v1.
+func RequestFlushV2ToV1(req *abciv2.RequestFlush) *abciv1.RequestFlush {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestFlush{}
+}
+
+// RequestInfoV1ToV2 converts an ABCI v1 Info request to ABCI v2.
+func RequestInfoV1ToV2(req *abciv1.RequestInfo) *abciv2.RequestInfo {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestInfo{
+		Version:      req.Version,
+		BlockVersion: req.BlockVersion,
+		P2PVersion:   req.P2PVersion,
+	}
+}
+
+// RequestInfoV2ToV1 converts an ABCI v2 Info request to ABCI v1. The ABCI
+// version of the request is dropped.
+func RequestInfoV2ToV1(req *abciv2.RequestInfo) *abciv1.RequestInfo {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestInfo{
+		Version:      req.Version,
+		BlockVersion: req.BlockVersion,
+		P2PVersion:   req.P2PVersion,
+	}
+}
+
+// RequestInitChainV1ToV2 converts an ABCI v1 InitChain request to ABCI v2.
+func RequestInitChainV1ToV2(req *abciv1.RequestInitChain) *abciv2.RequestInitChain {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestInitChain{
+		Time:            req.Time,
+		ChainId:         req.ChainId,
+		ConsensusParams: ConsensusParamsV1ToV2(req.ConsensusParams),
+		Validators:      ValidatorUpdatesV1ToV2(req.Validators),
+		AppStateBytes:   req.AppStateBytes,
+		InitialHeight:   req.InitialHeight,
+	}
+}
+
+// RequestInitChainV2ToV1 converts an ABCI v2 InitChain request to ABCI v1.
+func RequestInitChainV2ToV1(req *abciv2.RequestInitChain) (*abciv1.RequestInitChain, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	f := newFields("RequestInitChain")
+	consensusParams, err := ConsensusParamsV2ToV1(req.ConsensusParams)
+	f.add("ConsensusParams", err)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.RequestInitChain{
+		Time:            req.Time,
+		ChainId:         req.ChainId,
+		ConsensusParams: consensusParams,
+		Validators:      ValidatorUpdatesV2ToV1(req.Validators),
+		AppStateBytes:   req.AppStateBytes,
+		InitialHeight:   req.InitialHeight,
+	}, nil
+}
+
+// RequestQueryV1ToV2 converts an ABCI v1 Query request to ABCI v2.
+func RequestQueryV1ToV2(req *abciv1.RequestQuery) *abciv2.RequestQuery {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestQuery{
+		Data:   req.Data,
+		Path:   req.Path,
+		Height: req.Height,
+		Prove:  req.Prove,
+	}
+}
+
+// RequestQueryV2ToV1 converts an ABCI v2 Query request to ABCI v1.
+func RequestQueryV2ToV1(req *abciv2.RequestQuery) *abciv1.RequestQuery {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestQuery{
+		Data:   req.Data,
+		Path:   req.Path,
+		Height: req.Height,
+		Prove:  req.Prove,
+	}
+}
+
+// RequestCheckTxV1ToV2 converts an ABCI v1 CheckTx request to ABCI v2.
+func RequestCheckTxV1ToV2(req *abciv1.RequestCheckTx) *abciv2.RequestCheckTx {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestCheckTx{
+		Tx:   req.Tx,
+		Type: abciv2.CheckTxType(req.Type),
+	}
+}
+
+// RequestCheckTxV2ToV1 converts an ABCI v2 CheckTx request to ABCI v1.
+func RequestCheckTxV2ToV1(req *abciv2.RequestCheckTx) *abciv1.RequestCheckTx {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestCheckTx{
+		Tx:   req.Tx,
+		Type: abciv1.CheckTxType(req.Type),
+	}
+}
+
+// RequestCommitV1ToV2 converts an ABCI v1 Commit request to ABCI v2.
+func RequestCommitV1ToV2(req *abciv1.RequestCommit) *abciv2.RequestCommit {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestCommit{}
+}
+
+// RequestCommitV2ToV1 converts an ABCI v2 Commit request to ABCI v1.
+func RequestCommitV2ToV1(req *abciv2.RequestCommit) *abciv1.RequestCommit {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestCommit{}
+}
+
+// RequestListSnapshotsV1ToV2 converts an ABCI v1 ListSnapshots request to ABCI v2.
+func RequestListSnapshotsV1ToV2(req *abciv1.RequestListSnapshots) *abciv2.RequestListSnapshots {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestListSnapshots{}
+}
+
+// RequestListSnapshotsV2ToV1 converts an ABCI v2 ListSnapshots request to ABCI v1.
+func RequestListSnapshotsV2ToV1(req *abciv2.RequestListSnapshots) *abciv1.RequestListSnapshots {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestListSnapshots{}
+}
+
+// RequestOfferSnapshotV1ToV2 converts an ABCI v1 OfferSnapshot request to ABCI v2.
+func RequestOfferSnapshotV1ToV2(req *abciv1.RequestOfferSnapshot) *abciv2.RequestOfferSnapshot {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestOfferSnapshot{
+		Snapshot:   SnapshotV1ToV2(req.Snapshot),
+		AppHash:    req.AppHash,
+		AppVersion: req.AppVersion,
+	}
+}
+
+// RequestOfferSnapshotV2ToV1 converts an ABCI v2 OfferSnapshot request to ABCI v1.
+func RequestOfferSnapshotV2ToV1(req *abciv2.RequestOfferSnapshot) *abciv1.RequestOfferSnapshot {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestOfferSnapshot{
+		Snapshot:   SnapshotV2ToV1(req.Snapshot),
+		AppHash:    req.AppHash,
+		AppVersion: req.AppVersion,
+	}
+}
+
+// RequestLoadSnapshotChunkV1ToV2 converts an ABCI v1 LoadSnapshotChunk request to ABCI v2.
+func RequestLoadSnapshotChunkV1ToV2(req *abciv1.RequestLoadSnapshotChunk) *abciv2.RequestLoadSnapshotChunk {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestLoadSnapshotChunk{
+		Height: req.Height,
+		Format: req.Format,
+		Chunk:  req.Chunk,
+	}
+}
+
+// RequestLoadSnapshotChunkV2ToV1 converts an ABCI v2 LoadSnapshotChunk request to ABCI v1.
+func RequestLoadSnapshotChunkV2ToV1(req *abciv2.RequestLoadSnapshotChunk) *abciv1.RequestLoadSnapshotChunk {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestLoadSnapshotChunk{
+		Height: req.Height,
+		Format: req.Format,
+		Chunk:  req.Chunk,
+	}
+}
+
+// RequestApplySnapshotChunkV1ToV2 converts an ABCI v1 ApplySnapshotChunk request to ABCI v2.
+func RequestApplySnapshotChunkV1ToV2(req *abciv1.RequestApplySnapshotChunk) *abciv2.RequestApplySnapshotChunk {
+	if req == nil {
+		return nil
+	}
+	return &abciv2.RequestApplySnapshotChunk{
+		Index:  req.Index,
+		Chunk:  req.Chunk,
+		Sender: req.Sender,
+	}
+}
+
+// RequestApplySnapshotChunkV2ToV1 converts an ABCI v2 ApplySnapshotChunk request to ABCI v1.
+func RequestApplySnapshotChunkV2ToV1(req *abciv2.RequestApplySnapshotChunk) *abciv1.RequestApplySnapshotChunk {
+	if req == nil {
+		return nil
+	}
+	return &abciv1.RequestApplySnapshotChunk{
+		Index:  req.Index,
+		Chunk:  req.Chunk,
+		Sender: req.Sender,
+	}
+}
+
+// RequestPrepareProposalV1ToV2 converts an ABCI v1 PrepareProposal request to
+// ABCI v2. ABCI v2 has no chain ID and the block data only holds the
+// transactions, so the chain ID, the square size and the data hash must be
+// empty. A nil block data is converted like an empty one.
+func RequestPrepareProposalV1ToV2(req *abciv1.RequestPrepareProposal) (*abciv2.RequestPrepareProposal, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	f := newFields("RequestPrepareProposal")
+	f.check("ChainId", req.ChainId != "")
+	f.check("BlockData.SquareSize", req.BlockData.GetSquareSize() != 0)
+	f.check("BlockData.Hash", len(req.BlockData.GetHash()) > 0)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv2.RequestPrepareProposal{
+		MaxTxBytes: req.BlockDataSize,
+		Txs:        req.BlockData.GetTxs(),
+		Height:     req.Height,
+		Time:       req.Time,
+	}, nil
+}
+
+// RequestPrepareProposalV2ToV1 converts an ABCI v2 PrepareProposal request to
+// ABCI v1. ABCI v1 has no last commit, misbehavior, next validators hash or
+// proposer address, so they must be empty.
+func RequestPrepareProposalV2ToV1(req *abciv2.RequestPrepareProposal) (*abciv1.RequestPrepareProposal, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	f := newFields("RequestPrepareProposal")
+	f.check("LocalLastCommit", req.LocalLastCommit.Round != 0 || len(req.LocalLastCommit.Votes) > 0)
+	f.check("Misbehavior", len(req.Misbehavior) > 0)
+	f.check("NextValidatorsHash", len(req.NextValidatorsHash) > 0)
+	f.check("ProposerAddress", len(req.ProposerAddress) > 0)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.RequestPrepareProposal{
+		BlockData:     &typesv1.Data{Txs: req.Txs},
+		BlockDataSize: req.MaxTxBytes,
+		Height:        req.Height,
+		Time:          req.Time,
+	}, nil
+}
+
+// RequestProcessProposalV1ToV2 converts an ABCI v1 ProcessProposal request to
+// ABCI v2. The height, time, next validators hash and proposer address are
+// taken from the header. A nil block data is converted like an empty one.
+func RequestProcessProposalV1ToV2(req *abciv1.RequestProcessProposal) *abciv2.RequestProcessProposal {
+	if req == nil {
+		return nil
+	}
+
+	header := HeaderV1ToV2(req.Header)
+	return &abciv2.RequestProcessProposal{
+		Txs:                req.BlockData.GetTxs(),
+		Height:             header.Height,
+		Time:               header.Time,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ProposerAddress:    header.ProposerAddress,
+		SquareSize:         req.BlockData.GetSquareSize(),
+		DataRootHash:       req.BlockData.GetHash(),
+		Header:             &header,
+	}
+}
+
+// RequestProcessProposalV2ToV1 converts an ABCI v2 ProcessProposal request to
+// ABCI v1. The request must have a header that matches its height, time, next
+// validators hash and proposer address. ABCI v1 has no last commit,
+// misbehavior or block hash, so they must be empty.
+func RequestProcessProposalV2ToV1(req *abciv2.RequestProcessProposal) (*abciv1.RequestProcessProposal, error) {
+	if req == nil {
+		return nil, nil
+	}
+	if req.Header == nil {
+		return nil, errors.New("RequestProcessProposal.Header is required")
+	}
+
+	f := newFields("RequestProcessProposal")
+	f.check("ProposedLastCommit", req.ProposedLastCommit.Round != 0 || len(req.ProposedLastCommit.Votes) > 0)
+	f.check("Misbehavior", len(req.Misbehavior) > 0)
+	f.check("Hash", len(req.Hash) > 0)
+	f.check("Height", req.Height != req.Header.Height)
+	f.check("Time", !req.Time.Equal(req.Header.Time))
+	f.check("NextValidatorsHash", !bytes.Equal(req.NextValidatorsHash, req.Header.NextValidatorsHash))
+	f.check("ProposerAddress", !bytes.Equal(req.ProposerAddress, req.Header.ProposerAddress))
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.RequestProcessProposal{
+		Header: HeaderV2ToV1(*req.Header),
+		BlockData: &typesv1.Data{
+			Txs:        req.Txs,
+			SquareSize: req.SquareSize,
+			Hash:       req.DataRootHash,
+		},
+	}, nil
+}
+
+// RequestFinalizeBlockV1ToV2 converts the ABCI v1 requests that execute a
+// block to an ABCI v2 FinalizeBlock request. The height, time, next validators
+// hash and proposer address are taken from the header of BeginBlock, which
+// must match the height of EndBlock.
+func RequestFinalizeBlockV1ToV2(block *RequestBlockV1) (*abciv2.RequestFinalizeBlock, error) {
+	if block == nil {
+		return nil, nil
+	}
+	if block.BeginBlock == nil || block.EndBlock == nil {
+		return nil, errors.New("RequestBlockV1.BeginBlock and RequestBlockV1.EndBlock are required")
+	}
+
+	f := newFields("RequestBlockV1")
+	f.check("EndBlock.Height", block.EndBlock.Height != block.BeginBlock.Header.Height)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	var txs [][]byte
+	if block.DeliverTxs != nil {
+		txs = make([][]byte, len(block.DeliverTxs))
+		for i, req := range block.DeliverTxs {
+			txs[i] = req.GetTx()
+		}
+	}
+
+	header := HeaderV1ToV2(block.BeginBlock.Header)
+	return &abciv2.RequestFinalizeBlock{
+		Txs:                txs,
+		DecidedLastCommit:  CommitInfoV1ToV2(block.BeginBlock.LastCommitInfo),
+		Misbehavior:        EvidenceV1ToV2(block.BeginBlock.ByzantineValidators),
+		Hash:               block.BeginBlock.Hash,
+		Height:             header.Height,
+		Time:               header.Time,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ProposerAddress:    header.ProposerAddress,
+		Header:             &header,
+	}, nil
+}
+
+// RequestFinalizeBlockV2ToV1 converts an ABCI v2 FinalizeBlock request to the
+// ABCI v1 requests that execute a block. The request must have a header that
+// matches its height, time, next validators hash and proposer address.
+func RequestFinalizeBlockV2ToV1(req *abciv2.RequestFinalizeBlock) (*RequestBlockV1, error) {
+	if req == nil {
+		return nil, nil
+	}
+	if req.Header == nil {
+		return nil, errors.New("RequestFinalizeBlock.Header is required")
+	}
+
+	f := newFields("RequestFinalizeBlock")
+	f.check("Height", req.Height != req.Header.Height)
+	f.check("Time", !req.Time.Equal(req.Header.Time))
+	f.check("NextValidatorsHash", !bytes.Equal(req.NextValidatorsHash, req.Header.NextValidatorsHash))
+	f.check("ProposerAddress", !bytes.Equal(req.ProposerAddress, req.Header.ProposerAddress))
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	var deliverTxs []*abciv1.RequestDeliverTx
+	if req.Txs != nil {
+		deliverTxs = make([]*abciv1.RequestDeliverTx, len(req.Txs))
+		for i, tx := range req.Txs {
+			deliverTxs[i] = &abciv1.RequestDeliverTx{Tx: tx}
+		}
+	}
+
+	return &RequestBlockV1{
+		BeginBlock: &abciv1.RequestBeginBlock{
+			Hash:                req.Hash,
+			Header:              HeaderV2ToV1(*req.Header),
+			LastCommitInfo:      CommitInfoV2ToV1(req.DecidedLastCommit),
+			ByzantineValidators: EvidenceV2ToV1(req.Misbehavior),
+		},
+		DeliverTxs: deliverTxs,
+		EndBlock:   &abciv1.RequestEndBlock{Height: req.Height},
+	}, nil
+}
@@ -0,0 +1,468 @@
+package convert
+
+import (
+	abciv2 "github.com/cometbft/cometbft/abci/types"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	typesv1 "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ResponseBlockV1 holds the ABCI v1 responses of the requests that execute a
+// block and the app hash returned by the following Commit, which are replaced
+// by a single FinalizeBlock response in ABCI v2.
+type ResponseBlockV1 struct {
+	BeginBlock *abciv1.ResponseBeginBlock
+	DeliverTxs []*abciv1.ResponseDeliverTx
+	EndBlock   *abciv1.ResponseEndBlock
+	AppHash    []byte
+}
+
+// ResponseExceptionV1ToV2 converts an ABCI v1 Exception response to ABCI v2.
+func ResponseExceptionV1ToV2(resp *abciv1.ResponseException) *abciv2.ResponseException {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseException{Error: resp.Error}
+}
+
+// ResponseExceptionV2ToV1 converts an ABCI v2 Exception response to ABCI v1.
+func ResponseExceptionV2ToV1(resp *abciv2.ResponseException) *abciv1.ResponseException {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseException{Error: resp.Error}
+}
+
+// ResponseEchoV1ToV2 converts an ABCI v1 Echo response to ABCI v2.
+func ResponseEchoV1ToV2(resp *abciv1.ResponseEcho) *abciv2.ResponseEcho {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseEcho{Message: resp.Message}
+}
+
+// ResponseEchoV2ToV1 converts an ABCI v2 Echo response to ABCI v1.
+func ResponseEchoV2ToV1(resp *abciv2.ResponseEcho) *abciv1.ResponseEcho {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseEcho{Message: resp.Message}
+}
+
+// ResponseFlushV1ToV2 converts an ABCI v1 Flush response to ABCI v2.
+func ResponseFlushV1ToV2(resp *abciv1.ResponseFlush) *abciv2.ResponseFlush {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseFlush{}
+}
+
+// ResponseFlushV2ToV1 converts an ABCI v2 Flush response to ABCI v1.
+func ResponseFlushV2ToV1(resp *abciv2.ResponseFlush) *abciv1.ResponseFlush {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseFlush{}
+}
+
+// ResponseInfoV1ToV2 converts an ABCI v1 Info response to ABCI v2.
+func ResponseInfoV1ToV2(resp *abciv1.ResponseInfo) *abciv2.ResponseInfo {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseInfo{
+		Data:             resp.Data,
+		Version:          resp.Version,
+		AppVersion:       resp.AppVersion,
+		LastBlockHeight:  resp.LastBlockHeight,
+		LastBlockAppHash: resp.LastBlockAppHash,
+		TimeoutInfo:      TimeoutInfoV1ToV2(resp.Timeouts),
+	}
+}
+
+// ResponseInfoV2ToV1 converts an ABCI v2 Info response to ABCI v1.
+func ResponseInfoV2ToV1(resp *abciv2.ResponseInfo) (*abciv1.ResponseInfo, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseInfo")
+	timeouts, err := TimeoutInfoV2ToV1(resp.TimeoutInfo)
+	f.add("TimeoutInfo", err)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.ResponseInfo{
+		Data:             resp.Data,
+		Version:          resp.Version,
+		AppVersion:       resp.AppVersion,
+		LastBlockHeight:  resp.LastBlockHeight,
+		LastBlockAppHash: resp.LastBlockAppHash,
+		Timeouts:         timeouts,
+	}, nil
+}
+
+// ResponseInitChainV1ToV2 converts an ABCI v1 InitChain response to ABCI v2.
+func ResponseInitChainV1ToV2(resp *abciv1.ResponseInitChain) *abciv2.ResponseInitChain {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseInitChain{
+		ConsensusParams: ConsensusParamsV1ToV2(resp.ConsensusParams),
+		Validators:      ValidatorUpdatesV1ToV2(resp.Validators),
+		AppHash:         resp.AppHash,
+		TimeoutInfo:     TimeoutInfoV1ToV2(resp.Timeouts),
+	}
+}
+
+// ResponseInitChainV2ToV1 converts an ABCI v2 InitChain response to ABCI v1.
+func ResponseInitChainV2ToV1(resp *abciv2.ResponseInitChain) (*abciv1.ResponseInitChain, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseInitChain")
+	consensusParams, err := ConsensusParamsV2ToV1(resp.ConsensusParams)
+	f.add("ConsensusParams", err)
+	timeouts, err := TimeoutInfoV2ToV1(resp.TimeoutInfo)
+	f.add("TimeoutInfo", err)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.ResponseInitChain{
+		ConsensusParams: consensusParams,
+		Validators:      ValidatorUpdatesV2ToV1(resp.Validators),
+		AppHash:         resp.AppHash,
+		Timeouts:        timeouts,
+	}, nil
+}
+
+// ResponseQueryV1ToV2 converts an ABCI v1 Query response to ABCI v2.
+func ResponseQueryV1ToV2(resp *abciv1.ResponseQuery) *abciv2.ResponseQuery {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseQuery{
+		Code:      resp.Code,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		Index:     resp.Index,
+		Key:       resp.Key,
+		Value:     resp.Value,
+		ProofOps:  ProofOpsV1ToV2(resp.ProofOps),
+		Height:    resp.Height,
+		Codespace: resp.Codespace,
+	}
+}
+
+// ResponseQueryV2ToV1 converts an ABCI v2 Query response to ABCI v1.
+func ResponseQueryV2ToV1(resp *abciv2.ResponseQuery) *abciv1.ResponseQuery {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseQuery{
+		Code:      resp.Code,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		Index:     resp.Index,
+		Key:       resp.Key,
+		Value:     resp.Value,
+		ProofOps:  ProofOpsV2ToV1(resp.ProofOps),
+		Height:    resp.Height,
+		Codespace: resp.Codespace,
+	}
+}
+
+// ResponseCheckTxV1ToV2 converts an ABCI v1 CheckTx response to ABCI v2. ABCI
+// v2 has no sender or mempool error, so they must be empty.
+func ResponseCheckTxV1ToV2(resp *abciv1.ResponseCheckTx) (*abciv2.ResponseCheckTx, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseCheckTx")
+	f.check("Sender", resp.Sender != "")
+	f.check("MempoolError", resp.MempoolError != "")
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv2.ResponseCheckTx{
+		Code:      resp.Code,
+		Data:      resp.Data,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		GasWanted: resp.GasWanted,
+		GasUsed:   resp.GasUsed,
+		Events:    EventsV1ToV2(resp.Events),
+		Codespace: resp.Codespace,
+		Priority:  resp.Priority,
+	}, nil
+}
+
+// ResponseCheckTxV2ToV1 converts an ABCI v2 CheckTx response to ABCI v1. ABCI
+// v1 has no signer address or sequence, so they must be empty.
+func ResponseCheckTxV2ToV1(resp *abciv2.ResponseCheckTx) (*abciv1.ResponseCheckTx, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseCheckTx")
+	f.check("Address", len(resp.Address) > 0)
+	f.check("Sequence", resp.Sequence != 0)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv1.ResponseCheckTx{
+		Code:      resp.Code,
+		Data:      resp.Data,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		GasWanted: resp.GasWanted,
+		GasUsed:   resp.GasUsed,
+		Events:    EventsV2ToV1(resp.Events),
+		Codespace: resp.Codespace,
+		Priority:  resp.Priority,
+	}, nil
+}
+
+// ResponseCommitV1ToV2 converts an ABCI v1 Commit response to ABCI v2 and
+// returns the app hash separately, as it is part of the FinalizeBlock response
+// in ABCI v2.
+func ResponseCommitV1ToV2(resp *abciv1.ResponseCommit) (*abciv2.ResponseCommit, []byte) {
+	if resp == nil {
+		return nil, nil
+	}
+	return &abciv2.ResponseCommit{RetainHeight: resp.RetainHeight}, resp.Data
+}
+
+// ResponseCommitV2ToV1 converts an ABCI v2 Commit response and the app hash of
+// the FinalizeBlock response to an ABCI v1 Commit response.
+func ResponseCommitV2ToV1(resp *abciv2.ResponseCommit, appHash []byte) *abciv1.ResponseCommit {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseCommit{
+		Data:         appHash,
+		RetainHeight: resp.RetainHeight,
+	}
+}
+
+// ResponseListSnapshotsV1ToV2 converts an ABCI v1 ListSnapshots response to ABCI v2.
+func ResponseListSnapshotsV1ToV2(resp *abciv1.ResponseListSnapshots) *abciv2.ResponseListSnapshots {
+	if resp == nil {
+		return nil
+	}
+
+	v2Resp := &abciv2.ResponseListSnapshots{}
+	if resp.Snapshots != nil {
+		v2Resp.Snapshots = make([]*abciv2.Snapshot, len(resp.Snapshots))
+		for i, snapshot := range resp.Snapshots {
+			v2Resp.Snapshots[i] = SnapshotV1ToV2(snapshot)
+		}
+	}
+	return v2Resp
+}
+
+// ResponseListSnapshotsV2ToV1 converts an ABCI v2 ListSnapshots response to ABCI v1.
+func ResponseListSnapshotsV2ToV1(resp *abciv2.ResponseListSnapshots) *abciv1.ResponseListSnapshots {
+	if resp == nil {
+		return nil
+	}
+
+	v1Resp := &abciv1.ResponseListSnapshots{}
+	if resp.Snapshots != nil {
+		v1Resp.Snapshots = make([]*abciv1.Snapshot, len(resp.Snapshots))
+		for i, snapshot := range resp.Snapshots {
+			v1Resp.Snapshots[i] = SnapshotV2ToV1(snapshot)
+		}
+	}
+	return v1Resp
+}
+
+// ResponseOfferSnapshotV1ToV2 converts an ABCI v1 OfferSnapshot response to ABCI v2.
+func ResponseOfferSnapshotV1ToV2(resp *abciv1.ResponseOfferSnapshot) *abciv2.ResponseOfferSnapshot {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseOfferSnapshot{
+		Result: abciv2.ResponseOfferSnapshot_Result(resp.Result),
+	}
+}
+
+// ResponseOfferSnapshotV2ToV1 converts an ABCI v2 OfferSnapshot response to ABCI v1.
+func ResponseOfferSnapshotV2ToV1(resp *abciv2.ResponseOfferSnapshot) *abciv1.ResponseOfferSnapshot {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseOfferSnapshot{
+		Result: abciv1.ResponseOfferSnapshot_Result(resp.Result),
+	}
+}
+
+// ResponseLoadSnapshotChunkV1ToV2 converts an ABCI v1 LoadSnapshotChunk response to ABCI v2.
+func ResponseLoadSnapshotChunkV1ToV2(resp *abciv1.ResponseLoadSnapshotChunk) *abciv2.ResponseLoadSnapshotChunk {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseLoadSnapshotChunk{Chunk: resp.Chunk}
+}
+
+// ResponseLoadSnapshotChunkV2ToV1 converts an ABCI v2 LoadSnapshotChunk response to ABCI v1.
+func ResponseLoadSnapshotChunkV2ToV1(resp *abciv2.ResponseLoadSnapshotChunk) *abciv1.ResponseLoadSnapshotChunk {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseLoadSnapshotChunk{Chunk: resp.Chunk}
+}
+
+// ResponseApplySnapshotChunkV1ToV2 converts an ABCI v1 ApplySnapshotChunk response to ABCI v2.
+func ResponseApplySnapshotChunkV1ToV2(resp *abciv1.ResponseApplySnapshotChunk) *abciv2.ResponseApplySnapshotChunk {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponseApplySnapshotChunk{
+		Result:        abciv2.ResponseApplySnapshotChunk_Result(resp.Result),
+		RefetchChunks: resp.RefetchChunks,
+		RejectSenders: resp.RejectSenders,
+	}
+}
+
+// ResponseApplySnapshotChunkV2ToV1 converts an ABCI v2 ApplySnapshotChunk response to ABCI v1.
+func ResponseApplySnapshotChunkV2ToV1(resp *abciv2.ResponseApplySnapshotChunk) *abciv1.ResponseApplySnapshotChunk {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseApplySnapshotChunk{
+		Result:        abciv1.ResponseApplySnapshotChunk_Result(resp.Result),
+		RefetchChunks: resp.RefetchChunks,
+		RejectSenders: resp.RejectSenders,
+	}
+}
+
+// ResponsePrepareProposalV1ToV2 converts an ABCI v1 PrepareProposal response
+// to ABCI v2. A nil block data is converted like an empty one.
+func ResponsePrepareProposalV1ToV2(resp *abciv1.ResponsePrepareProposal) *abciv2.ResponsePrepareProposal {
+	if resp == nil {
+		return nil
+	}
+	return &abciv2.ResponsePrepareProposal{
+		Txs:          resp.BlockData.GetTxs(),
+		SquareSize:   resp.BlockData.GetSquareSize(),
+		DataRootHash: resp.BlockData.GetHash(),
+	}
+}
+
+// ResponsePrepareProposalV2ToV1 converts an ABCI v2 PrepareProposal response to ABCI v1.
+func ResponsePrepareProposalV2ToV1(resp *abciv2.ResponsePrepareProposal) *abciv1.ResponsePrepareProposal {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponsePrepareProposal{
+		BlockData: &typesv1.Data{
+			Txs:        resp.Txs,
+			SquareSize: resp.SquareSize,
+			Hash:       resp.DataRootHash,
+		},
+	}
+}
+
+// ResponseProcessProposalV1ToV2 converts an ABCI v1 ProcessProposal response
+// to ABCI v2. ABCI v2 has no evidence in the response, so it must be empty.
+func ResponseProcessProposalV1ToV2(resp *abciv1.ResponseProcessProposal) (*abciv2.ResponseProcessProposal, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseProcessProposal")
+	f.check("Evidence", len(resp.Evidence) > 0)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	return &abciv2.ResponseProcessProposal{
+		Status: abciv2.ResponseProcessProposal_ProposalStatus(resp.Result),
+	}, nil
+}
+
+// ResponseProcessProposalV2ToV1 converts an ABCI v2 ProcessProposal response to ABCI v1.
+func ResponseProcessProposalV2ToV1(resp *abciv2.ResponseProcessProposal) *abciv1.ResponseProcessProposal {
+	if resp == nil {
+		return nil
+	}
+	return &abciv1.ResponseProcessProposal{
+		Result: abciv1.ResponseProcessProposal_Result(resp.Status),
+	}
+}
+
+// ResponseFinalizeBlockV1ToV2 converts the ABCI v1 responses of the requests
+// that execute a block to an ABCI v2 FinalizeBlock response. The events of
+// BeginBlock are followed by the events of EndBlock.
+func ResponseFinalizeBlockV1ToV2(block *ResponseBlockV1) *abciv2.ResponseFinalizeBlock {
+	if block == nil {
+		return nil
+	}
+
+	events := EventsV1ToV2(block.EndBlock.GetEvents())
+	if beginBlockEvents := block.BeginBlock.GetEvents(); beginBlockEvents != nil {
+		events = append(EventsV1ToV2(beginBlockEvents), events...)
+	}
+
+	var txResults []*abciv2.ExecTxResult
+	if block.DeliverTxs != nil {
+		txResults = make([]*abciv2.ExecTxResult, len(block.DeliverTxs))
+		for i, resp := range block.DeliverTxs {
+			txResults[i] = TxResultV1ToV2(resp)
+		}
+	}
+
+	return &abciv2.ResponseFinalizeBlock{
+		Events:                events,
+		TxResults:             txResults,
+		ValidatorUpdates:      ValidatorUpdatesV1ToV2(block.EndBlock.GetValidatorUpdates()),
+		ConsensusParamUpdates: ConsensusParamsV1ToV2(block.EndBlock.GetConsensusParamUpdates()),
+		AppHash:               block.AppHash,
+		TimeoutInfo:           TimeoutInfoV1ToV2(block.EndBlock.GetTimeouts()),
+	}
+}
+
+// ResponseFinalizeBlockV2ToV1 converts an ABCI v2 FinalizeBlock response to
+// the ABCI v1 responses of the requests that execute a block. ABCI v2 does not
+// record whether an event was emitted by BeginBlock or EndBlock, so all events
+// are returned by EndBlock.
+func ResponseFinalizeBlockV2ToV1(resp *abciv2.ResponseFinalizeBlock) (*ResponseBlockV1, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	f := newFields("ResponseFinalizeBlock")
+	consensusParams, err := ConsensusParamsV2ToV1(resp.ConsensusParamUpdates)
+	f.add("ConsensusParamUpdates", err)
+	timeouts, err := TimeoutInfoV2ToV1(resp.TimeoutInfo)
+	f.add("TimeoutInfo", err)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+
+	var deliverTxs []*abciv1.ResponseDeliverTx
+	if resp.TxResults != nil {
+		deliverTxs = make([]*abciv1.ResponseDeliverTx, len(resp.TxResults))
+		for i, result := range resp.TxResults {
+			deliverTxs[i] = TxResultV2ToV1(result)
+		}
+	}
+
+	return &ResponseBlockV1{
+		BeginBlock: &abciv1.ResponseBeginBlock{},
+		DeliverTxs: deliverTxs,
+		EndBlock: &abciv1.ResponseEndBlock{
+			ValidatorUpdates:      ValidatorUpdatesV2ToV1(resp.ValidatorUpdates),
+			ConsensusParamUpdates: consensusParams,
+			Events:                EventsV2ToV1(resp.Events),
+			Timeouts:              timeouts,
+		},
+		AppHash: resp.AppHash,
+	}, nil
+}
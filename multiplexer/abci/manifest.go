@@ -0,0 +1,129 @@
+package abci
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
+)
+
+// Manifest describes app binaries that live on disk instead of being embedded
+// in the binary. It allows operators to plug in an out-of-tree binary for a
+// given app version without rebuilding the multiplexer.
+//
+// Example:
+//
+//	{
+//	  "versions": [
+//	    {
+//	      "app_version": 5,
+//	      "binary": "/usr/local/bin/celestia-appd-v5-patched",
+//	      "abci_version": 2,
+//	      "start_args": ["--with-tendermint=false", "--transport=grpc"],
+//	      "sha256": "<hex encoded SHA-256 of the binary>"
+//	    }
+//	  ]
+//	}
+type Manifest struct {
+	Versions []ManifestVersion `json:"versions"`
+}
+
+// ManifestVersion is a single app version of a Manifest.
+type ManifestVersion struct {
+	// AppVersion is the app version served by the binary.
+	AppVersion uint64 `json:"app_version"`
+	// Name is an optional human readable name of the binary, e.g. "v5.0.1-patched".
+	// Defaults to "v<app_version>".
+	Name string `json:"name,omitempty"`
+	// Binary is the path to the binary. Relative paths are resolved against the
+	// directory containing the manifest.
+	Binary string `json:"binary"`
+	// ABCIVersion is the ABCI version used to talk to the binary: 1 for
+	// celestia-core v0.34 based binaries and 2 for v0.38 based binaries.
+	ABCIVersion uint `json:"abci_version"`
+	// StartArgs are extra arguments passed to the binary on start. The default
+	// flags are used when empty.
+	StartArgs []string `json:"start_args,omitempty"`
+	// PreHandlers are commands run before starting the binary.
+	PreHandlers []string `json:"pre_handlers,omitempty"`
+	// SHA256 is the optional hex encoded SHA-256 checksum of the binary.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// LoadManifest reads the versions manifest at path and returns the versions it
+// describes.
+func LoadManifest(path string) (Versions, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions manifest: %w", err)
+	}
+
+	var manifest Manifest
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse versions manifest %s: %w", path, err)
+	}
+
+	versions, err := manifest.toVersions(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("invalid versions manifest %s: %w", path, err)
+	}
+	return versions, nil
+}
+
+// toVersions converts the manifest into versions. Relative binary paths are
+// resolved against baseDir.
+func (m Manifest) toVersions(baseDir string) (Versions, error) {
+	versions := make([]Version, 0, len(m.Versions))
+	for _, entry := range m.Versions {
+		abciVersion, err := toABCIClientVersion(entry.ABCIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("version %d: %w", entry.AppVersion, err)
+		}
+
+		if entry.Binary == "" {
+			return nil, fmt.Errorf("version %d: no binary specified", entry.AppVersion)
+		}
+
+		binary := entry.Binary
+		if !filepath.IsAbs(binary) {
+			binary = filepath.Join(baseDir, binary)
+		}
+
+		name := entry.Name
+		if name == "" {
+			name = fmt.Sprintf("v%d", entry.AppVersion)
+		}
+
+		app, err := appd.NewFromPath(name, binary, entry.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("version %d: %w", entry.AppVersion, err)
+		}
+
+		versions = append(versions, Version{
+			AppVersion:  entry.AppVersion,
+			ABCIVersion: abciVersion,
+			Appd:        app,
+			PreHandlers: entry.PreHandlers,
+			StartArgs:   entry.StartArgs,
+		})
+	}
+
+	return NewVersions(versions...)
+}
+
+// toABCIClientVersion converts the ABCI version of a manifest entry into an ABCIClientVersion.
+func toABCIClientVersion(version uint) (ABCIClientVersion, error) {
+	switch version {
+	case 1:
+		return ABCIClientVersion1, nil
+	case 2:
+		return ABCIClientVersion2, nil
+	default:
+		return 0, fmt.Errorf("unknown ABCI version %d, expected 1 or 2", version)
+	}
+}
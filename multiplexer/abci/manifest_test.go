@@ -0,0 +1,151 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeBinary writes an executable script to dir and returns its name and checksum.
+func writeBinary(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	content := []byte("#!/bin/sh\necho " + name + "\n")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0o755))
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func writeManifest(t *testing.T, dir string, manifest any) string {
+	t.Helper()
+
+	bz, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "versions.json")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	return path
+}
+
+func TestLoadManifest(t *testing.T) {
+	dir := t.TempDir()
+	v4Checksum := writeBinary(t, dir, "appd-v4")
+	writeBinary(t, dir, "appd-v3")
+
+	path := writeManifest(t, dir, Manifest{Versions: []ManifestVersion{
+		{AppVersion: 4, Binary: "appd-v4", ABCIVersion: 2, SHA256: v4Checksum, StartArgs: []string{"--transport=grpc"}},
+		{AppVersion: 3, Name: "v3-patched", Binary: filepath.Join(dir, "appd-v3"), ABCIVersion: 1},
+	}})
+
+	versions, err := LoadManifest(path)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+
+	require.Equal(t, uint64(3), versions[0].AppVersion)
+	require.Equal(t, ABCIClientVersion1, versions[0].ABCIVersion)
+	require.Equal(t, "v3-patched", versions[0].Appd.Version())
+
+	require.Equal(t, uint64(4), versions[1].AppVersion)
+	require.Equal(t, ABCIClientVersion2, versions[1].ABCIVersion)
+	require.Equal(t, "v4", versions[1].Appd.Version())
+	require.Equal(t, filepath.Join(dir, "appd-v4"), versions[1].Appd.Path())
+	require.Equal(t, []string{"--transport=grpc"}, versions[1].StartArgs)
+	require.NoError(t, versions.Validate())
+
+	// modifying the binary after loading the manifest invalidates the versions.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "appd-v4"), []byte("#!/bin/sh\n"), 0o755))
+	require.ErrorContains(t, versions.Validate(), "checksum mismatch")
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		versions    []ManifestVersion
+		expectedErr string
+	}{
+		{
+			name:        "no versions",
+			versions:    nil,
+			expectedErr: "no versions specified",
+		},
+		{
+			name:        "unknown abci version",
+			versions:    []ManifestVersion{{AppVersion: 4, Binary: "appd", ABCIVersion: 3}},
+			expectedErr: "unknown ABCI version 3",
+		},
+		{
+			name:        "missing binary",
+			versions:    []ManifestVersion{{AppVersion: 4, ABCIVersion: 2}},
+			expectedErr: "no binary specified",
+		},
+		{
+			name:        "binary not found",
+			versions:    []ManifestVersion{{AppVersion: 4, Binary: "missing", ABCIVersion: 2}},
+			expectedErr: "binary for v4 not found",
+		},
+		{
+			name:        "checksum mismatch",
+			versions:    []ManifestVersion{{AppVersion: 4, Binary: "appd", ABCIVersion: 2, SHA256: "00"}},
+			expectedErr: "checksum mismatch",
+		},
+		{
+			name: "duplicate app versions",
+			versions: []ManifestVersion{
+				{AppVersion: 4, Binary: "appd", ABCIVersion: 2},
+				{AppVersion: 4, Binary: "appd", ABCIVersion: 2},
+			},
+			expectedErr: "version 4 specified multiple times",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeBinary(t, dir, "appd")
+			path := writeManifest(t, dir, Manifest{Versions: tt.versions})
+
+			_, err := LoadManifest(path)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+
+	t.Run("unknown field", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeManifest(t, dir, map[string]any{"versions": []map[string]any{{"app_version": 4, "path": "appd"}}})
+
+		_, err := LoadManifest(path)
+		require.ErrorContains(t, err, "unknown field")
+	})
+
+	t.Run("missing manifest", func(t *testing.T) {
+		_, err := LoadManifest(filepath.Join(t.TempDir(), "versions.json"))
+		require.ErrorContains(t, err, "failed to read versions manifest")
+	})
+}
+
+func TestOverride(t *testing.T) {
+	versions := Versions{
+		{AppVersion: 3, ABCIVersion: ABCIClientVersion1},
+		{AppVersion: 4, ABCIVersion: ABCIClientVersion2},
+	}
+
+	got, err := versions.Override(Versions{
+		{AppVersion: 5, ABCIVersion: ABCIClientVersion2},
+		{AppVersion: 4, ABCIVersion: ABCIClientVersion2, StartArgs: []string{"--patched"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, Versions{
+		{AppVersion: 3, ABCIVersion: ABCIClientVersion1},
+		{AppVersion: 4, ABCIVersion: ABCIClientVersion2, StartArgs: []string{"--patched"}},
+		{AppVersion: 5, ABCIVersion: ABCIClientVersion2},
+	}, got)
+
+	// the original versions are left untouched.
+	require.Nil(t, versions[1].StartArgs)
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/internal"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
@@ -41,6 +42,10 @@ import (
 const (
 	flagTraceStore = "trace-store"
 	flagGRPCOnly   = "grpc-only"
+
+	// FlagStatusAddress is the address of the HTTP endpoint serving the
+	// multiplexer status. The endpoint is disabled if empty.
+	FlagStatusAddress = "multiplexer.status-address"
 )
 
 // Multiplexer is responsible for managing multiple versions of applications and coordinating their lifecycle.
@@ -67,6 +72,8 @@ type Multiplexer struct {
 	nativeApp servertypes.Application
 	// activeVersion is the currently active embedded version that is running.
 	activeVersion Version
+	// supervisor restarts the embedded app of activeVersion if it exits unexpectedly.
+	supervisor *appd.Supervisor
 	// chainID is required as it needs to be propagated to the ABCI V1 connection.
 	chainID string
 	// cmNode is the comet node which has been created. A reference is required in order to establish
@@ -125,6 +132,10 @@ func (m *Multiplexer) Start() error {
 
 	emitServerInfoMetrics()
 
+	if err := m.startStatusServer(); err != nil {
+		return err
+	}
+
 	// startApp starts the underlying application, either native or embedded.
 	if err := m.startApp(); err != nil {
 		return err
@@ -219,9 +230,11 @@ func (m *Multiplexer) startApp() error {
 
 		// start an embedded app.
 		m.logger.Debug("starting embedded app", "app_version", currentVersion.AppVersion, "args", currentVersion.GetStartArgs(programArgs))
-		if err := currentVersion.Appd.Start(currentVersion.GetStartArgs(programArgs)...); err != nil {
+		supervisor := m.newSupervisor(currentVersion)
+		if err := supervisor.Start(currentVersion.GetStartArgs(programArgs)...); err != nil {
 			return fmt.Errorf("failed to start app: %w", err)
 		}
+		m.supervisor = supervisor
 
 		if currentVersion.Appd.IsStopped() { // should never happen
 			return fmt.Errorf("app failed to start")
@@ -492,9 +505,11 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 		programArgs := removeStart(os.Args)
 
 		m.logger.Info("Starting app for version", "app_version", version.AppVersion, "args", version.GetStartArgs(programArgs))
-		if err := version.Appd.Start(version.GetStartArgs(programArgs)...); err != nil {
+		supervisor := m.newSupervisor(version)
+		if err := supervisor.Start(version.GetStartArgs(programArgs)...); err != nil {
 			return fmt.Errorf("failed to start app for version %d: %w", m.appVersion, err)
 		}
+		m.supervisor = supervisor
 
 		if version.Appd.IsStopped() {
 			return fmt.Errorf("app for version %d stopped", version.AppVersion)
@@ -506,6 +521,56 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 	return nil
 }
 
+// newSupervisor returns a supervisor which restarts the embedded app of version
+// if it exits unexpectedly.
+func (m *Multiplexer) newSupervisor(version Version) *appd.Supervisor {
+	return appd.NewSupervisor(
+		version.Appd,
+		m.logger.With("app_version", version.AppVersion),
+		appd.DefaultSupervisorConfig(),
+		func() error { return m.resyncEmbeddedApp(version) },
+	)
+}
+
+// resyncEmbeddedApp is called after the embedded app of version has been
+// restarted by the supervisor. It replays Info, which reloads the latest
+// committed state of the app, and fails if the app height doesn't match the
+// height of the block store. It must not acquire m.mu as it runs concurrently
+// with getApp.
+func (m *Multiplexer) resyncEmbeddedApp(version Version) error {
+	conn := m.conn
+	if conn == nil {
+		return fmt.Errorf("no connection to the embedded app")
+	}
+
+	var app servertypes.ABCI
+	switch version.ABCIVersion {
+	case ABCIClientVersion1:
+		app = NewRemoteABCIClientV1(conn, m.chainID, version.AppVersion)
+	case ABCIClientVersion2:
+		app = NewRemoteABCIClientV2(conn)
+	default:
+		return fmt.Errorf("unknown ABCI client version %d", version.ABCIVersion)
+	}
+
+	resp, err := app.Info(proxy.RequestInfo)
+	if err != nil {
+		return fmt.Errorf("failed to replay info: %w", err)
+	}
+	m.logger.Info("resynced embedded app", "app_version", resp.AppVersion, "height", resp.LastBlockHeight)
+
+	if m.cmNode == nil {
+		return nil
+	}
+	// Blocks are only replayed by the CometBFT handshake when the node starts,
+	// so an app that is not at the height of the block store, e.g. because it
+	// exited before committing the latest block, can't be resynced here.
+	if storeHeight := m.cmNode.BlockStore().Height(); resp.LastBlockHeight != storeHeight {
+		return fmt.Errorf("embedded app is at height %d but the block store is at height %d, restart the node to replay the missing blocks", resp.LastBlockHeight, storeHeight)
+	}
+	return nil
+}
+
 // embeddedVersionRunning returns true if there is an active version specified which is running.
 func (m *Multiplexer) embeddedVersionRunning() bool {
 	return m.activeVersion.Appd != nil && m.activeVersion.Appd.IsRunning()
@@ -589,13 +654,20 @@ func (m *Multiplexer) stopNativeApp() error {
 	return nil
 }
 
-// stopEmbeddedApp stops any embedded app versions if they are currently running.
+// stopEmbeddedApp stops any embedded app versions if they are currently running
+// or being restarted by the supervisor.
 func (m *Multiplexer) stopEmbeddedApp() error {
-	if !m.embeddedVersionRunning() {
+	if m.supervisor == nil && !m.embeddedVersionRunning() {
 		return nil
 	}
 	m.logger.Info("stopping embedded app for version", "active_app_version", m.activeVersion.AppVersion)
-	if err := m.activeVersion.Appd.Stop(); err != nil {
+	if m.supervisor != nil {
+		err := m.supervisor.Stop()
+		m.supervisor = nil
+		if err != nil {
+			return fmt.Errorf("failed to stop embedded app for version %d: %w", m.activeVersion.AppVersion, err)
+		}
+	} else if err := m.activeVersion.Appd.Stop(); err != nil {
 		return fmt.Errorf("failed to stop embedded app for version %d: %w", m.activeVersion.AppVersion, err)
 	}
 	m.started = false
@@ -1,8 +1,12 @@
 package abci
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"cosmossdk.io/log"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,3 +56,16 @@ func TestRemoveStart(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestHandleStatus(t *testing.T) {
+	m := &Multiplexer{appVersion: 4, logger: log.NewNopLogger()}
+
+	recorder := httptest.NewRecorder()
+	m.handleStatus(recorder, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var status Status
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	require.Equal(t, Status{AppVersion: 4}, status)
+}
@@ -5,11 +5,10 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci/convert"
 	abciv2 "github.com/cometbft/cometbft/abci/types"
 	cryptov2 "github.com/cometbft/cometbft/proto/tendermint/crypto"
-	typesv2 "github.com/cometbft/cometbft/proto/tendermint/types"
 	abciv1 "github.com/tendermint/tendermint/abci/types"
-	cryptov1 "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	typesv1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	versionv1 "github.com/tendermint/tendermint/proto/tendermint/version"
 	"google.golang.org/grpc"
@@ -43,22 +42,14 @@ func NewRemoteABCIClientV1(conn *grpc.ClientConn, chainID string, initialAppVers
 func (a *RemoteABCIClientV1) ApplySnapshotChunk(req *abciv2.RequestApplySnapshotChunk) (*abciv2.ResponseApplySnapshotChunk, error) {
 	resp, err := a.ABCIApplicationClient.ApplySnapshotChunk(
 		context.Background(),
-		&abciv1.RequestApplySnapshotChunk{
-			Index:  req.Index,
-			Chunk:  req.Chunk,
-			Sender: req.Sender,
-		},
+		convert.RequestApplySnapshotChunkV2ToV1(req),
 		grpc.WaitForReady(true),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &abciv2.ResponseApplySnapshotChunk{
-		Result:        abciv2.ResponseApplySnapshotChunk_Result(resp.Result),
-		RefetchChunks: resp.RefetchChunks,
-		RejectSenders: resp.RejectSenders,
-	}, nil
+	return convert.ResponseApplySnapshotChunkV1ToV2(resp), nil
 }
 
 // CheckTx implements abciv2.ABCI
@@ -78,7 +69,7 @@ func (a *RemoteABCIClientV1) CheckTx(req *abciv2.RequestCheckTx) (*abciv2.Respon
 		Info:      resp.Info,
 		GasWanted: resp.GasWanted,
 		GasUsed:   resp.GasUsed,
-		Events:    abciEventV1ToV2(resp.Events...),
+		Events:    convert.EventsV1ToV2(resp.Events),
 		Codespace: resp.Codespace,
 	}, nil
 }
@@ -114,7 +105,7 @@ func (a *RemoteABCIClientV1) FinalizeBlock(req *abciv2.RequestFinalizeBlock) (*a
 		}
 	}
 
-	commitInfo := commitInfoV2ToV1(&req.DecidedLastCommit)
+	commitInfo := convert.CommitInfoV2ToV1(req.DecidedLastCommit)
 
 	beginBlockResp, err := a.BeginBlock(context.Background(), &abciv1.RequestBeginBlock{
 		Hash: req.Hash,
@@ -140,7 +131,7 @@ func (a *RemoteABCIClientV1) FinalizeBlock(req *abciv2.RequestFinalizeBlock) (*a
 			EvidenceHash:    req.Header.EvidenceHash,
 		},
 		LastCommitInfo:      commitInfo,
-		ByzantineValidators: evidenceV2ToV1(req.Misbehavior),
+		ByzantineValidators: convert.EvidenceV2ToV1(req.Misbehavior),
 	}, grpc.WaitForReady(true))
 	if err != nil {
 		return nil, err
@@ -171,24 +162,15 @@ func (a *RemoteABCIClientV1) FinalizeBlock(req *abciv2.RequestFinalizeBlock) (*a
 
 	// convert events
 	var events []abciv2.Event
-	events = append(events, abciEventV1ToV2(beginBlockResp.Events...)...)
+	events = append(events, convert.EventsV1ToV2(beginBlockResp.Events)...)
 	for _, commitBlockResp := range commitBlockResps {
-		events = append(events, abciEventV1ToV2(commitBlockResp.Events...)...)
+		events = append(events, convert.EventsV1ToV2(commitBlockResp.Events)...)
 	}
-	events = append(events, abciEventV1ToV2(endBlockResp.Events...)...)
+	events = append(events, convert.EventsV1ToV2(endBlockResp.Events)...)
 
 	txResults := make([]*abciv2.ExecTxResult, len(commitBlockResps))
 	for i, commitBlockResp := range commitBlockResps {
-		txResults[i] = &abciv2.ExecTxResult{
-			Code:      commitBlockResp.Code,
-			Data:      commitBlockResp.Data,
-			Log:       commitBlockResp.Log,
-			Info:      commitBlockResp.Info,
-			GasWanted: commitBlockResp.GasWanted,
-			GasUsed:   commitBlockResp.GasUsed,
-			Events:    abciEventV1ToV2(commitBlockResp.Events...),
-			Codespace: commitBlockResp.Codespace,
-		}
+		txResults[i] = convert.TxResultV1ToV2(commitBlockResp)
 	}
 
 	// commit result
@@ -205,32 +187,21 @@ func (a *RemoteABCIClientV1) FinalizeBlock(req *abciv2.RequestFinalizeBlock) (*a
 	return &abciv2.ResponseFinalizeBlock{
 		Events:                events,
 		TxResults:             txResults,
-		ValidatorUpdates:      validatorUpdatesV1ToV2(endBlockResp.ValidatorUpdates),
-		ConsensusParamUpdates: consensusParamsV1ToV2(endBlockResp.ConsensusParamUpdates),
+		ValidatorUpdates:      convert.ValidatorUpdatesV1ToV2(endBlockResp.ValidatorUpdates),
+		ConsensusParamUpdates: convert.ConsensusParamsV1ToV2(endBlockResp.ConsensusParamUpdates),
 		AppHash:               commitResp.Data,
-		TimeoutInfo:           timeoutInfoV1ToV2(endBlockResp.Timeouts),
+		TimeoutInfo:           convert.TimeoutInfoV1ToV2(endBlockResp.Timeouts),
 	}, nil
 }
 
 // Info implements abciv2.ABCI
 func (a *RemoteABCIClientV1) Info(req *abciv2.RequestInfo) (*abciv2.ResponseInfo, error) {
-	resp, err := a.ABCIApplicationClient.Info(context.Background(), &abciv1.RequestInfo{
-		Version:      req.Version,
-		BlockVersion: req.BlockVersion,
-		P2PVersion:   req.P2PVersion,
-	}, grpc.WaitForReady(true))
+	resp, err := a.ABCIApplicationClient.Info(context.Background(), convert.RequestInfoV2ToV1(req), grpc.WaitForReady(true))
 	if err != nil {
 		return nil, err
 	}
 
-	return &abciv2.ResponseInfo{
-		Data:             resp.Data,
-		Version:          resp.Version,
-		AppVersion:       resp.AppVersion,
-		LastBlockHeight:  resp.LastBlockHeight,
-		LastBlockAppHash: resp.LastBlockAppHash,
-		TimeoutInfo:      timeoutInfoV1ToV2(resp.Timeouts),
-	}, nil
+	return convert.ResponseInfoV1ToV2(resp), nil
 }
 
 // InitChain implements abciv2.ABCI
@@ -244,70 +215,45 @@ func (a *RemoteABCIClientV1) InitChain(req *abciv2.RequestInitChain) (*abciv2.Re
 	// Therefore, this overrides the app version in req with the multiplexer's initial app version.
 	req.ConsensusParams.Version.App = a.initialAppVersion
 
-	resp, err := a.ABCIApplicationClient.InitChain(context.Background(), &abciv1.RequestInitChain{
-		Time:            req.Time,
-		ChainId:         req.ChainId,
-		ConsensusParams: consensusParamsV2ToV1(req.ConsensusParams),
-		Validators:      validatorUpdatesV2ToV1(req.Validators),
-		AppStateBytes:   req.AppStateBytes,
-		InitialHeight:   req.InitialHeight,
-	}, grpc.WaitForReady(true))
+	v1Req, err := convert.RequestInitChainV2ToV1(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return &abciv2.ResponseInitChain{
-		ConsensusParams: consensusParamsV1ToV2(resp.ConsensusParams),
-		Validators:      validatorUpdatesV1ToV2(resp.Validators),
-		AppHash:         resp.AppHash,
-		TimeoutInfo:     timeoutInfoV1ToV2(resp.Timeouts),
-	}, nil
+	resp, err := a.ABCIApplicationClient.InitChain(context.Background(), v1Req, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ResponseInitChainV1ToV2(resp), nil
 }
 
 // ListSnapshots implements abciv2.ABCI
 func (a *RemoteABCIClientV1) ListSnapshots(req *abciv2.RequestListSnapshots) (*abciv2.ResponseListSnapshots, error) {
 	resp, err := a.ABCIApplicationClient.ListSnapshots(
 		context.Background(),
-		&abciv1.RequestListSnapshots{},
+		convert.RequestListSnapshotsV2ToV1(req),
 		grpc.WaitForReady(true),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	snapshots := make([]*abciv2.Snapshot, 0, len(resp.Snapshots))
-	for _, snapshot := range resp.Snapshots {
-		snapshots = append(snapshots, &abciv2.Snapshot{
-			Height: snapshot.Height,
-			Format: snapshot.Format,
-			Chunks: snapshot.Chunks,
-			Hash:   snapshot.Hash,
-		})
-	}
-
-	return &abciv2.ResponseListSnapshots{
-		Snapshots: snapshots,
-	}, nil
+	return convert.ResponseListSnapshotsV1ToV2(resp), nil
 }
 
 // LoadSnapshotChunk implements abciv2.ABCI
 func (a *RemoteABCIClientV1) LoadSnapshotChunk(req *abciv2.RequestLoadSnapshotChunk) (*abciv2.ResponseLoadSnapshotChunk, error) {
 	resp, err := a.ABCIApplicationClient.LoadSnapshotChunk(
 		context.Background(),
-		&abciv1.RequestLoadSnapshotChunk{
-			Height: req.Height,
-			Format: req.Format,
-			Chunk:  req.Chunk,
-		},
+		convert.RequestLoadSnapshotChunkV2ToV1(req),
 		grpc.WaitForReady(true),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &abciv2.ResponseLoadSnapshotChunk{
-		Chunk: resp.GetChunk(),
-	}, nil
+	return convert.ResponseLoadSnapshotChunkV1ToV2(resp), nil
 }
 
 // OfferSnapshot implements abciv2.ABCI
@@ -475,192 +421,3 @@ func (a *RemoteABCIClientV1) ExtendVote(ctx context.Context, req *abciv2.Request
 func (a *RemoteABCIClientV1) QuerySequence(_ context.Context, _ *abciv2.RequestQuerySequence) (*abciv2.ResponseQuerySequence, error) {
 	return &abciv2.ResponseQuerySequence{}, fmt.Errorf("RemoteABCIClientV1 does not support QuerySequence")
 }
-
-// abciEventV1ToV2 converts a slice of abciv1.Event to a slice of abciv2.Event.
-func abciEventV1ToV2(events ...abciv1.Event) []abciv2.Event {
-	v2Events := make([]abciv2.Event, 0, len(events))
-
-	for _, event := range events {
-		attributes := make([]abciv2.EventAttribute, 0, len(event.Attributes))
-		for _, attr := range event.Attributes {
-			attributes = append(attributes, abciv2.EventAttribute{
-				Key:   attr.Key,
-				Value: attr.Value,
-			})
-		}
-
-		v2Events = append(v2Events, abciv2.Event{
-			Type:       event.Type,
-			Attributes: attributes,
-		})
-	}
-
-	return v2Events
-}
-
-func validatorUpdatesV1ToV2(validators []abciv1.ValidatorUpdate) []abciv2.ValidatorUpdate {
-	v2Updates := make([]abciv2.ValidatorUpdate, len(validators))
-	for i, validator := range validators {
-		var pubKeyV2 cryptov2.PublicKey
-		if pubKey := validator.GetPubKey(); pubKey.GetEd25519() != nil {
-			pubKeyV2 = cryptov2.PublicKey{
-				Sum: &cryptov2.PublicKey_Ed25519{
-					Ed25519: pubKey.GetEd25519(),
-				},
-			}
-		} else if pubKey := validator.GetPubKey(); pubKey.GetSecp256K1() != nil {
-			pubKeyV2 = cryptov2.PublicKey{
-				Sum: &cryptov2.PublicKey_Secp256K1{
-					Secp256K1: pubKey.GetSecp256K1(),
-				},
-			}
-		} else {
-			continue
-		}
-
-		v2Updates[i] = abciv2.ValidatorUpdate{
-			PubKey: pubKeyV2,
-			Power:  validator.Power,
-		}
-	}
-
-	return v2Updates
-}
-
-func validatorUpdatesV2ToV1(validators []abciv2.ValidatorUpdate) []abciv1.ValidatorUpdate {
-	v1Updates := make([]abciv1.ValidatorUpdate, len(validators))
-	for i, validator := range validators {
-		var pubKeyV1 cryptov1.PublicKey
-		if pubKey := validator.GetPubKey(); pubKey.GetEd25519() != nil {
-			pubKeyV1 = cryptov1.PublicKey{
-				Sum: &cryptov1.PublicKey_Ed25519{
-					Ed25519: pubKey.GetEd25519(),
-				},
-			}
-		} else if pubKey := validator.GetPubKey(); pubKey.GetSecp256K1() != nil {
-			pubKeyV1 = cryptov1.PublicKey{
-				Sum: &cryptov1.PublicKey_Secp256K1{
-					Secp256K1: pubKey.GetSecp256K1(),
-				},
-			}
-		} else {
-			continue
-		}
-
-		v1Updates[i] = abciv1.ValidatorUpdate{
-			PubKey: pubKeyV1,
-			Power:  validator.Power,
-		}
-	}
-
-	return v1Updates
-}
-
-func consensusParamsV1ToV2(params *abciv1.ConsensusParams) *typesv2.ConsensusParams {
-	if params == nil {
-		return nil
-	}
-
-	consensusParamsV2 := &typesv2.ConsensusParams{}
-	if blockParams := params.GetBlock(); blockParams != nil {
-		consensusParamsV2.Block = &typesv2.BlockParams{
-			MaxBytes: blockParams.MaxBytes,
-			MaxGas:   blockParams.MaxGas,
-		}
-	}
-
-	if evidenceParams := params.GetEvidence(); evidenceParams != nil {
-		consensusParamsV2.Evidence = &typesv2.EvidenceParams{
-			MaxAgeNumBlocks: evidenceParams.MaxAgeNumBlocks,
-			MaxAgeDuration:  evidenceParams.MaxAgeDuration,
-			MaxBytes:        evidenceParams.MaxBytes,
-		}
-	}
-
-	if versionParams := params.GetVersion(); versionParams != nil {
-		consensusParamsV2.Version = &typesv2.VersionParams{
-			App: versionParams.AppVersion,
-		}
-	}
-
-	if validatorParams := params.GetValidator(); validatorParams != nil {
-		consensusParamsV2.Validator = &typesv2.ValidatorParams{
-			PubKeyTypes: validatorParams.PubKeyTypes,
-		}
-	}
-
-	return consensusParamsV2
-}
-
-func consensusParamsV2ToV1(params *typesv2.ConsensusParams) *abciv1.ConsensusParams {
-	consensusParamsV1 := &abciv1.ConsensusParams{}
-	if blockParams := params.GetBlock(); blockParams != nil {
-		consensusParamsV1.Block = &abciv1.BlockParams{
-			MaxBytes: blockParams.MaxBytes,
-			MaxGas:   blockParams.MaxGas,
-		}
-	}
-
-	if evidenceParams := params.GetEvidence(); evidenceParams != nil {
-		consensusParamsV1.Evidence = &typesv1.EvidenceParams{
-			MaxAgeNumBlocks: evidenceParams.MaxAgeNumBlocks,
-			MaxAgeDuration:  evidenceParams.MaxAgeDuration,
-			MaxBytes:        evidenceParams.MaxBytes,
-		}
-	}
-
-	if versionParams := params.GetVersion(); versionParams != nil {
-		consensusParamsV1.Version = &typesv1.VersionParams{
-			AppVersion: versionParams.App,
-		}
-	}
-
-	if validatorParams := params.GetValidator(); validatorParams != nil {
-		consensusParamsV1.Validator = &typesv1.ValidatorParams{
-			PubKeyTypes: validatorParams.PubKeyTypes,
-		}
-	}
-	return consensusParamsV1
-}
-
-func commitInfoV2ToV1(info *abciv2.CommitInfo) abciv1.LastCommitInfo {
-	votes := make([]abciv1.VoteInfo, 0, len(info.Votes))
-	for _, vote := range info.Votes {
-		votes = append(votes, abciv1.VoteInfo{
-			Validator: abciv1.Validator{
-				Address: vote.Validator.Address,
-				Power:   vote.Validator.Power,
-			},
-			SignedLastBlock: vote.BlockIdFlag != typesv2.BlockIDFlagAbsent,
-		})
-	}
-	return abciv1.LastCommitInfo{
-		Round: info.Round,
-		Votes: votes,
-	}
-}
-
-func evidenceV2ToV1(evidence []abciv2.Misbehavior) []abciv1.Evidence {
-	v1Evidence := make([]abciv1.Evidence, len(evidence))
-	for i, ev := range evidence {
-		v1Evidence[i] = abciv1.Evidence{
-			Type:   abciv1.EvidenceType(ev.Type),
-			Height: ev.Height,
-			Validator: abciv1.Validator{
-				Address: ev.Validator.Address,
-				Power:   ev.Validator.Power,
-			},
-			Time:             ev.Time,
-			TotalVotingPower: ev.TotalVotingPower,
-		}
-	}
-
-	return v1Evidence
-}
-
-func timeoutInfoV1ToV2(info abciv1.TimeoutsInfo) abciv2.TimeoutInfo {
-	return abciv2.TimeoutInfo{
-		TimeoutPropose: info.TimeoutPropose,
-		TimeoutCommit:  info.TimeoutCommit,
-	}
-}
@@ -11,26 +11,6 @@ import (
 	"google.golang.org/grpc"
 )
 
-func TestConsensusParamsV1ToV2(t *testing.T) {
-	t.Run("should return nil if params are nil", func(t *testing.T) {
-		got := consensusParamsV1ToV2(nil)
-		assert.Nil(t, got)
-	})
-}
-
-func TestTimeoutInfoV1ToV2(t *testing.T) {
-	info := abciv1.TimeoutsInfo{
-		TimeoutPropose: 1,
-		TimeoutCommit:  2,
-	}
-	want := abciv2.TimeoutInfo{
-		TimeoutPropose: 1,
-		TimeoutCommit:  2,
-	}
-	got := timeoutInfoV1ToV2(info)
-	assert.Equal(t, want, got)
-}
-
 func TestInfo(t *testing.T) {
 	// This test verifies the regression in
 	// https://github.com/celestiaorg/celestia-app/issues/4859
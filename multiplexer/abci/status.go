@@ -0,0 +1,79 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
+)
+
+// Status is the status of the multiplexer served by the status endpoint.
+type Status struct {
+	// AppVersion is the current app version.
+	AppVersion uint64 `json:"app_version"`
+	// Native is true if the native app is used.
+	Native bool `json:"native"`
+	// EmbeddedApp is the status of the embedded app process if one is used.
+	EmbeddedApp *appd.Status `json:"embedded_app,omitempty"`
+}
+
+// Status returns the status of the multiplexer.
+func (m *Multiplexer) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status := Status{
+		AppVersion: m.appVersion,
+		Native:     m.isNativeApp(),
+	}
+	if m.supervisor != nil {
+		embeddedStatus := m.supervisor.Status()
+		status.EmbeddedApp = &embeddedStatus
+	}
+	return status
+}
+
+// startStatusServer serves the multiplexer status as JSON on the address set
+// by FlagStatusAddress. It does nothing if no address is set.
+func (m *Multiplexer) startStatusServer() error {
+	address := m.svrCtx.Viper.GetString(FlagStatusAddress)
+	if address == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on status address %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", m.handleStatus)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	m.logger.Info("starting multiplexer status server", "address", listener.Addr().String())
+	m.g.Go(func() error {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("status server failed: %w", err)
+		}
+		return nil
+	})
+	m.g.Go(func() error {
+		<-m.ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(ctx)
+	})
+	return nil
+}
+
+func (m *Multiplexer) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(m.Status()); err != nil {
+		m.logger.Error("failed to write multiplexer status", "err", err)
+	}
+}
@@ -91,7 +91,8 @@ func (v Version) GetStartArgs(args []string) []string {
 	)
 }
 
-// Validate checks for duplicate app versions in a slice of Versions.
+// Validate checks for duplicate app versions in a slice of Versions, unknown
+// ABCI client versions and missing or corrupted binaries.
 func (v Versions) Validate() error {
 	if len(v) == 0 {
 		return fmt.Errorf("no versions specified")
@@ -103,7 +104,39 @@ func (v Versions) Validate() error {
 			return fmt.Errorf("version %d specified multiple times", ver.AppVersion)
 		}
 		seen[ver.AppVersion] = struct{}{}
+
+		if ver.ABCIVersion != ABCIClientVersion1 && ver.ABCIVersion != ABCIClientVersion2 {
+			return fmt.Errorf("version %d has an unknown ABCI client version %d", ver.AppVersion, ver.ABCIVersion)
+		}
+
+		// Appd instances without a binary are only used in tests.
+		if ver.Appd != nil && ver.Appd.Path() != "" {
+			if err := ver.Appd.Validate(); err != nil {
+				return fmt.Errorf("version %d: %w", ver.AppVersion, err)
+			}
+		}
 	}
 
 	return nil
 }
+
+// Override returns the versions with the entries of overrides replacing the
+// entries that have the same app version. Overrides for app versions that
+// are not in v are added.
+func (v Versions) Override(overrides Versions) (Versions, error) {
+	replaced := make(map[uint64]Version, len(overrides))
+	for _, ver := range overrides {
+		replaced[ver.AppVersion] = ver
+	}
+
+	result := make([]Version, 0, len(v)+len(overrides))
+	for _, ver := range v {
+		if _, ok := replaced[ver.AppVersion]; ok {
+			continue
+		}
+		result = append(result, ver)
+	}
+	result = append(result, overrides...)
+
+	return NewVersions(result...)
+}
@@ -154,6 +154,11 @@ func TestEnsureUniqueVersions(t *testing.T) {
 			versions:    []Version{{AppVersion: 1}, {AppVersion: 2}, {AppVersion: 1}, {AppVersion: 3}, {AppVersion: 2}},
 			expectedErr: errors.New("version 1 specified multiple times"),
 		},
+		{
+			name:        "unknown abci client version",
+			versions:    []Version{{AppVersion: 1}, {AppVersion: 2, ABCIVersion: 2}},
+			expectedErr: errors.New("version 2 has an unknown ABCI client version 2"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,12 +5,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -21,12 +25,26 @@ type Appd struct {
 	// Example: "v3.10.0-arabica"
 	version string
 	// path is the path to the celestia-appd binary.
-	path   string
-	stdin  io.Reader
-	stderr io.Writer
-	stdout io.Writer
+	path string
+	// checksum is the hex encoded SHA-256 of the binary. It is only set for
+	// binaries loaded from disk via NewFromPath.
+	checksum string
+	stdin    io.Reader
+	stderr   io.Writer
+	stdout   io.Writer
+
+	// mu protects the state of the started binary below, which is written when
+	// the binary is started or exits and read concurrently by the multiplexer
+	// and the supervisor.
+	mu sync.Mutex
 	// cmd is the started celestia-appd binary.
 	cmd *exec.Cmd
+	// done is closed once the started binary has exited.
+	done chan struct{}
+	// exitErr is the error returned when waiting for the started binary.
+	exitErr error
+	// stderrTail keeps the last lines written to stderr by the started binary.
+	stderrTail *tailWriter
 }
 
 // New returns a new Appd instance.
@@ -54,14 +72,78 @@ func New(version string, compressedBinary []byte) (*Appd, error) {
 	return appd, nil
 }
 
+// NewFromPath returns a new Appd instance for a celestia-appd binary that
+// already exists on disk. This allows operators to use a binary that is not
+// embedded in celestia-appd. If checksum is not empty, it must be the hex
+// encoded SHA-256 of the binary.
+func NewFromPath(version, path, checksum string) (*Appd, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path to binary %s: %w", path, err)
+	}
+
+	appd := &Appd{
+		version:  version,
+		path:     absPath,
+		checksum: strings.ToLower(checksum),
+		stdin:    os.Stdin,
+		stdout:   os.Stdout,
+		stderr:   os.Stderr,
+	}
+	if err := appd.Validate(); err != nil {
+		return nil, err
+	}
+	return appd, nil
+}
+
+// Version returns the version of the celestia-appd binary.
+func (a *Appd) Version() string {
+	return a.version
+}
+
+// Path returns the path to the celestia-appd binary.
+func (a *Appd) Path() string {
+	return a.path
+}
+
+// Validate checks that the binary exists, is executable and, if a checksum
+// was provided, that its contents match the checksum.
+func (a *Appd) Validate() error {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return fmt.Errorf("binary for %s not found: %w", a.version, err)
+	}
+	if info.IsDir() || info.Mode()&0o111 == 0 {
+		return fmt.Errorf("binary for %s at %s is not an executable file", a.version, a.path)
+	}
+
+	if a.checksum == "" {
+		return nil
+	}
+
+	got, err := fileChecksum(a.path)
+	if err != nil {
+		return err
+	}
+	if got != a.checksum {
+		return fmt.Errorf("checksum mismatch for %s at %s: expected %s, got %s", a.version, a.path, a.checksum, got)
+	}
+	return nil
+}
+
 // Start starts the appd binary with the given arguments.
 func (a *Appd) Start(args ...string) error {
 	cmd := exec.Command(a.path, append([]string{"start"}, args...)...)
 
-	// Set up I/O
+	// Set up I/O. Stderr is also kept in a tail buffer so that it can be
+	// reported if the binary exits unexpectedly.
+	stderrTail := newTailWriter(stderrTailLines)
 	cmd.Stdin = a.stdin
 	cmd.Stdout = a.stdout
-	cmd.Stderr = a.stderr
+	cmd.Stderr = stderrTail
+	if a.stderr != nil {
+		cmd.Stderr = io.MultiWriter(a.stderr, stderrTail)
+	}
 
 	// Start the embedded binary in its own process group.
 	// This prevents the embedded binary from receiving CTRL+C signals directly from the terminal.
@@ -74,49 +156,129 @@ func (a *Appd) Start(args ...string) error {
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("failed to start %s: %w", a.path, err)
 	}
+
+	// Wait for the process in the background so that unexpected exits are
+	// noticed and the process is reaped.
+	done := make(chan struct{})
+	a.mu.Lock()
 	a.cmd = cmd
+	a.done = done
+	a.exitErr = nil
+	a.stderrTail = stderrTail
+	a.mu.Unlock()
+	go func() {
+		err := cmd.Wait()
+		a.mu.Lock()
+		if a.cmd == cmd {
+			a.exitErr = err
+		}
+		a.mu.Unlock()
+		close(done)
+	}()
 	return nil
 }
 
+// process returns the last started process and the channel closed once it
+// has exited.
+func (a *Appd) process() (*exec.Cmd, chan struct{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.cmd, a.done
+}
+
 func (a *Appd) IsRunning() bool {
 	return !a.IsStopped()
 }
 
 func (a *Appd) IsStopped() bool {
+	return isStopped(a.process())
+}
+
+// isStopped returns true if the process was never started, failed to start or
+// has finished.
+func isStopped(cmd *exec.Cmd, done chan struct{}) bool {
 	// Never started or failed to start
-	if a.cmd == nil || a.cmd.Process == nil {
+	if cmd == nil || cmd.Process == nil {
 		return true
 	}
 
-	// If ProcessState is not nil, it means Wait() was called and the process has finished
-	// (either by exiting normally or being terminated by a signal)
-	if a.cmd.ProcessState != nil {
+	// done is closed once the process has finished (either by exiting
+	// normally or being terminated by a signal)
+	select {
+	case <-done:
 		return true
+	default:
+		return false
+	}
+}
+
+// Done returns a channel that is closed when the started process exits. It
+// returns nil if the process was never started.
+func (a *Appd) Done() <-chan struct{} {
+	_, done := a.process()
+	return done
+}
+
+// PID returns the process ID of the started process or 0 if it was never started.
+func (a *Appd) PID() int {
+	cmd, _ := a.process()
+	if cmd == nil || cmd.Process == nil {
+		return 0
+	}
+	return cmd.Process.Pid
+}
+
+// ExitCode returns the exit code of the last started process. It returns -1
+// if the process is still running, was never started or was terminated by a
+// signal.
+func (a *Appd) ExitCode() int {
+	cmd, done := a.process()
+	// ProcessState is set before done is closed.
+	if !isStopped(cmd, done) || cmd == nil || cmd.ProcessState == nil {
+		return -1
+	}
+	return cmd.ProcessState.ExitCode()
+}
+
+// ExitError returns the error returned when the last started process exited.
+func (a *Appd) ExitError() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !isStopped(a.cmd, a.done) {
+		return nil
 	}
+	return a.exitErr
+}
 
-	// ProcessState is nil, which means the process is still running
-	return false
+// StderrTail returns the last lines written to stderr by the last started process.
+func (a *Appd) StderrTail() []string {
+	a.mu.Lock()
+	stderrTail := a.stderrTail
+	a.mu.Unlock()
+	if stderrTail == nil {
+		return nil
+	}
+	return stderrTail.Lines()
 }
 
 // Stop interrupts and then kills the running appd process if it exists and
 // waits for it to fully exit. If the process is not running, it returns nil.
 // The method will wait up to 6 seconds for graceful shutdown before force killing.
 func (a *Appd) Stop() error {
-	if a.cmd == nil {
-		return nil
-	}
-	if a.cmd.Process == nil {
+	cmd, done := a.process()
+	if isStopped(cmd, done) {
 		return nil
 	}
 
-	err := a.cmd.Process.Signal(os.Interrupt)
+	err := cmd.Process.Signal(os.Interrupt)
 	if err != nil {
 		log.Printf("Failed to send interrupt signal, attempting to kill: %v", err)
-		if err := a.cmd.Process.Kill(); err != nil {
-			return fmt.Errorf("failed to kill process with PID %d: %w", a.cmd.Process.Pid, err)
+		if err := cmd.Process.Kill(); err != nil {
+			return fmt.Errorf("failed to kill process with PID %d: %w", cmd.Process.Pid, err)
 		}
 
-		if err := a.cmd.Wait(); err != nil {
+		<-done
+		if err := a.ExitError(); err != nil {
 			log.Printf("Process finished with error: %v\n", err)
 		}
 		return nil
@@ -125,14 +287,9 @@ func (a *Appd) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 6*time.Second)
 	defer cancel()
 
-	done := make(chan error, 1)
-	go func() {
-		done <- a.cmd.Wait()
-	}()
-
 	select {
-	case err := <-done:
-		if err != nil {
+	case <-done:
+		if err := a.ExitError(); err != nil {
 			log.Printf("Process finished with error: %v\n", err)
 		} else {
 			log.Printf("Process finished with no error\n")
@@ -140,11 +297,12 @@ func (a *Appd) Stop() error {
 		return nil
 	case <-ctx.Done():
 		log.Printf("Process did not exit within 6 seconds, force killing")
-		if err := a.cmd.Process.Kill(); err != nil {
-			return fmt.Errorf("failed to kill process with PID %d after timeout: %w", a.cmd.Process.Pid, err)
+		if err := cmd.Process.Kill(); err != nil {
+			return fmt.Errorf("failed to kill process with PID %d after timeout: %w", cmd.Process.Pid, err)
 		}
 
-		if err := <-done; err != nil {
+		<-done
+		if err := a.ExitError(); err != nil {
 			log.Printf("Process finished with error after force kill: %v\n", err)
 		} else {
 			log.Printf("Process finished after force kill\n")
@@ -162,6 +320,21 @@ func (a *Appd) CreateExecCommand(args ...string) *exec.Cmd {
 	return cmd
 }
 
+// fileChecksum returns the hex encoded SHA-256 of the file at path.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 // getPathToBinary returns the path to the celestia-appd binary for the given version.
 func getPathToBinary(version string) (string, error) {
 	var pathToBinary string
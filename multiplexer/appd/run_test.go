@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -51,6 +52,50 @@ func TestCreateExecCommand(t *testing.T) {
 	}
 }
 
+func TestNewFromPath(t *testing.T) {
+	mockBinary := createMockExecutable(t, "echo v5.0.1-patched")
+	defer os.Remove(mockBinary)
+
+	checksum, err := fileChecksum(mockBinary)
+	require.NoError(t, err)
+
+	t.Run("should load a binary without checksum", func(t *testing.T) {
+		appdInstance, err := NewFromPath("v5", mockBinary, "")
+		require.NoError(t, err)
+		require.Equal(t, "v5", appdInstance.Version())
+		require.Equal(t, mockBinary, appdInstance.Path())
+
+		var outputBuffer bytes.Buffer
+		cmd := appdInstance.CreateExecCommand()
+		cmd.Stdout = &outputBuffer
+		require.NoError(t, cmd.Run())
+		require.Equal(t, "v5.0.1-patched\n", outputBuffer.String())
+	})
+
+	t.Run("should load a binary with a matching checksum", func(t *testing.T) {
+		_, err := NewFromPath("v5", mockBinary, strings.ToUpper(checksum))
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a checksum mismatch", func(t *testing.T) {
+		_, err := NewFromPath("v5", mockBinary, strings.Repeat("0", len(checksum)))
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("should reject a missing binary", func(t *testing.T) {
+		_, err := NewFromPath("v5", mockBinary+"-missing", "")
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("should reject a non executable file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "appd")
+		require.NoError(t, os.WriteFile(file, []byte("appd"), 0o600))
+
+		_, err := NewFromPath("v5", file, "")
+		require.ErrorContains(t, err, "is not an executable file")
+	})
+}
+
 func TestStart(t *testing.T) {
 	t.Run("should start the process", func(t *testing.T) {
 		mockBinary := createMockExecutable(t, "sleep 10")
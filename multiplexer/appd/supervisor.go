@@ -0,0 +1,279 @@
+package appd
+
+import (
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+)
+
+// SupervisorConfig configures how a Supervisor restarts a process that exited
+// unexpectedly.
+type SupervisorConfig struct {
+	// InitialBackoff is the delay before the first restart after an unexpected exit.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between restarts. The delay doubles after
+	// every consecutive restart.
+	MaxBackoff time.Duration
+	// MaxRestarts is the maximum number of consecutive restarts before the
+	// supervisor gives up. Zero means unlimited.
+	MaxRestarts int
+	// StableAfter is the duration after which a running process is considered
+	// healthy, resetting the backoff and the consecutive restarts.
+	StableAfter time.Duration
+}
+
+// DefaultSupervisorConfig returns the default SupervisorConfig.
+func DefaultSupervisorConfig() SupervisorConfig {
+	return SupervisorConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxRestarts:    0,
+		StableAfter:    time.Minute,
+	}
+}
+
+// Status is the state of a supervised process.
+type Status struct {
+	Version string `json:"version"`
+	Path    string `json:"path"`
+	Running bool   `json:"running"`
+	// PID is the process ID of the running process or 0 if it is not running.
+	PID int `json:"pid"`
+	// Restarts is the number of times the process was restarted after an unexpected exit.
+	Restarts int `json:"restarts"`
+	// LastExitCode is the exit code of the last unexpected exit or -1 if the
+	// process was terminated by a signal.
+	LastExitCode  int       `json:"last_exit_code"`
+	LastExitError string    `json:"last_exit_error,omitempty"`
+	LastExitTime  time.Time `json:"last_exit_time,omitempty"`
+	// LastRestartError is the error of the last failed restart or resync.
+	LastRestartError string `json:"last_restart_error,omitempty"`
+	// StderrTail is the last lines written to stderr by the process.
+	StderrTail []string `json:"stderr_tail,omitempty"`
+}
+
+// Supervisor starts an Appd and restarts it with backoff if it exits
+// unexpectedly.
+type Supervisor struct {
+	appd   *Appd
+	cfg    SupervisorConfig
+	logger log.Logger
+	// onRestart is called after every restart, e.g. to resync the restarted app.
+	onRestart func() error
+
+	mu sync.Mutex
+	// args are the arguments the process was started with.
+	args []string
+	// stop is closed when Stop is called.
+	stop      chan struct{}
+	startedAt time.Time
+
+	restarts       int
+	lastExitCode   int
+	lastExitErr    error
+	lastExitTime   time.Time
+	lastRestartErr error
+}
+
+// NewSupervisor returns a new Supervisor for appd. onRestart may be nil.
+func NewSupervisor(appd *Appd, logger log.Logger, cfg SupervisorConfig, onRestart func() error) *Supervisor {
+	return &Supervisor{
+		appd:         appd,
+		cfg:          cfg,
+		logger:       logger,
+		onRestart:    onRestart,
+		lastExitCode: -1,
+	}
+}
+
+// Start starts the process with the given arguments and supervises it until
+// Stop is called.
+func (s *Supervisor) Start(args ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.appd.Start(args...); err != nil {
+		return err
+	}
+
+	s.args = args
+	s.startedAt = time.Now()
+	s.stop = make(chan struct{})
+	go s.monitor(s.stop)
+
+	s.emitTelemetry()
+	return nil
+}
+
+// Stop stops supervising the process and stops it. Stop does not wait for a
+// pending onRestart call to return but guarantees that the process is not
+// restarted anymore.
+func (s *Supervisor) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop == nil {
+		return nil
+	}
+	// close stop before stopping the process so that the exit is not
+	// considered unexpected.
+	close(s.stop)
+	s.stop = nil
+	err := s.appd.Stop()
+	s.emitTelemetry()
+	return err
+}
+
+// Status returns the status of the supervised process.
+func (s *Supervisor) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := Status{
+		Version:      s.appd.Version(),
+		Path:         s.appd.Path(),
+		Running:      s.appd.IsRunning(),
+		Restarts:     s.restarts,
+		LastExitCode: s.lastExitCode,
+		LastExitTime: s.lastExitTime,
+		StderrTail:   s.appd.StderrTail(),
+	}
+	if status.Running {
+		status.PID = s.appd.PID()
+	}
+	if s.lastExitErr != nil {
+		status.LastExitError = s.lastExitErr.Error()
+	}
+	if s.lastRestartErr != nil {
+		status.LastRestartError = s.lastRestartErr.Error()
+	}
+	return status
+}
+
+// monitor waits for the process to exit and restarts it with backoff until
+// stop is closed.
+func (s *Supervisor) monitor(stop <-chan struct{}) {
+	backoff := s.cfg.InitialBackoff
+	consecutiveRestarts := 0
+	for {
+		s.mu.Lock()
+		processDone := s.appd.Done()
+		startedAt := s.startedAt
+		s.mu.Unlock()
+
+		select {
+		case <-stop:
+			return
+		case <-processDone:
+		}
+
+		// the process may have exited because Stop was called.
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		s.recordExit()
+		if time.Since(startedAt) >= s.cfg.StableAfter {
+			backoff = s.cfg.InitialBackoff
+			consecutiveRestarts = 0
+		}
+
+		for {
+			if s.cfg.MaxRestarts > 0 && consecutiveRestarts >= s.cfg.MaxRestarts {
+				s.logger.Error("embedded app exited too many times, giving up", "version", s.appd.Version(), "restarts", consecutiveRestarts)
+				return
+			}
+
+			s.logger.Info("restarting embedded app", "version", s.appd.Version(), "backoff", backoff)
+			select {
+			case <-stop:
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, s.cfg.MaxBackoff)
+			consecutiveRestarts++
+
+			if err := s.restart(stop); err != nil {
+				s.logger.Error("failed to restart embedded app", "version", s.appd.Version(), "err", err)
+				continue
+			}
+			break
+		}
+	}
+}
+
+// recordExit records an unexpected exit of the process.
+func (s *Supervisor) recordExit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastExitCode = s.appd.ExitCode()
+	s.lastExitErr = s.appd.ExitError()
+	s.lastExitTime = time.Now()
+	s.logger.Error("embedded app exited unexpectedly",
+		"version", s.appd.Version(),
+		"exit_code", s.lastExitCode,
+		"err", s.lastExitErr,
+		"stderr_tail", s.appd.StderrTail(),
+	)
+
+	telemetry.SetGaugeWithLabels([]string{"multiplexer", "appd", "last_exit_code"}, float32(s.lastExitCode), s.labels())
+	s.emitTelemetry()
+}
+
+// restart starts the process again and calls onRestart.
+func (s *Supervisor) restart(stop <-chan struct{}) error {
+	s.mu.Lock()
+	select {
+	case <-stop:
+		s.mu.Unlock()
+		return nil
+	default:
+	}
+
+	if err := s.appd.Start(s.args...); err != nil {
+		s.lastRestartErr = err
+		s.mu.Unlock()
+		return err
+	}
+	s.restarts++
+	s.startedAt = time.Now()
+	s.lastRestartErr = nil
+	telemetry.IncrCounterWithLabels([]string{"multiplexer", "appd", "restarts"}, 1, s.labels())
+	s.emitTelemetry()
+	s.mu.Unlock()
+
+	if s.onRestart == nil {
+		return nil
+	}
+
+	// a failed resync is recorded but does not trigger another restart as the
+	// process itself is running.
+	if err := s.onRestart(); err != nil {
+		s.logger.Error("failed to resync embedded app after restart", "version", s.appd.Version(), "err", err)
+		s.mu.Lock()
+		s.lastRestartErr = err
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// emitTelemetry emits the process state. It must be called with s.mu held.
+func (s *Supervisor) emitTelemetry() {
+	running, pid := float32(0), float32(0)
+	if s.appd.IsRunning() {
+		running, pid = 1, float32(s.appd.PID())
+	}
+	telemetry.SetGaugeWithLabels([]string{"multiplexer", "appd", "running"}, running, s.labels())
+	telemetry.SetGaugeWithLabels([]string{"multiplexer", "appd", "pid"}, pid, s.labels())
+	telemetry.SetGaugeWithLabels([]string{"multiplexer", "appd", "restarts_total"}, float32(s.restarts), s.labels())
+}
+
+func (s *Supervisor) labels() []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel("version", s.appd.Version())}
+}
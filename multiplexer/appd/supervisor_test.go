@@ -0,0 +1,150 @@
+//go:build multiplexer
+
+package appd
+
+import (
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func testSupervisorConfig() SupervisorConfig {
+	return SupervisorConfig{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+		StableAfter:    time.Minute,
+	}
+}
+
+func newTestAppd(path string) *Appd {
+	return &Appd{
+		version: "v5",
+		path:    path,
+		stdin:   os.Stdin,
+		stdout:  os.Stdout,
+	}
+}
+
+func TestSupervisor(t *testing.T) {
+	t.Run("should restart a process that exits unexpectedly", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "echo 'panic: boom' >&2; exit 3")
+		defer os.Remove(mockBinary)
+
+		var resyncs atomic.Int32
+		supervisor := NewSupervisor(newTestAppd(mockBinary), log.NewNopLogger(), testSupervisorConfig(), func() error {
+			resyncs.Add(1)
+			return nil
+		})
+		require.NoError(t, supervisor.Start())
+		defer supervisor.Stop() //nolint:errcheck
+
+		require.Eventually(t, func() bool {
+			return supervisor.Status().Restarts >= 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		status := supervisor.Status()
+		require.Equal(t, "v5", status.Version)
+		require.Equal(t, mockBinary, status.Path)
+		require.Equal(t, 3, status.LastExitCode)
+		require.Contains(t, status.LastExitError, "exit status 3")
+		require.False(t, status.LastExitTime.IsZero())
+		require.Contains(t, status.StderrTail, "panic: boom")
+		require.GreaterOrEqual(t, int(resyncs.Load()), 2)
+	})
+
+	t.Run("should allow reading the process state while restarting", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "exit 1")
+		defer os.Remove(mockBinary)
+
+		appd := newTestAppd(mockBinary)
+		supervisor := NewSupervisor(appd, log.NewNopLogger(), testSupervisorConfig(), nil)
+		require.NoError(t, supervisor.Start())
+		defer supervisor.Stop() //nolint:errcheck
+
+		// the multiplexer reads the state of the process without going
+		// through the supervisor.
+		for supervisor.Status().Restarts < 3 {
+			appd.IsRunning()
+			appd.PID()
+			appd.ExitCode()
+			_ = appd.ExitError()
+			appd.StderrTail()
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("should give up after the maximum number of restarts", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "exit 1")
+		defer os.Remove(mockBinary)
+
+		cfg := testSupervisorConfig()
+		cfg.MaxRestarts = 2
+		supervisor := NewSupervisor(newTestAppd(mockBinary), log.NewNopLogger(), cfg, nil)
+		require.NoError(t, supervisor.Start())
+		defer supervisor.Stop() //nolint:errcheck
+
+		require.Eventually(t, func() bool {
+			status := supervisor.Status()
+			return status.Restarts == 2 && !status.Running
+		}, 5*time.Second, 10*time.Millisecond)
+
+		time.Sleep(100 * time.Millisecond)
+		require.Equal(t, 2, supervisor.Status().Restarts)
+	})
+
+	t.Run("should report a failed resync", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "sleep 0.05; exit 1")
+		defer os.Remove(mockBinary)
+
+		supervisor := NewSupervisor(newTestAppd(mockBinary), log.NewNopLogger(), testSupervisorConfig(), func() error {
+			return os.ErrDeadlineExceeded
+		})
+		require.NoError(t, supervisor.Start())
+		defer supervisor.Stop() //nolint:errcheck
+
+		require.Eventually(t, func() bool {
+			return supervisor.Status().LastRestartError == os.ErrDeadlineExceeded.Error()
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should not restart a stopped process", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "sleep 10")
+		defer os.Remove(mockBinary)
+
+		supervisor := NewSupervisor(newTestAppd(mockBinary), log.NewNopLogger(), testSupervisorConfig(), nil)
+		require.NoError(t, supervisor.Start())
+
+		status := supervisor.Status()
+		require.True(t, status.Running)
+		require.NotZero(t, status.PID)
+
+		require.NoError(t, supervisor.Stop())
+		time.Sleep(100 * time.Millisecond)
+
+		status = supervisor.Status()
+		require.False(t, status.Running)
+		require.Zero(t, status.PID)
+		require.Zero(t, status.Restarts)
+		require.Equal(t, -1, status.LastExitCode)
+	})
+}
+
+func TestTailWriter(t *testing.T) {
+	tail := newTailWriter(2)
+
+	_, err := tail.Write([]byte("first\nsec"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "sec"}, tail.Lines())
+
+	_, err = tail.Write([]byte("ond\nthird\nfourth"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"third", "fourth"}, tail.Lines())
+
+	_, err = tail.Write([]byte("\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"third", "fourth"}, tail.Lines())
+}
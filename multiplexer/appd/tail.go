@@ -0,0 +1,69 @@
+package appd
+
+import (
+	"bytes"
+	"sync"
+)
+
+// stderrTailLines is the number of stderr lines kept for each started process.
+const stderrTailLines = 50
+
+// maxPartialLineBytes bounds the size of a line that has not been terminated yet.
+const maxPartialLineBytes = 4096
+
+// tailWriter is an io.Writer that keeps the last maxLines lines written to it.
+type tailWriter struct {
+	mu       sync.Mutex
+	maxLines int
+	lines    []string
+	// partial is the last line written without a trailing newline.
+	partial []byte
+}
+
+func newTailWriter(maxLines int) *tailWriter {
+	return &tailWriter{maxLines: maxLines}
+}
+
+// Write implements io.Writer.
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	data := append(t.partial, p...)
+	for {
+		idx := bytes.IndexByte(data, '\n')
+		if idx < 0 {
+			break
+		}
+		t.appendLine(string(data[:idx]))
+		data = data[idx+1:]
+	}
+	if len(data) > maxPartialLineBytes {
+		data = data[len(data)-maxPartialLineBytes:]
+	}
+	t.partial = append([]byte(nil), data...)
+	return len(p), nil
+}
+
+func (t *tailWriter) appendLine(line string) {
+	t.lines = append(t.lines, line)
+	if len(t.lines) > t.maxLines {
+		t.lines = t.lines[len(t.lines)-t.maxLines:]
+	}
+}
+
+// Lines returns a copy of the last lines written, including a trailing
+// partial line if there is one.
+func (t *tailWriter) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := append([]string(nil), t.lines...)
+	if len(t.partial) > 0 {
+		lines = append(lines, string(t.partial))
+		if len(lines) > t.maxLines {
+			lines = lines[len(lines)-t.maxLines:]
+		}
+	}
+	return lines
+}
@@ -1,10 +1,22 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagVersionsManifest is the path to a versions manifest whose binaries
+	// replace or extend the embedded versions.
+	FlagVersionsManifest = "multiplexer.versions-manifest"
+	// EnvVersionsManifest is the environment variable read by the passthrough
+	// command for the path to a versions manifest, as it does not parse flags.
+	EnvVersionsManifest = "CELESTIA_APP_MULTIPLEXER_VERSIONS_MANIFEST"
 )
 
 // StartCommandHandler is the type that must implement the multiplexer to match Cosmos SDK start logic.
@@ -24,6 +36,36 @@ func New(versions abci.Versions) StartCommandHandler {
 			return nil
 		}
 
+		versions, err := withManifest(versions, svrCtx.Viper.GetString(FlagVersionsManifest))
+		if err != nil {
+			return err
+		}
+
 		return start(versions, svrCtx, clientCtx, appCreator)
 	}
 }
+
+// AddFlags adds the multiplexer flags to the start command.
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagVersionsManifest, "", "Path to a JSON versions manifest of app binaries that replace or extend the embedded ones")
+	startCmd.Flags().String(abci.FlagStatusAddress, "", "Address of the HTTP endpoint serving the status of the multiplexer and its embedded app, e.g. localhost:26664. Disabled if empty")
+}
+
+// withManifest returns the versions overridden by the versions of the manifest
+// at manifestPath. The versions are returned unchanged if manifestPath is empty.
+func withManifest(versions abci.Versions, manifestPath string) (abci.Versions, error) {
+	if manifestPath == "" {
+		return versions, nil
+	}
+
+	manifestVersions, err := abci.LoadManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err = versions.Override(manifestVersions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply versions manifest: %w", err)
+	}
+	return versions, nil
+}
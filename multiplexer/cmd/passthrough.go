@@ -1,38 +1,92 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/internal"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	db "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagPassthroughHeight   = "--height"
+	flagPassthroughJSON     = "--json"
+	flagPassthroughSnapshot = "--snapshot"
 )
 
 // NewPassthroughCmd creates a command that allows executing commands on any app version.
 // This enables direct interaction with older app versions for debugging or older queries.
 func NewPassthroughCmd(versions abci.Versions) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:                "passthrough [version] [command]",
+		Use:                "passthrough [--height height] [--json] [--snapshot] [version] [command]",
 		DisableFlagParsing: true,
 		Short:              "Execute a command on a specific app version",
 		Long: `Execute a command on a specific app version.
-This allows interacting with older app versions for debugging or older queries.`,
-		Example: `passthrough v3 status`,
+This allows interacting with older app versions for debugging or older queries.
+
+The following flags must be set before the version and the command:
+  --height height  select the app version used at the given height of the chain instead of passing a version
+  --json           capture the output of the command and print it as JSON
+  --snapshot       run the command against a copy of the application state, rolled back to --height if set.
+                   The node must be stopped.`,
+		Example: `passthrough v3 status
+passthrough --height 1000 --json version
+passthrough --height 1000 --snapshot export --height 1000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 || (args[0] == "-h" || args[0] == "--help") {
 				return cmd.Help()
 			}
 
-			if len(args) >= 2 && strings.EqualFold("start", args[1]) {
+			opts, args, err := parsePassthroughArgs(args)
+			if err != nil {
+				return err
+			}
+
+			home := homeFromArgs(args)
+			if home == "" {
+				home = client.GetClientContextFromCmd(cmd).HomeDir
+			}
+
+			var version uint64
+			if opts.height > 0 {
+				version, err = appVersionAtHeight(home, opts.height)
+				if err != nil {
+					return err
+				}
+			} else {
+				if len(args) == 0 {
+					return errors.New("no version specified")
+				}
+				versionStr := strings.TrimPrefix(args[0], "v")
+				version, err = strconv.ParseUint(versionStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse version: %w", err)
+				}
+				args = args[1:]
+			}
+
+			if len(args) >= 1 && strings.EqualFold("start", args[0]) {
 				return errors.New("cannot passthrough start command")
 			}
 
-			versionStr := strings.TrimPrefix(args[0], "v")
-			version, err := strconv.ParseUint(versionStr, 10, 64)
+			versions, err := withManifest(versions, os.Getenv(EnvVersionsManifest))
 			if err != nil {
-				return fmt.Errorf("failed to parse version: %w", err)
+				return err
 			}
 
 			if versions.ShouldUseLatestApp(version) {
@@ -49,11 +103,257 @@ This allows interacting with older app versions for debugging or older queries.`
 				return fmt.Errorf("no binary available for version %d", version)
 			}
 
+			if opts.snapshot {
+				snapshotHome, err := snapshotHome(home)
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(snapshotHome)
+				if opts.height > 0 {
+					if err := rollbackSnapshot(snapshotHome, opts.height); err != nil {
+						return err
+					}
+				}
+				args = append(removeHome(args), "--home", snapshotHome)
+			}
+
 			// prepare the command to be executed
-			execCmd := appVersion.Appd.CreateExecCommand(args[1:]...)
-			return execCmd.Run()
+			execCmd := appVersion.Appd.CreateExecCommand(args...)
+			if !opts.json {
+				return execCmd.Run()
+			}
+
+			result := runCaptured(execCmd)
+			result.AppVersion = appVersion.AppVersion
+			result.Height = opts.height
+			result.Binary = appVersion.Appd.Version()
+			result.Args = args
+
+			bz, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			if result.ExitCode != 0 {
+				return fmt.Errorf("command exited with code %d", result.ExitCode)
+			}
+			return nil
 		},
 	}
 
 	return cmd
 }
+
+// passthroughOptions are the options of the passthrough command.
+type passthroughOptions struct {
+	// height selects the app version used at this height of the chain.
+	height int64
+	// json captures the output of the command and prints it as JSON.
+	json bool
+	// snapshot runs the command against a copy of the home directory.
+	snapshot bool
+}
+
+// parsePassthroughArgs parses the leading passthrough flags of args and
+// returns the remaining args. Flags after the version or the command are
+// passed to the binary.
+func parsePassthroughArgs(args []string) (passthroughOptions, []string, error) {
+	var opts passthroughOptions
+	for len(args) > 0 {
+		arg := args[0]
+		switch {
+		case arg == flagPassthroughJSON:
+			opts.json = true
+			args = args[1:]
+		case arg == flagPassthroughSnapshot:
+			opts.snapshot = true
+			args = args[1:]
+		case arg == flagPassthroughHeight || strings.HasPrefix(arg, flagPassthroughHeight+"="):
+			_, value, found := strings.Cut(arg, "=")
+			args = args[1:]
+			if !found {
+				if len(args) == 0 {
+					return opts, nil, errors.New("no height specified")
+				}
+				value, args = args[0], args[1:]
+			}
+
+			height, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || height <= 0 {
+				return opts, nil, fmt.Errorf("invalid height %q", value)
+			}
+			opts.height = height
+		default:
+			return opts, args, nil
+		}
+	}
+	return opts, args, nil
+}
+
+// passthroughResult is the JSON output of the passthrough command.
+type passthroughResult struct {
+	AppVersion uint64   `json:"app_version"`
+	Height     int64    `json:"height,omitempty"`
+	Binary     string   `json:"binary"`
+	Args       []string `json:"args"`
+	ExitCode   int      `json:"exit_code"`
+	// Output is the standard output of the command if it is valid JSON.
+	Output json.RawMessage `json:"output,omitempty"`
+	// Stdout is the standard output of the command if it is not valid JSON.
+	Stdout string `json:"stdout,omitempty"`
+	Stderr string `json:"stderr,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// runCaptured runs execCmd and captures its output.
+func runCaptured(execCmd *exec.Cmd) passthroughResult {
+	var stdout, stderr bytes.Buffer
+	execCmd.Stdout = &stdout
+	execCmd.Stderr = &stderr
+
+	var result passthroughResult
+	if err := execCmd.Run(); err != nil {
+		result.Error = err.Error()
+		result.ExitCode = -1
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			result.ExitCode = exitErr.ExitCode()
+		}
+	}
+
+	if output := bytes.TrimSpace(stdout.Bytes()); len(output) > 0 && json.Valid(output) {
+		result.Output = output
+	} else {
+		result.Stdout = stdout.String()
+	}
+	result.Stderr = stderr.String()
+	return result
+}
+
+// homeFromArgs returns the value of the --home flag in args or an empty string.
+func homeFromArgs(args []string) string {
+	home := ""
+	for i, arg := range args {
+		if arg == "--"+flags.FlagHome && i+1 < len(args) {
+			home = args[i+1]
+		} else if value, ok := strings.CutPrefix(arg, "--"+flags.FlagHome+"="); ok {
+			home = value
+		}
+	}
+	return home
+}
+
+// removeHome returns args without the --home flag.
+func removeHome(args []string) []string {
+	result := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--"+flags.FlagHome {
+			i++ // skip the value
+			continue
+		}
+		if strings.HasPrefix(args[i], "--"+flags.FlagHome+"=") {
+			continue
+		}
+		result = append(result, args[i])
+	}
+	return result
+}
+
+// snapshotHome copies the configuration and the application database of home
+// to a temporary directory and returns it. The block store and the CometBFT
+// state are not copied as the commands run against the snapshot only need the
+// application state. The node must be stopped: the application database is
+// kept open while it's copied, which fails if the node holds it and prevents
+// the node from writing to it during the copy.
+func snapshotHome(home string) (string, error) {
+	v, err := readNodeConfig(home)
+	if err != nil {
+		return "", err
+	}
+
+	dataDir := filepath.Join(home, "data")
+	if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+		return "", fmt.Errorf("failed to find the application database: %w", err)
+	}
+	appDB, err := db.NewDB("application", server.GetAppDBBackend(v), dataDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to open application database, make sure the node is stopped: %w", err)
+	}
+	defer appDB.Close()
+
+	snapshot, err := os.MkdirTemp("", "passthrough-snapshot-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	for _, dir := range []string{"config", filepath.Join("data", "application.db")} {
+		if err := copyDir(filepath.Join(home, dir), filepath.Join(snapshot, dir)); err != nil {
+			os.RemoveAll(snapshot)
+			return "", fmt.Errorf("failed to snapshot %s: %w", dir, err)
+		}
+	}
+	return snapshot, nil
+}
+
+// readNodeConfig reads the CometBFT and app configuration files of home.
+func readNodeConfig(home string) (*viper.Viper, error) {
+	v := viper.New()
+	for _, file := range []string{"config.toml", "app.toml"} {
+		path := filepath.Join(home, "config", file)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		v.SetConfigFile(path)
+		if err := v.MergeInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	}
+	return v, nil
+}
+
+// appVersionAtHeight returns the app version stored in the block header at
+// height in the block store of home.
+func appVersionAtHeight(home string, height int64) (uint64, error) {
+	v, err := readNodeConfig(home)
+	if err != nil {
+		return 0, err
+	}
+
+	cfg := cmtcfg.DefaultConfig().SetRoot(home)
+	if backend := v.GetString("db_backend"); backend != "" {
+		cfg.DBBackend = backend
+	}
+
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return 0, fmt.Errorf("failed to open block store, make sure the node is stopped: %w", err)
+	}
+	defer blockStoreDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return 0, fmt.Errorf("block %d not found, the block store contains blocks %d to %d", height, blockStore.Base(), blockStore.Height())
+	}
+	return meta.Header.Version.App, nil
+}
+
+// rollbackSnapshot rolls back the application database of the snapshot home to height.
+func rollbackSnapshot(home string, height int64) error {
+	v, err := readNodeConfig(home)
+	if err != nil {
+		return err
+	}
+
+	appDB, err := db.NewDB("application", server.GetAppDBBackend(v), filepath.Join(home, "data"))
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot application database: %w", err)
+	}
+	defer appDB.Close()
+
+	if err := internal.RollbackAppDB(appDB, height); err != nil {
+		return fmt.Errorf("failed to roll back snapshot to height %d: %w", height, err)
+	}
+	return nil
+}
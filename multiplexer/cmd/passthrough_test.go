@@ -2,10 +2,19 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
 	"github.com/celestiaorg/celestia-app/v6/multiplexer/appd"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
@@ -77,3 +86,137 @@ func executeCommand(t *testing.T, cmd *cobra.Command, args ...string) (string, e
 	}
 	return output.Name(), nil
 }
+
+func TestPassthroughJSON(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "appd")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\necho '{\"args\":\"'\"$*\"'\"}'\necho warning >&2\n"), 0o755))
+
+	app, err := appd.NewFromPath("v1.0.0", binary, "")
+	require.NoError(t, err)
+
+	cmd := NewPassthroughCmd(abci.Versions{newVersion(1, app)})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	_, err = executeCommand(t, cmd, "--json", "v1", "status", "--home=/tmp/home")
+	require.NoError(t, err)
+
+	var result passthroughResult
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	require.Equal(t, uint64(1), result.AppVersion)
+	require.Equal(t, "v1.0.0", result.Binary)
+	require.Equal(t, []string{"status", "--home=/tmp/home"}, result.Args)
+	require.Zero(t, result.ExitCode)
+	require.JSONEq(t, `{"args":"status --home=/tmp/home"}`, string(result.Output))
+	require.Equal(t, "warning\n", result.Stderr)
+}
+
+func TestRunCaptured(t *testing.T) {
+	result := runCaptured(exec.Command("sh", "-c", "echo not json; exit 3"))
+	require.Equal(t, 3, result.ExitCode)
+	require.Equal(t, "not json\n", result.Stdout)
+	require.Nil(t, result.Output)
+	require.Contains(t, result.Error, "exit status 3")
+}
+
+func TestParsePassthroughArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedOpts passthroughOptions
+		expectedArgs []string
+		expectedErr  string
+	}{
+		{
+			name:         "version only",
+			args:         []string{"v3", "status", "--json"},
+			expectedArgs: []string{"v3", "status", "--json"},
+		},
+		{
+			name:         "all options",
+			args:         []string{"--json", "--height", "10", "--snapshot", "q", "bank", "--height", "5"},
+			expectedOpts: passthroughOptions{height: 10, json: true, snapshot: true},
+			expectedArgs: []string{"q", "bank", "--height", "5"},
+		},
+		{
+			name:         "height with equal sign",
+			args:         []string{"--height=7", "status"},
+			expectedOpts: passthroughOptions{height: 7},
+			expectedArgs: []string{"status"},
+		},
+		{
+			name:        "missing height",
+			args:        []string{"--height"},
+			expectedErr: "no height specified",
+		},
+		{
+			name:        "invalid height",
+			args:        []string{"--height", "-1", "status"},
+			expectedErr: `invalid height "-1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, args, err := parsePassthroughArgs(tt.args)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOpts, opts)
+			require.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestHomeArgs(t *testing.T) {
+	args := []string{"export", "--home", "/a", "--height", "1", "--home=/b"}
+	require.Equal(t, "/b", homeFromArgs(args))
+	require.Equal(t, []string{"export", "--height", "1"}, removeHome(args))
+	require.Empty(t, homeFromArgs([]string{"status"}))
+}
+
+func TestAppVersionAtHeightMissingBlock(t *testing.T) {
+	_, err := appVersionAtHeight(t.TempDir(), 10)
+	require.ErrorContains(t, err, "block 10 not found")
+}
+
+func TestSnapshotHome(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "app.toml"), []byte(`app-db-backend = "goleveldb"`), 0o600))
+
+	appDB, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	rs := rootmulti.NewStore(appDB, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey("bank")
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	for i := byte(1); i <= 3; i++ {
+		rs.GetKVStore(key).Set([]byte{i}, []byte{i})
+		rs.Commit()
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data", "blockstore.db"), 0o755))
+
+	// the application database is held by the running node.
+	_, err = snapshotHome(home)
+	require.ErrorContains(t, err, "make sure the node is stopped")
+	require.NoError(t, appDB.Close())
+
+	snapshot, err := snapshotHome(home)
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshot)
+	require.FileExists(t, filepath.Join(snapshot, "config", "app.toml"))
+	require.NoDirExists(t, filepath.Join(snapshot, "data", "blockstore.db"))
+
+	require.NoError(t, rollbackSnapshot(snapshot, 2))
+
+	// the snapshot is rolled back while the original data is left untouched.
+	for dir, expected := range map[string]int64{snapshot: 2, home: 3} {
+		appDB, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(dir, "data"))
+		require.NoError(t, err)
+		require.Equal(t, expected, rootmulti.GetLatestVersion(appDB))
+		require.NoError(t, appDB.Close())
+	}
+}
@@ -0,0 +1,485 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/internal"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	db "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	flagBlocks   = "blocks"
+	flagKeepData = "keep-data"
+
+	// appStartTimeout is the time an embedded app has to start listening.
+	appStartTimeout = time.Minute
+)
+
+// NewMultiplexerCmd returns the multiplexer command which groups the
+// multiplexer subcommands.
+func NewMultiplexerCmd(versions abci.Versions, appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multiplexer",
+		Short: "Multiplexer subcommands",
+	}
+	cmd.AddCommand(NewRehearseUpgradeCmd(versions, appCreator))
+	return cmd
+}
+
+// NewRehearseUpgradeCmd returns a command that replays the latest blocks of the
+// block store on a copy of the application state. It checks that the app
+// hashes and app version transitions match the ones of the chain and that the
+// next app version can load the resulting state.
+func NewRehearseUpgradeCmd(versions abci.Versions, appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rehearse-upgrade",
+		Short: "Replay the latest blocks on a copy of the application state and check the app version transition",
+		Long: `Replay the latest blocks on a copy of the application state and check the app version transition.
+
+The application database is copied to a temporary directory and rolled back by
+the number of blocks to replay. The blocks are then replayed from the block
+store through FinalizeBlock and Commit, switching between embedded and native
+apps when the app version changes. The command fails if an app hash or an app
+version transition does not match the chain. Finally the app of the next app
+version is started on the resulting state.
+
+The node must be stopped while running this command.`,
+		Example: `rehearse-upgrade --blocks 100`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svrCtx := server.GetServerContextFromCmd(cmd)
+
+			blocks, err := cmd.Flags().GetInt64(flagBlocks)
+			if err != nil {
+				return err
+			}
+			keepData, err := cmd.Flags().GetBool(flagKeepData)
+			if err != nil {
+				return err
+			}
+
+			versions, err := withManifest(versions, svrCtx.Viper.GetString(FlagVersionsManifest))
+			if err != nil {
+				return err
+			}
+
+			home, err := os.MkdirTemp("", "rehearse-upgrade-")
+			if err != nil {
+				return fmt.Errorf("failed to create temporary directory: %w", err)
+			}
+			if keepData {
+				fmt.Fprintf(cmd.OutOrStdout(), "keeping the rehearsal data in %s\n", home)
+			} else {
+				defer os.RemoveAll(home)
+			}
+
+			r := &rehearsal{
+				svrCtx:     svrCtx,
+				versions:   versions,
+				appCreator: appCreator,
+				home:       home,
+				out:        cmd.OutOrStdout(),
+			}
+			return r.run(blocks)
+		},
+	}
+
+	cmd.Flags().Int64(flagBlocks, 100, "Number of latest blocks to replay")
+	cmd.Flags().Bool(flagKeepData, false, "Keep the copy of the application state after the rehearsal")
+	cmd.Flags().String(FlagVersionsManifest, "", "Path to a JSON versions manifest of app binaries that replace or extend the embedded ones")
+	return cmd
+}
+
+// rehearsal replays blocks of a node on a copy of its application state.
+type rehearsal struct {
+	svrCtx     *server.Context
+	versions   abci.Versions
+	appCreator types.AppCreator
+	// home is the home directory of the copy of the application state.
+	home string
+	out  io.Writer
+
+	chainID string
+	// appVersion is the app version of the running app.
+	appVersion uint64
+	app        types.ABCI
+	stopApp    func() error
+}
+
+func (r *rehearsal) run(blocks int64) error {
+	if blocks <= 0 {
+		return fmt.Errorf("the number of blocks to replay must be positive, got %d", blocks)
+	}
+
+	cfg := r.svrCtx.Config
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return fmt.Errorf("failed to open block store, make sure the node is stopped: %w", err)
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return fmt.Errorf("failed to open state store, make sure the node is stopped: %w", err)
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	r.chainID = state.ChainID
+
+	lastHeight := state.LastBlockHeight
+	firstHeight := lastHeight - blocks + 1
+	if firstHeight <= state.InitialHeight || firstHeight < blockStore.Base() {
+		return fmt.Errorf("cannot replay %d blocks, the block store contains blocks %d to %d and the first replayed block must be after the initial height %d",
+			blocks, blockStore.Base(), blockStore.Height(), state.InitialHeight)
+	}
+
+	if err := r.copyAppState(firstHeight - 1); err != nil {
+		return err
+	}
+
+	firstBlock := blockStore.LoadBlock(firstHeight)
+	if firstBlock == nil {
+		return fmt.Errorf("block %d not found", firstHeight)
+	}
+
+	defer func() {
+		if r.stopApp == nil {
+			return
+		}
+		if err := r.stopApp(); err != nil {
+			fmt.Fprintf(r.out, "failed to stop app version %d: %v\n", r.appVersion, err)
+		}
+	}()
+	if err := r.startApp(firstBlock.Version.App, firstHeight-1); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(r.out, "replaying blocks %d to %d starting with app version %d\n", firstHeight, lastHeight, r.appVersion)
+	transitions := 0
+	for height := firstHeight; height <= lastHeight; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d not found", height)
+		}
+
+		resp, err := r.execBlock(block, stateStore, state.InitialHeight)
+		if err != nil {
+			return fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+
+		nextAppVersion := r.appVersion
+		if resp.ConsensusParamUpdates != nil && resp.ConsensusParamUpdates.GetVersion() != nil {
+			nextAppVersion = resp.ConsensusParamUpdates.GetVersion().App
+		}
+
+		// the app hash and app version of a block are the ones resulting from
+		// the previous block.
+		expectedAppHash, expectedAppVersion := []byte(state.AppHash), state.Version.Consensus.App
+		if height < lastHeight {
+			meta := blockStore.LoadBlockMeta(height + 1)
+			if meta == nil {
+				return fmt.Errorf("block %d not found", height+1)
+			}
+			expectedAppHash, expectedAppVersion = meta.Header.AppHash, meta.Header.Version.App
+		}
+
+		if !bytes.Equal(expectedAppHash, resp.AppHash) {
+			return fmt.Errorf("app hash mismatch at height %d with app version %d: expected %X, got %X", height, r.appVersion, expectedAppHash, resp.AppHash)
+		}
+		if nextAppVersion != expectedAppVersion {
+			return fmt.Errorf("app version transition mismatch at height %d: expected app version %d, got %d", height, expectedAppVersion, nextAppVersion)
+		}
+
+		if nextAppVersion != r.appVersion {
+			fmt.Fprintf(r.out, "height %d: app version transition %d -> %d matches\n", height, r.appVersion, nextAppVersion)
+			transitions++
+			if err := r.switchApp(nextAppVersion, height); err != nil {
+				return err
+			}
+		}
+	}
+	fmt.Fprintf(r.out, "app hashes of blocks %d to %d match with %d app version transition(s)\n", firstHeight, lastHeight, transitions)
+
+	if transitions > 0 {
+		return nil
+	}
+
+	// no transition happened in the replayed blocks, so check that the next
+	// app version can load the resulting state.
+	nextAppVersion, ok := r.nextAppVersion()
+	if !ok {
+		fmt.Fprintf(r.out, "app version %d is the latest app version, there is no next app version to rehearse\n", r.appVersion)
+		return nil
+	}
+	if err := r.switchApp(nextAppVersion, lastHeight); err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "app version %d loaded the state at height %d, migrations run in its first block and are not rehearsed\n", nextAppVersion, lastHeight)
+	return nil
+}
+
+// copyAppState copies the configuration and the application database of the
+// node to the rehearsal home and rolls the application database back to height.
+func (r *rehearsal) copyAppState(height int64) error {
+	nodeHome := r.svrCtx.Config.RootDir
+	if err := copyDir(filepath.Join(nodeHome, "config"), filepath.Join(r.home, "config")); err != nil {
+		return fmt.Errorf("failed to copy config: %w", err)
+	}
+	if err := copyDir(filepath.Join(nodeHome, "data", "application.db"), filepath.Join(r.home, "data", "application.db")); err != nil {
+		return fmt.Errorf("failed to copy application database: %w", err)
+	}
+
+	appDB, err := r.openAppDB()
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+
+	if err := internal.RollbackAppDB(appDB, height); err != nil {
+		return fmt.Errorf("failed to roll back application state to height %d: %w", height, err)
+	}
+	return nil
+}
+
+func (r *rehearsal) openAppDB() (db.DB, error) {
+	return db.NewDB("application", server.GetAppDBBackend(r.svrCtx.Viper), filepath.Join(r.home, "data"))
+}
+
+// execBlock executes and commits block the same way CometBFT does when
+// replaying blocks.
+func (r *rehearsal) execBlock(block *cmttypes.Block, stateStore sm.Store, initialHeight int64) (*abcitypes.ResponseFinalizeBlock, error) {
+	lastValidators, err := stateStore.LoadValidators(block.Height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validators at height %d: %w", block.Height-1, err)
+	}
+
+	pbHeader := block.Header.ToProto()
+	resp, err := r.app.FinalizeBlock(&abcitypes.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValidators, initialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		Header:             pbHeader,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(block.Txs) != len(resp.TxResults) {
+		return nil, fmt.Errorf("expected %d tx results, got %d", len(block.Txs), len(resp.TxResults))
+	}
+
+	if _, err := r.app.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return resp, nil
+}
+
+// nextAppVersion returns the app version following the running one. It
+// returns false if the running app is the native app.
+func (r *rehearsal) nextAppVersion() (uint64, bool) {
+	if r.versions.ShouldUseLatestApp(r.appVersion) {
+		return 0, false
+	}
+	for _, version := range r.versions {
+		if version.AppVersion > r.appVersion {
+			return version.AppVersion, true
+		}
+	}
+	return appconsts.Version, true
+}
+
+// switchApp stops the running app and starts the app for appVersion.
+func (r *rehearsal) switchApp(appVersion uint64, height int64) error {
+	stopApp := r.stopApp
+	r.app, r.stopApp = nil, nil
+	if err := stopApp(); err != nil {
+		return fmt.Errorf("failed to stop app version %d: %w", r.appVersion, err)
+	}
+	return r.startApp(appVersion, height)
+}
+
+// startApp starts the app for appVersion on the rehearsal home and checks that
+// it is at the given height.
+func (r *rehearsal) startApp(appVersion uint64, height int64) error {
+	if r.versions.ShouldUseLatestApp(appVersion) {
+		if err := r.startNativeApp(); err != nil {
+			return fmt.Errorf("failed to start native app for app version %d: %w", appVersion, err)
+		}
+	} else if err := r.startEmbeddedApp(appVersion); err != nil {
+		return fmt.Errorf("failed to start embedded app for app version %d: %w", appVersion, err)
+	}
+	r.appVersion = appVersion
+
+	info, err := r.app.Info(proxy.RequestInfo)
+	if err != nil {
+		return fmt.Errorf("failed to get info from app version %d: %w", appVersion, err)
+	}
+	if info.LastBlockHeight != height {
+		return fmt.Errorf("app version %d is at height %d, expected %d", appVersion, info.LastBlockHeight, height)
+	}
+	return nil
+}
+
+func (r *rehearsal) startNativeApp() error {
+	appDB, err := r.openAppDB()
+	if err != nil {
+		return err
+	}
+
+	r.svrCtx.Viper.Set(flags.FlagHome, r.home)
+	app := r.appCreator(r.svrCtx.Logger, appDB, nil, r.svrCtx.Viper)
+	r.app = app
+	r.stopApp = app.Close
+	return nil
+}
+
+func (r *rehearsal) startEmbeddedApp(appVersion uint64) error {
+	version, err := r.versions.GetForAppVersion(appVersion)
+	if err != nil {
+		return err
+	}
+	if version.Appd == nil {
+		return fmt.Errorf("no binary available for version %d", appVersion)
+	}
+
+	address, err := freeAddress()
+	if err != nil {
+		return err
+	}
+
+	args := version.GetStartArgs([]string{"--home", r.home, "--address", "tcp://" + address})
+	args = append(args, "--grpc.enable=false", "--api.enable=false")
+	if err := version.Appd.Start(args...); err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(math.MaxInt32),
+			grpc.MaxCallRecvMsgSize(math.MaxInt32),
+		),
+	)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to prepare app connection: %w", err), version.Appd.Stop())
+	}
+
+	r.stopApp = func() error {
+		return errors.Join(conn.Close(), version.Appd.Stop())
+	}
+
+	if err := waitForListener(address, version.Appd.Done()); err != nil {
+		return errors.Join(err, r.stopApp())
+	}
+
+	switch version.ABCIVersion {
+	case abci.ABCIClientVersion1:
+		r.app = abci.NewRemoteABCIClientV1(conn, r.chainID, appVersion)
+	case abci.ABCIClientVersion2:
+		r.app = abci.NewRemoteABCIClientV2(conn)
+	}
+	return nil
+}
+
+// freeAddress returns a local address with a free port.
+func freeAddress() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("failed to find a free port: %w", err)
+	}
+	defer listener.Close()
+	return listener.Addr().String(), nil
+}
+
+// waitForListener waits until address accepts connections. It fails if the
+// process exits, as signaled by exited, or if it does not listen in time.
+func waitForListener(address string, exited <-chan struct{}) error {
+	deadline := time.After(appStartTimeout)
+	for {
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err == nil {
+			return conn.Close()
+		}
+
+		select {
+		case <-exited:
+			return errors.New("app exited before listening")
+		case <-deadline:
+			return fmt.Errorf("app did not listen on %s within %s", address, appStartTimeout)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// copyDir recursively copies the directory src to dst.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm()|0o700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
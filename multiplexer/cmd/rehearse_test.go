@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/test/util/genesis"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRehearsalNextAppVersion(t *testing.T) {
+	r := &rehearsal{versions: abci.Versions{{AppVersion: 3}, {AppVersion: 4}}}
+
+	r.appVersion = 3
+	next, ok := r.nextAppVersion()
+	require.True(t, ok)
+	require.Equal(t, uint64(4), next)
+
+	r.appVersion = 4
+	next, ok = r.nextAppVersion()
+	require.True(t, ok)
+	require.Equal(t, appconsts.Version, next)
+
+	r.appVersion = appconsts.Version
+	_, ok = r.nextAppVersion()
+	require.False(t, ok)
+}
+
+func TestCopyDir(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "nested", "b"), []byte("b"), 0o644))
+
+	dst := filepath.Join(t.TempDir(), "copy")
+	require.NoError(t, copyDir(src, dst))
+
+	bz, err := os.ReadFile(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), bz)
+
+	bz, err = os.ReadFile(filepath.Join(dst, "nested", "b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), bz)
+
+	info, err := os.Stat(filepath.Join(dst, "nested", "b"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+}
+
+func TestRehearsalReplaysBlocks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping rehearsal of testnode blocks in short mode.")
+	}
+
+	home := produceBlocks(t, 8)
+
+	t.Run("app hashes match", func(t *testing.T) {
+		var out bytes.Buffer
+		r := newTestRehearsal(t, home, newTestAppServer, &out)
+		require.NoError(t, r.run(3))
+		require.Contains(t, out.String(), "match with 0 app version transition(s)")
+	})
+
+	t.Run("app hash mismatch", func(t *testing.T) {
+		tamperedAppServer := func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+			return tamperedApp{newTestAppServer(logger, db, traceStore, appOpts)}
+		}
+		r := newTestRehearsal(t, home, tamperedAppServer, io.Discard)
+		require.ErrorContains(t, r.run(3), "app hash mismatch")
+	})
+}
+
+// produceBlocks runs a single validator testnode, which persists its
+// application state, until it has produced at least the given number of blocks,
+// stops it and returns its home directory.
+func produceBlocks(t *testing.T, blocks int64) string {
+	t.Helper()
+
+	cfg := testnode.DefaultConfig().WithAppCreator(newTestAppServer)
+	home := t.TempDir()
+	require.NoError(t, genesis.InitFiles(home, cfg.TmConfig, cfg.AppConfig, cfg.Genesis, 0))
+
+	cometNode, testApp, err := testnode.NewCometNode(home, &cfg.UniversalTestingConfig)
+	require.NoError(t, err)
+	cctx := testnode.NewContext(t.Context(), cfg.Genesis.Keyring(), cfg.TmConfig, cfg.Genesis.ChainID, cfg.AppConfig.API.Address)
+	// the returned cleanup removes the home directory so the node is stopped
+	// directly instead.
+	cctx, _, err = testnode.StartNode(cometNode, cctx)
+	require.NoError(t, err)
+
+	_, err = cctx.WaitForHeight(blocks)
+	require.NoError(t, err)
+
+	require.NoError(t, cometNode.Stop())
+	cometNode.Wait()
+	require.NoError(t, testApp.Close())
+	return home
+}
+
+func newTestRehearsal(t *testing.T, home string, appCreator servertypes.AppCreator, out io.Writer) *rehearsal {
+	t.Helper()
+
+	v, err := readNodeConfig(home)
+	require.NoError(t, err)
+	svrCtx := server.NewContext(v, cmtcfg.DefaultConfig().SetRoot(home), log.NewNopLogger())
+	return &rehearsal{
+		svrCtx:     svrCtx,
+		appCreator: appCreator,
+		home:       t.TempDir(),
+		out:        out,
+	}
+}
+
+func newTestAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	return app.New(logger, db, traceStore, 100*time.Millisecond, appOpts, server.DefaultBaseappOptions(appOpts)...)
+}
+
+// tamperedApp is an app whose state diverges from the chain.
+type tamperedApp struct {
+	servertypes.Application
+}
+
+func (a tamperedApp) FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
+	resp, err := a.Application.FinalizeBlock(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.AppHash = []byte("tampered")
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// RollbackAppDB rolls back the application database to the given height. It
+// does not depend on the app version that wrote the database because the
+// stores are mounted by the names recorded in the commit info. Stores created
+// after height are deleted.
+func RollbackAppDB(db dbm.DB, height int64) error {
+	latest := rootmulti.GetLatestVersion(db)
+	if height > latest {
+		return fmt.Errorf("cannot roll back to height %d, the application is at height %d", height, latest)
+	}
+	if height == latest {
+		return nil
+	}
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	latestInfo, err := rs.GetCommitInfo(latest)
+	if err != nil {
+		return fmt.Errorf("failed to get commit info at height %d: %w", latest, err)
+	}
+	targetInfo, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("failed to get commit info at height %d, it may have been pruned: %w", height, err)
+	}
+
+	targetStores := make(map[string]struct{}, len(targetInfo.StoreInfos))
+	for _, info := range targetInfo.StoreInfos {
+		targetStores[info.Name] = struct{}{}
+		rs.MountStoreWithDB(storetypes.NewKVStoreKey(info.Name), storetypes.StoreTypeIAVL, nil)
+	}
+
+	for _, info := range latestInfo.StoreInfos {
+		if _, ok := targetStores[info.Name]; ok {
+			continue
+		}
+		if err := deletePrefix(db, []byte("s/k:"+info.Name+"/")); err != nil {
+			return fmt.Errorf("failed to delete store %s: %w", info.Name, err)
+		}
+	}
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to load application state: %w", err)
+	}
+	return rs.RollbackToVersion(height)
+}
+
+// deletePrefix deletes all keys of db starting with prefix.
+func deletePrefix(db dbm.DB, prefix []byte) error {
+	it, err := dbm.IteratePrefix(db, prefix)
+	if err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			it.Close()
+			return err
+		}
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
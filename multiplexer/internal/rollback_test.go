@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newMultiStore(t *testing.T, db dbm.DB, names ...string) (*rootmulti.Store, map[string]*storetypes.KVStoreKey) {
+	t.Helper()
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := make(map[string]*storetypes.KVStoreKey, len(names))
+	for _, name := range names {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		rs.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	return rs, keys
+}
+
+func TestRollbackAppDB(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	rs, keys := newMultiStore(t, db, "a", "b")
+	require.NoError(t, rs.LoadLatestVersion())
+
+	commits := make(map[int64]storetypes.CommitID)
+	for i := byte(1); i <= 3; i++ {
+		rs.GetKVStore(keys["a"]).Set([]byte{i}, []byte{i})
+		rs.GetKVStore(keys["b"]).Set([]byte{i}, []byte{i})
+		commit := rs.Commit()
+		commits[commit.Version] = commit
+	}
+
+	// add a store after height 3.
+	rs, keys = newMultiStore(t, db, "a", "b", "c")
+	require.NoError(t, rs.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Added: []string{"c"}}))
+	rs.GetKVStore(keys["c"]).Set([]byte{4}, []byte{4})
+	require.Equal(t, int64(4), rs.Commit().Version)
+
+	require.ErrorContains(t, RollbackAppDB(db, 5), "cannot roll back to height 5")
+	require.NoError(t, RollbackAppDB(db, 2))
+	require.Equal(t, int64(2), rootmulti.GetLatestVersion(db))
+
+	rs, keys = newMultiStore(t, db, "a", "b")
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, commits[2], rs.LastCommitID())
+	require.Equal(t, []byte{2}, rs.GetKVStore(keys["a"]).Get([]byte{2}))
+	require.Nil(t, rs.GetKVStore(keys["a"]).Get([]byte{3}))
+
+	// the store added after height 2 is deleted.
+	it, err := dbm.IteratePrefix(db, []byte("s/k:c/"))
+	require.NoError(t, err)
+	defer it.Close()
+	require.False(t, it.Valid())
+
+	// rolling back to the latest height is a no-op.
+	require.NoError(t, RollbackAppDB(db, 2))
+}
@@ -0,0 +1,214 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/da"
+	"github.com/celestiaorg/celestia-app/v6/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// NewNamespaceProof constructs the data square from the block data and returns
+// a proof of all shares of the namespace in that square.
+func NewNamespaceProof(txs [][]byte, namespace share.Namespace) (NamespaceProof, error) {
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+	return NewNamespaceProofFromEDS(eds, namespace)
+}
+
+// NewNamespaceProofFromEDS takes an extended data square and returns a proof
+// of all shares of the namespace in its original data square. Every row of the
+// original data square is included in the proof. Rows whose namespace range
+// brackets the namespace contain an NMT proof of the namespace's shares, or a
+// proof of absence if the row contains none of them.
+func NewNamespaceProofFromEDS(eds *rsmt2d.ExtendedDataSquare, namespace share.Namespace) (NamespaceProof, error) {
+	squareSize := eds.Width() / 2
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	// create the binary merkle inclusion proof for all the square rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+
+	rows := make([]*NamespaceRowProof, squareSize)
+	for i := range squareSize {
+		rows[i] = &NamespaceRowProof{
+			RowRoot: edsRowRoots[i],
+			RowProof: &Proof{
+				Total:    allProofs[i].Total,
+				Index:    allProofs[i].Index,
+				LeafHash: allProofs[i].LeafHash,
+				Aunts:    allProofs[i].Aunts,
+			},
+		}
+		if !isNamespaceInRange(edsRowRoots[i], namespace) {
+			continue
+		}
+
+		shares, shareProof, err := proveNamespaceInRow(eds.Row(i), squareSize, i, edsRowRoots[i], namespace)
+		if err != nil {
+			return NamespaceProof{}, err
+		}
+		rows[i].Shares = shares
+		rows[i].ShareProof = shareProof
+	}
+
+	return NamespaceProof{
+		NamespaceId:      namespace.ID(),
+		NamespaceVersion: uint32(namespace.Version()),
+		Rows:             rows,
+	}, nil
+}
+
+// proveNamespaceInRow returns the shares of the namespace in the extended row
+// and an NMT proof of them to the row root.
+func proveNamespaceInRow(row [][]byte, squareSize, rowIndex uint, rowRoot []byte, namespace share.Namespace) ([][]byte, *NMTProof, error) {
+	// create an nmt to generate a proof.
+	// we have to re-create the tree as the eds one is not accessible.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), rowIndex)
+	for _, sh := range row {
+		if err := tree.Push(sh); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// make sure that the generated root is the same as the eds row root.
+	root, err := tree.Root()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(rowRoot, root) {
+		return nil, nil, errors.New("eds row root is different than tree root")
+	}
+
+	proof, err := tree.ProveNamespace(namespace.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var shares [][]byte
+	if !proof.IsOfAbsence() {
+		shares = row[proof.Start():proof.End()]
+	}
+	return shares, &NMTProof{
+		Start:    int32(proof.Start()),
+		End:      int32(proof.End()),
+		Nodes:    proof.Nodes(),
+		LeafHash: proof.LeafHash(),
+	}, nil
+}
+
+// isNamespaceInRange returns true if the namespace is within the minimum and
+// maximum namespace of the NMT root.
+func isNamespaceInRange(root []byte, namespace share.Namespace) bool {
+	minNamespace := nmt.MinNamespace(root, share.NamespaceSize)
+	maxNamespace := nmt.MaxNamespace(root, share.NamespaceSize)
+	return bytes.Compare(namespace.Bytes(), minNamespace) >= 0 && bytes.Compare(namespace.Bytes(), maxNamespace) <= 0
+}
+
+// Validate checks that the proof contains every row of the original data square
+// and verifies it against the data root. It returns nil if the proof is valid,
+// in which case the shares of the proof are all shares of the namespace in the
+// square.
+func (np NamespaceProof) Validate(root []byte) error {
+	if len(np.Rows) == 0 {
+		return errors.New("empty namespace proof")
+	}
+	if np.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", np.NamespaceVersion)
+	}
+	namespace, err := share.NewNamespace(uint8(np.NamespaceVersion), np.NamespaceId)
+	if err != nil {
+		return err
+	}
+
+	for i, row := range np.Rows {
+		if row.RowProof == nil {
+			return fmt.Errorf("missing row proof for row %d", i)
+		}
+		// the data root commits to the row and column roots of the extended
+		// data square, which has twice as many rows as the original one.
+		if row.RowProof.Total != 4*int64(len(np.Rows)) {
+			return fmt.Errorf("the number of rows %d does not match the size of the data square %d", len(np.Rows), row.RowProof.Total/4)
+		}
+		if row.RowProof.Index != int64(i) {
+			return fmt.Errorf("row proof index %d must equal the row index %d", row.RowProof.Index, i)
+		}
+		if err := row.RowProof.Verify(root, row.RowRoot); err != nil {
+			return fmt.Errorf("row proof for row %d failed to verify: %w", i, err)
+		}
+		if err := row.verifyShares(namespace); err != nil {
+			return fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Shares returns the shares of the namespace in the order of the data square.
+func (np NamespaceProof) Shares() [][]byte {
+	var shares [][]byte
+	for _, row := range np.Rows {
+		shares = append(shares, row.Shares...)
+	}
+	return shares
+}
+
+// verifyShares verifies that the shares of the row are all shares of the
+// namespace in the row.
+func (rp NamespaceRowProof) verifyShares(namespace share.Namespace) error {
+	if !isNamespaceInRange(rp.RowRoot, namespace) {
+		if rp.ShareProof != nil || len(rp.Shares) != 0 {
+			return errors.New("namespace is outside the namespace range of the row but the row contains shares")
+		}
+		return nil
+	}
+
+	if rp.ShareProof == nil {
+		return errors.New("missing share proof")
+	}
+
+	var proof nmt.Proof
+	if rp.ShareProof.LeafHash != nil {
+		if len(rp.Shares) != 0 {
+			return errors.New("proof of absence must not contain shares")
+		}
+		proof = nmt.NewAbsenceProof(int(rp.ShareProof.Start), int(rp.ShareProof.End), rp.ShareProof.Nodes, rp.ShareProof.LeafHash, true)
+	} else {
+		proof = nmt.NewInclusionProof(int(rp.ShareProof.Start), int(rp.ShareProof.End), rp.ShareProof.Nodes, true)
+	}
+
+	// the leaves of the row's NMT are the shares prefixed with their namespace.
+	leaves := make([][]byte, len(rp.Shares))
+	for i, sh := range rp.Shares {
+		leaves[i] = make([]byte, 0, share.NamespaceSize+len(sh))
+		leaves[i] = append(leaves[i], namespace.Bytes()...)
+		leaves[i] = append(leaves[i], sh...)
+	}
+	if !proof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), leaves, rp.RowRoot) {
+		return errors.New("share proof failed to verify")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/da"
+	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v6/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	ns3 := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns1, ns3}, []int{20000, 500, 20000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	t.Run("returns all shares of the namespace", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns1)
+		require.NoError(t, err)
+		require.NoError(t, namespaceProof.Validate(dataRoot))
+		require.Len(t, namespaceProof.Rows, dataSquare.Size())
+
+		var expected [][]byte
+		for _, sh := range dataSquare {
+			if sh.Namespace().Equals(ns1) {
+				expected = append(expected, sh.ToBytes())
+			}
+		}
+		require.NotEmpty(t, expected)
+		require.Equal(t, expected, namespaceProof.Shares())
+	})
+
+	t.Run("proves the absence of a namespace within the range of a row", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns2)
+		require.NoError(t, err)
+		require.NoError(t, namespaceProof.Validate(dataRoot))
+		require.Empty(t, namespaceProof.Shares())
+
+		absenceProofs := 0
+		for _, row := range namespaceProof.Rows {
+			if row.ShareProof != nil {
+				require.NotNil(t, row.ShareProof.LeafHash)
+				absenceProofs++
+			}
+		}
+		require.Equal(t, 1, absenceProofs)
+	})
+
+	t.Run("rejects a proof with an omitted share", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns3)
+		require.NoError(t, err)
+		for _, row := range namespaceProof.Rows {
+			if len(row.Shares) > 0 {
+				row.Shares = row.Shares[:len(row.Shares)-1]
+				break
+			}
+		}
+		require.Error(t, namespaceProof.Validate(dataRoot))
+	})
+
+	t.Run("rejects a proof with an omitted row", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns3)
+		require.NoError(t, err)
+		namespaceProof.Rows = namespaceProof.Rows[:len(namespaceProof.Rows)-1]
+		require.Error(t, namespaceProof.Validate(dataRoot))
+	})
+
+	t.Run("rejects a proof that claims the namespace is absent", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns3)
+		require.NoError(t, err)
+		for _, row := range namespaceProof.Rows {
+			row.Shares = nil
+			row.ShareProof = nil
+		}
+		require.Error(t, namespaceProof.Validate(dataRoot))
+	})
+
+	t.Run("rejects a proof for a different data root", func(t *testing.T) {
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns1)
+		require.NoError(t, err)
+		require.Error(t, namespaceProof.Validate(bytes.Repeat([]byte{1}, 32)))
+	})
+
+	t.Run("blob shares are returned in order", func(t *testing.T) {
+		blobTx, _, err := blobtx.UnmarshalBlobTx(blobTxs[2])
+		require.NoError(t, err)
+		blobShares, err := blobTx.Blobs[0].ToShares()
+		require.NoError(t, err)
+
+		namespaceProof, err := proof.NewNamespaceProof(txs.ToSliceOfBytes(), ns3)
+		require.NoError(t, err)
+		require.Equal(t, share.ToBytes(blobShares), namespaceProof.Shares())
+	})
+}
@@ -10,12 +10,17 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/pkg/da"
 	"github.com/celestiaorg/celestia-app/v6/pkg/wrapper"
 	"github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/inclusion"
 	"github.com/celestiaorg/go-square/v3/share"
 	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	"github.com/celestiaorg/rsmt2d"
 	"github.com/cometbft/cometbft/crypto/merkle"
 )
 
+// ErrBlobNotFound is returned when a block doesn't contain a blob with the
+// requested namespace and share commitment.
+var ErrBlobNotFound = errors.New("blob not found")
+
 // NewTxInclusionProof returns a new share inclusion proof for the given
 // transaction index.
 func NewTxInclusionProof(txs [][]byte, txIndex, _ uint64) (ShareProof, error) {
@@ -46,6 +51,66 @@ func NewTxInclusionProof(txs [][]byte, txIndex, _ uint64) (ShareProof, error) {
 	return NewShareInclusionProof(dataSquare, namespace, shareRange)
 }
 
+// NewBlobInclusionProof returns a new share inclusion proof for the blob with
+// the given namespace and share commitment. The proof covers exactly the
+// shares of that blob.
+func NewBlobInclusionProof(txs [][]byte, namespace share.Namespace, commitment []byte) (ShareProof, error) {
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return ShareProof{}, err
+	}
+
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return ShareProof{}, err
+	}
+
+	shareRange, err := findBlobShareRange(builder, txs, namespace, commitment)
+	if err != nil {
+		return ShareProof{}, err
+	}
+
+	return NewShareInclusionProof(dataSquare, namespace, shareRange)
+}
+
+// findBlobShareRange returns the range of shares occupied by the blob with the
+// given namespace and share commitment using the blob indexes of the square
+// builder. The range is end exclusive.
+func findBlobShareRange(builder *square.Builder, txs [][]byte, namespace share.Namespace, commitment []byte) (share.Range, error) {
+	for txIndex, tx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(tx)
+		if err != nil || !isBlobTx {
+			continue
+		}
+
+		for blobIndex, blob := range blobTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+
+			blobCommitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return share.Range{}, err
+			}
+			if !bytes.Equal(blobCommitment, commitment) {
+				continue
+			}
+
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return share.Range{}, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return share.Range{}, err
+			}
+			return share.NewRange(start, start+length), nil
+		}
+	}
+
+	return share.Range{}, fmt.Errorf("%w: namespace %x commitment %x", ErrBlobNotFound, namespace.Bytes(), commitment)
+}
+
 func getTxNamespace(tx []byte) (ns share.Namespace) {
 	_, isBlobTx, _ := blobtx.UnmarshalBlobTx(tx)
 	if isBlobTx {
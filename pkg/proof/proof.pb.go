@@ -330,11 +330,154 @@ func (m *Proof) GetAunts() [][]byte {
 	return nil
 }
 
+// NamespaceProof proves the complete set of shares of a namespace in a data
+// square. It contains one entry per row of the original data square so that a
+// verifier can check that no share of the namespace was omitted.
+type NamespaceProof struct {
+	NamespaceId      []byte               `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32               `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	Rows             []*NamespaceRowProof `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *NamespaceProof) Reset()         { *m = NamespaceProof{} }
+func (m *NamespaceProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceProof) ProtoMessage()    {}
+func (*NamespaceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{4}
+}
+func (m *NamespaceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceProof.Merge(m, src)
+}
+func (m *NamespaceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceProof proto.InternalMessageInfo
+
+func (m *NamespaceProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *NamespaceProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *NamespaceProof) GetRows() []*NamespaceRowProof {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// NamespaceRowProof proves the shares of a namespace in a single row of the
+// original data square.
+type NamespaceRowProof struct {
+	// row_root is the NMT root of the extended row.
+	RowRoot []byte `protobuf:"bytes,1,opt,name=row_root,json=rowRoot,proto3" json:"row_root,omitempty"`
+	// row_proof is a Merkle proof that the row root exists in the Merkle tree
+	// with the data root. Its index is the index of the row.
+	RowProof *Proof `protobuf:"bytes,2,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+	// shares are the shares of the namespace in the row.
+	Shares [][]byte `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
+	// share_proof is an NMT proof of the shares to the row root. It is a proof
+	// of absence if the namespace range of the row brackets the namespace but
+	// the row contains no shares of it. It is unset if the namespace is outside
+	// the namespace range of the row.
+	ShareProof *NMTProof `protobuf:"bytes,4,opt,name=share_proof,json=shareProof,proto3" json:"share_proof,omitempty"`
+}
+
+func (m *NamespaceRowProof) Reset()         { *m = NamespaceRowProof{} }
+func (m *NamespaceRowProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceRowProof) ProtoMessage()    {}
+func (*NamespaceRowProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{5}
+}
+func (m *NamespaceRowProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceRowProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceRowProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceRowProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRowProof.Merge(m, src)
+}
+func (m *NamespaceRowProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceRowProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRowProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceRowProof proto.InternalMessageInfo
+
+func (m *NamespaceRowProof) GetRowRoot() []byte {
+	if m != nil {
+		return m.RowRoot
+	}
+	return nil
+}
+
+func (m *NamespaceRowProof) GetRowProof() *Proof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func (m *NamespaceRowProof) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *NamespaceRowProof) GetShareProof() *NMTProof {
+	if m != nil {
+		return m.ShareProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ShareProof)(nil), "celestia.core.v1.proof.ShareProof")
 	proto.RegisterType((*RowProof)(nil), "celestia.core.v1.proof.RowProof")
 	proto.RegisterType((*NMTProof)(nil), "celestia.core.v1.proof.NMTProof")
 	proto.RegisterType((*Proof)(nil), "celestia.core.v1.proof.Proof")
+	proto.RegisterType((*NamespaceProof)(nil), "celestia.core.v1.proof.NamespaceProof")
+	proto.RegisterType((*NamespaceRowProof)(nil), "celestia.core.v1.proof.NamespaceRowProof")
 }
 
 func init() {
@@ -342,35 +485,42 @@ func init() {
 }
 
 var fileDescriptor_e53d87d8fb5ec353 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0x37, 0x6d, 0x09, 0x6e, 0x56, 0x5a, 0x2c, 0x04, 0x96, 0x10, 0x51, 0xc8, 0x29, 0x12,
-	0xda, 0x44, 0x0b, 0xe2, 0xc8, 0x05, 0x0e, 0xc0, 0x01, 0x84, 0x0c, 0xe2, 0xc0, 0xa5, 0xf2, 0x36,
-	0x6e, 0x13, 0xd1, 0xcd, 0x44, 0xb6, 0xb7, 0xe1, 0x33, 0xf8, 0x0c, 0x3e, 0x85, 0x63, 0x8f, 0x1c,
-	0x51, 0xfb, 0x0b, 0x7c, 0x00, 0xf2, 0xb8, 0x09, 0xaa, 0x04, 0x7b, 0xb1, 0xe6, 0xbd, 0x99, 0x79,
-	0x6f, 0x66, 0x64, 0x9a, 0x2e, 0xd4, 0x5a, 0x19, 0x5b, 0xcb, 0x62, 0x01, 0x5a, 0x15, 0x9b, 0x8b,
-	0xa2, 0xd5, 0x00, 0x4b, 0xff, 0xe6, 0xad, 0x06, 0x0b, 0xec, 0x5e, 0x5f, 0x93, 0xbb, 0x9a, 0x7c,
-	0x73, 0x91, 0x63, 0x36, 0xfd, 0x4d, 0x28, 0xfd, 0x50, 0x49, 0xad, 0xde, 0x3b, 0xc8, 0x18, 0x1d,
-	0x97, 0xd2, 0x4a, 0x4e, 0x92, 0x20, 0x8b, 0x04, 0xc6, 0xec, 0x25, 0x8d, 0x8c, 0xab, 0x98, 0x63,
-	0x87, 0xe1, 0x27, 0x49, 0x90, 0xcd, 0x9e, 0x24, 0xf9, 0xbf, 0x15, 0xf3, 0x77, 0x6f, 0x3f, 0xa2,
-	0x96, 0x98, 0x99, 0x41, 0xd7, 0xb0, 0x47, 0x34, 0x6a, 0xe4, 0x95, 0x32, 0xad, 0x5c, 0xa8, 0x79,
-	0x5d, 0xf2, 0x20, 0x21, 0x59, 0x24, 0x66, 0x03, 0xf7, 0xa6, 0x64, 0xcf, 0xe9, 0x6d, 0x0d, 0x9d,
-	0x77, 0xe1, 0xe3, 0x84, 0xdc, 0x64, 0x22, 0xa0, 0xf3, 0x26, 0xa1, 0x3e, 0x44, 0xec, 0x31, 0xbd,
-	0xf3, 0xd7, 0x61, 0xa3, 0xb4, 0xa9, 0xa1, 0xe1, 0x93, 0x84, 0x64, 0xa7, 0xe2, 0x6c, 0x48, 0x7c,
-	0xf2, 0x7c, 0xfa, 0x9d, 0xd0, 0xb0, 0xd7, 0x60, 0x0f, 0xbc, 0xb1, 0x06, 0xb0, 0xe6, 0xb0, 0xb9,
-	0x93, 0x15, 0x0e, 0xb3, 0x67, 0x74, 0x7a, 0xb4, 0xf7, 0xc3, 0xff, 0x8d, 0xe4, 0xe7, 0x39, 0x14,
-	0xbb, 0x43, 0x3a, 0xbd, 0xc3, 0x9e, 0x18, 0x3b, 0x1f, 0x63, 0xa5, 0xb6, 0x73, 0x0d, 0x1d, 0x2e,
-	0x78, 0x2a, 0x42, 0x24, 0x04, 0x74, 0xec, 0x3e, 0xbd, 0xa5, 0x9a, 0x12, 0x53, 0x7e, 0xe8, 0xa9,
-	0x6a, 0x4a, 0x01, 0x5d, 0xaa, 0x68, 0xd8, 0x9f, 0x94, 0xdd, 0xa5, 0x13, 0x6c, 0xe0, 0x24, 0x21,
-	0xd9, 0x44, 0x78, 0xc0, 0xce, 0x68, 0xa0, 0x9a, 0x92, 0x9f, 0x20, 0xe7, 0x42, 0x57, 0xd7, 0x40,
-	0xa9, 0x0c, 0x0f, 0x70, 0x1b, 0x0f, 0x9c, 0xff, 0x5a, 0xc9, 0xe5, 0xbc, 0x92, 0xa6, 0x42, 0xff,
-	0x48, 0x84, 0x8e, 0x78, 0x2d, 0x4d, 0x95, 0x2e, 0xe9, 0x64, 0xf0, 0xb0, 0x60, 0xe5, 0x1a, 0x3d,
-	0x02, 0xe1, 0x81, 0x63, 0xeb, 0xa6, 0x54, 0x5f, 0xd1, 0x25, 0x10, 0x1e, 0x1c, 0x2b, 0x06, 0xc7,
-	0x8a, 0xae, 0x45, 0x5e, 0x37, 0xd6, 0xf0, 0xb1, 0x1f, 0x02, 0xc1, 0x8b, 0x57, 0x3f, 0x76, 0x31,
-	0xd9, 0xee, 0x62, 0xf2, 0x6b, 0x17, 0x93, 0x6f, 0xfb, 0x78, 0xb4, 0xdd, 0xc7, 0xa3, 0x9f, 0xfb,
-	0x78, 0xf4, 0xf9, 0x7c, 0x55, 0xdb, 0xea, 0xfa, 0x32, 0x5f, 0xc0, 0x55, 0xd1, 0xdf, 0x18, 0xf4,
-	0x6a, 0x88, 0xcf, 0x65, 0xdb, 0x16, 0xed, 0x97, 0x95, 0xff, 0xd7, 0x97, 0x53, 0xfc, 0xd8, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x27, 0x8a, 0x77, 0xfe, 0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xed, 0xc6, 0x49, 0x6a, 0x26, 0x29, 0x6a, 0x57, 0xa8, 0x18, 0x21, 0x2c, 0xe3, 0x93, 0x11,
+	0xaa, 0xad, 0x16, 0x71, 0x41, 0xea, 0x01, 0x38, 0x00, 0x07, 0x2a, 0xb4, 0x20, 0x0e, 0x5c, 0xa2,
+	0x6d, 0xbc, 0x89, 0x2d, 0x52, 0xaf, 0xb5, 0xbb, 0x8d, 0xf9, 0x0c, 0xfe, 0x80, 0x2b, 0x3f, 0x82,
+	0xc4, 0xb1, 0x47, 0x8e, 0x28, 0xf9, 0x05, 0x3e, 0x00, 0xed, 0xae, 0xed, 0x34, 0x34, 0xa5, 0x17,
+	0x6b, 0x66, 0x76, 0xe6, 0xbd, 0x99, 0xdd, 0x37, 0x86, 0x70, 0xcc, 0x66, 0x4c, 0xaa, 0x9c, 0x26,
+	0x63, 0x2e, 0x58, 0x32, 0x3f, 0x4c, 0x4a, 0xc1, 0xf9, 0xc4, 0x7e, 0xe3, 0x52, 0x70, 0xc5, 0xf1,
+	0x7e, 0x93, 0x13, 0xeb, 0x9c, 0x78, 0x7e, 0x18, 0x9b, 0xd3, 0xf0, 0x0f, 0x02, 0x78, 0x9f, 0x51,
+	0xc1, 0xde, 0x69, 0x17, 0x63, 0xe8, 0xa6, 0x54, 0x51, 0x0f, 0x05, 0x4e, 0x34, 0x24, 0xc6, 0xc6,
+	0x2f, 0x61, 0x28, 0x75, 0xc6, 0xc8, 0x54, 0x48, 0xaf, 0x13, 0x38, 0xd1, 0xe0, 0x28, 0x88, 0x37,
+	0x23, 0xc6, 0x27, 0x6f, 0x3f, 0x18, 0x2c, 0x32, 0x90, 0x2d, 0xae, 0xc4, 0x0f, 0x61, 0x58, 0xd0,
+	0x33, 0x26, 0x4b, 0x3a, 0x66, 0xa3, 0x3c, 0xf5, 0x9c, 0x00, 0x45, 0x43, 0x32, 0x68, 0x63, 0x6f,
+	0x52, 0x7c, 0x0c, 0xb7, 0x04, 0xaf, 0x2c, 0x8b, 0xd7, 0x0d, 0xd0, 0xff, 0x48, 0x08, 0xaf, 0x2c,
+	0x89, 0x2b, 0x6a, 0x0b, 0x3f, 0x86, 0xbd, 0x15, 0xc3, 0x9c, 0x09, 0x99, 0xf3, 0xc2, 0xeb, 0x05,
+	0x28, 0xda, 0x21, 0xbb, 0xed, 0xc1, 0x47, 0x1b, 0x0f, 0xbf, 0x23, 0x70, 0x1b, 0x0c, 0x7c, 0xdf,
+	0x12, 0x0b, 0xce, 0x95, 0xac, 0x27, 0xd7, 0xb0, 0x44, 0xfb, 0xf8, 0x29, 0xf4, 0xd7, 0xe6, 0x7e,
+	0x70, 0x5d, 0x4b, 0xb6, 0x9f, 0x3a, 0x59, 0x5f, 0xa4, 0xc6, 0xab, 0xe7, 0x34, 0xb6, 0xe6, 0x91,
+	0x8a, 0x0a, 0x35, 0x12, 0xbc, 0x32, 0x03, 0xee, 0x10, 0xd7, 0x04, 0x08, 0xaf, 0xf0, 0x5d, 0xd8,
+	0x66, 0x45, 0x6a, 0x8e, 0x6c, 0xd3, 0x7d, 0x56, 0xa4, 0x84, 0x57, 0x21, 0x03, 0xb7, 0xb9, 0x52,
+	0x7c, 0x07, 0x7a, 0xa6, 0xc0, 0x43, 0x01, 0x8a, 0x7a, 0xc4, 0x3a, 0x78, 0x17, 0x1c, 0x56, 0xa4,
+	0x5e, 0xc7, 0xc4, 0xb4, 0xa9, 0xf3, 0x0a, 0x9e, 0x32, 0xe9, 0x39, 0x66, 0x1a, 0xeb, 0x68, 0xfe,
+	0x19, 0xa3, 0x93, 0x51, 0x46, 0x65, 0x66, 0xf8, 0x87, 0xc4, 0xd5, 0x81, 0xd7, 0x54, 0x66, 0xe1,
+	0x04, 0x7a, 0x2d, 0x87, 0xe2, 0x8a, 0xce, 0x0c, 0x87, 0x43, 0xac, 0xa3, 0xa3, 0x79, 0x91, 0xb2,
+	0x2f, 0x86, 0xc5, 0x21, 0xd6, 0x59, 0x47, 0x74, 0xd6, 0x11, 0x75, 0x09, 0x3d, 0x2f, 0x94, 0xf4,
+	0xba, 0xb6, 0x09, 0xe3, 0x84, 0xdf, 0x10, 0xdc, 0x3e, 0x69, 0x9e, 0xc3, 0x32, 0xfe, 0xab, 0x0d,
+	0x74, 0x55, 0x1b, 0x1b, 0x1f, 0xb7, 0xb3, 0xf9, 0x71, 0xf1, 0xb1, 0xbe, 0xfb, 0xca, 0x0e, 0x3f,
+	0x38, 0x7a, 0x74, 0xad, 0x50, 0x9b, 0xba, 0x56, 0x4c, 0xa6, 0x2c, 0xfc, 0x81, 0x60, 0xef, 0xca,
+	0x19, 0xbe, 0x07, 0x6e, 0x23, 0x92, 0xba, 0xc1, 0xed, 0x5a, 0x23, 0xf8, 0xd9, 0x65, 0xe1, 0x76,
+	0x8c, 0x70, 0x6f, 0x50, 0xc9, 0x4a, 0xb5, 0xfb, 0xd0, 0x37, 0x6b, 0xd2, 0x3c, 0x55, 0xed, 0xe1,
+	0xe7, 0x30, 0xb8, 0xb4, 0x74, 0x37, 0xad, 0x43, 0xbb, 0x73, 0xb0, 0xda, 0xb9, 0x17, 0xaf, 0x7e,
+	0x2e, 0x7c, 0x74, 0xb1, 0xf0, 0xd1, 0xef, 0x85, 0x8f, 0xbe, 0x2e, 0xfd, 0xad, 0x8b, 0xa5, 0xbf,
+	0xf5, 0x6b, 0xe9, 0x6f, 0x7d, 0x3a, 0x98, 0xe6, 0x2a, 0x3b, 0x3f, 0x8d, 0xc7, 0xfc, 0x2c, 0x69,
+	0x10, 0xb9, 0x98, 0xb6, 0xf6, 0x01, 0x2d, 0xcb, 0xa4, 0xfc, 0x3c, 0xb5, 0x7f, 0x90, 0xd3, 0xbe,
+	0xf9, 0x85, 0x3c, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x63, 0xb7, 0xbf, 0xc9, 0x68, 0x04, 0x00,
+	0x00,
 }
 
 func (m *ShareProof) Marshal() (dAtA []byte, err error) {
@@ -604,6 +754,118 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceRowProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceRowProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceRowProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareProof != nil {
+		{
+			size, err := m.ShareProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RowProof != nil {
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RowRoot) > 0 {
+		i -= len(m.RowRoot)
+		copy(dAtA[i:], m.RowRoot)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.RowRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProof(v)
 	base := offset
@@ -728,6 +990,55 @@ func (m *Proof) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceRowProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RowRoot)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.ShareProof != nil {
+		l = m.ShareProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func sovProof(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +1746,331 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &NamespaceRowProof{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceRowProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceRowProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceRowProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowRoot = append(m.RowRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.RowRoot == nil {
+				m.RowRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &Proof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShareProof == nil {
+				m.ShareProof = &NMTProof{}
+			}
+			if err := m.ShareProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProof(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
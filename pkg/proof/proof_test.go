@@ -2,6 +2,7 @@ package proof_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"strings"
 	"testing"
 
@@ -13,8 +14,12 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
 	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/inclusion"
 	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -231,6 +236,79 @@ func TestNewShareInclusionProof(t *testing.T) {
 	}
 }
 
+func TestNewBlobInclusionProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{500, 5000, 100000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	for _, rawBlobTx := range blobTxs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawBlobTx)
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+
+		for _, blob := range blobTx.Blobs {
+			commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			require.NoError(t, err)
+
+			blobProof, err := proof.NewBlobInclusionProof(txs.ToSliceOfBytes(), blob.Namespace(), commitment)
+			require.NoError(t, err)
+			require.NoError(t, blobProof.Validate(dataRoot))
+
+			// the proof covers exactly the shares of the blob
+			blobShares, err := blob.ToShares()
+			require.NoError(t, err)
+			require.Equal(t, share.ToBytes(blobShares), blobProof.Data)
+		}
+	}
+
+	t.Run("custom query returns the blob inclusion proof", func(t *testing.T) {
+		blobTx, _, err := blobtx.UnmarshalBlobTx(blobTxs[1])
+		require.NoError(t, err)
+		blob := blobTx.Blobs[0]
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+
+		block := tmproto.Block{Data: tmproto.Data{Txs: txs.ToSliceOfBytes()}}
+		rawBlock, err := block.Marshal()
+		require.NoError(t, err)
+
+		path := []string{hex.EncodeToString(blob.Namespace().Bytes()), hex.EncodeToString(commitment)}
+		rawProof, err := proof.QueryBlobInclusionProof(sdk.Context{}, path, &abci.RequestQuery{Data: rawBlock})
+		require.NoError(t, err)
+
+		var blobProof proof.ShareProof
+		require.NoError(t, blobProof.Unmarshal(rawProof))
+		require.NoError(t, blobProof.Validate(dataRoot))
+	})
+
+	t.Run("unknown commitment returns error", func(t *testing.T) {
+		_, err := proof.NewBlobInclusionProof(txs.ToSliceOfBytes(), ns1, bytes.Repeat([]byte{1}, 32))
+		require.ErrorIs(t, err, proof.ErrBlobNotFound)
+	})
+
+	t.Run("namespace that does not match the commitment returns error", func(t *testing.T) {
+		blobTx, _, err := blobtx.UnmarshalBlobTx(blobTxs[0])
+		require.NoError(t, err)
+		commitment, err := inclusion.CreateCommitment(blobTx.Blobs[0], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		_, err = proof.NewBlobInclusionProof(txs.ToSliceOfBytes(), ns2, commitment)
+		require.ErrorIs(t, err, proof.ErrBlobNotFound)
+	})
+}
+
 // TestAllSharesInclusionProof creates a proof for all shares in the data
 // square. Since we can't prove multiple namespaces at the moment, all the
 // shares use the same namespace.
@@ -2,6 +2,7 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -126,6 +127,94 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req *abci.RequestQue
 	return rawShareProof, nil
 }
 
+const BlobInclusionQueryPath = "blobInclusionProof"
+
+// QueryBlobInclusionProof defines the logic performed when querying for the
+// inclusion proof of a blob to the data root. The hex encoded namespace and
+// share commitment of the blob should be appended to the path. The proof
+// covers exactly the shares of the blob. Example path:
+// custom/blobInclusionProof/<namespace>/<commitment>
+func QueryBlobInclusionProof(_ sdk.Context, path []string, req *abci.RequestQuery) ([]byte, error) {
+	// parse the namespace and commitment from the path
+	if len(path) != 2 {
+		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace: %w", err)
+	}
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := hex.DecodeString(path[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid commitment: %w", err)
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// create and marshal the blob inclusion proof, which we return in the form of []byte
+	shareProof, err := NewBlobInclusionProof(pbb.Data.Txs, namespace, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	rawShareProof, err := shareProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawShareProof, nil
+}
+
+const NamespaceDataQueryPath = "namespaceData"
+
+// QueryNamespaceData defines the logic performed when querying for all shares
+// of a namespace together with a proof that none of them were omitted. The hex
+// encoded namespace should be appended to the path. The marshalled bytes of
+// the namespace proof (NamespaceProof) are returned. Example path:
+// custom/namespaceData/<namespace>
+func QueryNamespaceData(_ sdk.Context, path []string, req *abci.RequestQuery) ([]byte, error) {
+	// parse the namespace from the path
+	if len(path) != 1 {
+		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace: %w", err)
+	}
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// create and marshal the namespace proof, which we return in the form of []byte
+	namespaceProof, err := NewNamespaceProof(pbb.Data.Txs, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rawNamespaceProof, err := namespaceProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawNamespaceProof, nil
+}
+
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
 // that namespace ID.
 // The provided range, defined by startShare and endShare, is end-exclusive.
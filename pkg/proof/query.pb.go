@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proof/query.proto
+
+package proof
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlobInclusionProofRequest is the request type for the
+// BlobInclusionProof gRPC method.
+type QueryBlobInclusionProofRequest struct {
+	// height is the height of the block that contains the blob.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace (version and id) of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *QueryBlobInclusionProofRequest) Reset()         { *m = QueryBlobInclusionProofRequest{} }
+func (m *QueryBlobInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobInclusionProofRequest) ProtoMessage()    {}
+func (*QueryBlobInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{0}
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryBlobInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlobInclusionProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobInclusionProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobInclusionProofRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// QueryBlobInclusionProofResponse is the response type for the
+// BlobInclusionProof gRPC method.
+type QueryBlobInclusionProofResponse struct {
+	// proof is the inclusion proof of the blob's shares to the data root.
+	Proof *ShareProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBlobInclusionProofResponse) Reset()         { *m = QueryBlobInclusionProofResponse{} }
+func (m *QueryBlobInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobInclusionProofResponse) ProtoMessage()    {}
+func (*QueryBlobInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{1}
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryBlobInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlobInclusionProofResponse) GetProof() *ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryNamespaceDataRequest is the request type for the NamespaceData gRPC
+// method.
+type QueryNamespaceDataRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace (version and id) to query.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceDataRequest) Reset()         { *m = QueryNamespaceDataRequest{} }
+func (m *QueryNamespaceDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceDataRequest) ProtoMessage()    {}
+func (*QueryNamespaceDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{2}
+}
+func (m *QueryNamespaceDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceDataRequest.Merge(m, src)
+}
+func (m *QueryNamespaceDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceDataRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceDataRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceDataRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceDataResponse is the response type for the NamespaceData gRPC
+// method.
+type QueryNamespaceDataResponse struct {
+	// proof contains the shares of the namespace and the proof of their
+	// completeness to the data root.
+	Proof *NamespaceProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryNamespaceDataResponse) Reset()         { *m = QueryNamespaceDataResponse{} }
+func (m *QueryNamespaceDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceDataResponse) ProtoMessage()    {}
+func (*QueryNamespaceDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{3}
+}
+func (m *QueryNamespaceDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceDataResponse.Merge(m, src)
+}
+func (m *QueryNamespaceDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceDataResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceDataResponse) GetProof() *NamespaceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBlobInclusionProofRequest)(nil), "celestia.core.v1.proof.QueryBlobInclusionProofRequest")
+	proto.RegisterType((*QueryBlobInclusionProofResponse)(nil), "celestia.core.v1.proof.QueryBlobInclusionProofResponse")
+	proto.RegisterType((*QueryNamespaceDataRequest)(nil), "celestia.core.v1.proof.QueryNamespaceDataRequest")
+	proto.RegisterType((*QueryNamespaceDataResponse)(nil), "celestia.core.v1.proof.QueryNamespaceDataResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proof/query.proto", fileDescriptor_0e626addf1ae410d)
+}
+
+var fileDescriptor_0e626addf1ae410d = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x2d, 0x2d, 0x38, 0xea, 0x65, 0x0e, 0xa5, 0x86, 0x32, 0x96, 0x80, 0x5a, 0xc4,
+	0x66, 0x68, 0x04, 0xf5, 0xe0, 0xa9, 0x08, 0xe2, 0x45, 0x6c, 0xbd, 0xd5, 0xd3, 0x24, 0x8c, 0x49,
+	0x30, 0x99, 0x37, 0x4d, 0x26, 0x05, 0x11, 0x2f, 0x7e, 0x02, 0xc1, 0x6f, 0xe1, 0xd9, 0x0f, 0xe1,
+	0xb1, 0xb0, 0x97, 0x3d, 0x2e, 0xed, 0xde, 0xf6, 0x4b, 0x2c, 0x9d, 0xb4, 0xd9, 0x96, 0x6d, 0x0a,
+	0x65, 0x2f, 0x21, 0x99, 0xfc, 0xff, 0xff, 0xf7, 0x7b, 0xef, 0x25, 0xd8, 0xf6, 0x45, 0x2c, 0x32,
+	0x1d, 0x71, 0xe6, 0x43, 0x2a, 0xd8, 0x7c, 0xc8, 0x54, 0x0a, 0xf0, 0x95, 0xcd, 0x72, 0x91, 0x7e,
+	0x77, 0x54, 0x0a, 0x1a, 0x48, 0x7b, 0xab, 0x71, 0xd6, 0x1a, 0x67, 0x3e, 0x74, 0x8c, 0xc6, 0xea,
+	0x06, 0x00, 0x41, 0x2c, 0x18, 0x57, 0x11, 0xe3, 0x52, 0x82, 0xe6, 0x3a, 0x02, 0x99, 0x15, 0x2e,
+	0xab, 0x2a, 0xd9, 0x5c, 0x0b, 0x8d, 0x3d, 0xc7, 0x74, 0xbc, 0x2e, 0x34, 0x8a, 0xc1, 0xfb, 0x20,
+	0xfd, 0x38, 0xcf, 0x22, 0x90, 0x9f, 0xd6, 0x82, 0x89, 0x98, 0xe5, 0x22, 0xd3, 0xa4, 0x8d, 0x5b,
+	0xa1, 0x88, 0x82, 0x50, 0x77, 0x50, 0x0f, 0xf5, 0x1b, 0x93, 0xcd, 0x13, 0xe9, 0xe2, 0x7b, 0x92,
+	0x27, 0x22, 0x53, 0xdc, 0x17, 0x9d, 0x7a, 0x0f, 0xf5, 0x1f, 0x4c, 0x6e, 0x0e, 0x08, 0xc5, 0xd8,
+	0x87, 0x24, 0x89, 0x74, 0x22, 0xa4, 0xee, 0x34, 0xcc, 0xeb, 0x9d, 0x13, 0xfb, 0x0b, 0x7e, 0x5c,
+	0x59, 0x37, 0x53, 0x20, 0x33, 0x41, 0xde, 0xe0, 0xa6, 0x21, 0x35, 0x75, 0xef, 0xbb, 0xb6, 0x73,
+	0x78, 0x08, 0xce, 0xe7, 0x90, 0xa7, 0xa2, 0xb0, 0x16, 0x06, 0x7b, 0x8c, 0x1f, 0x99, 0xf0, 0x8f,
+	0x5b, 0x9c, 0x77, 0x5c, 0xf3, 0x3b, 0xf5, 0x63, 0x4f, 0xb1, 0x75, 0x28, 0x72, 0x83, 0xfa, 0x76,
+	0x1f, 0xf5, 0x69, 0x15, 0x6a, 0xe9, 0xde, 0xc5, 0x75, 0xaf, 0xea, 0xb8, 0x69, 0xc2, 0xc9, 0x3f,
+	0x84, 0xc9, 0xed, 0x89, 0x90, 0x57, 0x55, 0x79, 0xc7, 0x57, 0x67, 0xbd, 0x3e, 0xd9, 0x57, 0xf4,
+	0x63, 0x0f, 0x7e, 0x9d, 0x5d, 0xfe, 0xa9, 0x3f, 0x23, 0x4f, 0x58, 0xc5, 0x27, 0xe4, 0xc5, 0xe0,
+	0xb1, 0x1f, 0xc5, 0xe4, 0x7e, 0x92, 0xbf, 0x08, 0x3f, 0xdc, 0x1b, 0x0c, 0x19, 0x1e, 0xad, 0x7c,
+	0x68, 0x2f, 0x96, 0x7b, 0x8a, 0x65, 0xc3, 0xe9, 0x1a, 0xce, 0x17, 0xe4, 0x79, 0x15, 0x67, 0xb9,
+	0xc0, 0x12, 0x76, 0xf4, 0xfe, 0xff, 0x92, 0xa2, 0xc5, 0x92, 0xa2, 0x8b, 0x25, 0x45, 0xbf, 0x57,
+	0xb4, 0xb6, 0x58, 0xd1, 0xda, 0xf9, 0x8a, 0xd6, 0xa6, 0x83, 0x20, 0xd2, 0x61, 0xee, 0x39, 0x3e,
+	0x24, 0x65, 0x1e, 0xa4, 0x41, 0x79, 0x3f, 0xe0, 0x4a, 0x31, 0xf5, 0x2d, 0x28, 0xb2, 0xbd, 0x96,
+	0xf9, 0x83, 0x5e, 0x5e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xd1, 0x4f, 0xc2, 0xc1, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobInclusionProof returns a proof that the blob with the given namespace
+	// and share commitment was included in the block at the given height. The
+	// proof covers exactly the shares of the blob and contains the row proofs
+	// to the data root.
+	BlobInclusionProof(ctx context.Context, in *QueryBlobInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlobInclusionProofResponse, error)
+	// NamespaceData returns all shares of the given namespace in the block at
+	// the given height together with a proof that no share of the namespace
+	// was omitted. Rows whose namespace range brackets the namespace but that
+	// contain none of its shares are covered by proofs of absence.
+	NamespaceData(ctx context.Context, in *QueryNamespaceDataRequest, opts ...grpc.CallOption) (*QueryNamespaceDataResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobInclusionProof(ctx context.Context, in *QueryBlobInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlobInclusionProofResponse, error) {
+	out := new(QueryBlobInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/BlobInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceData(ctx context.Context, in *QueryNamespaceDataRequest, opts ...grpc.CallOption) (*QueryNamespaceDataResponse, error) {
+	out := new(QueryNamespaceDataResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/NamespaceData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobInclusionProof returns a proof that the blob with the given namespace
+	// and share commitment was included in the block at the given height. The
+	// proof covers exactly the shares of the blob and contains the row proofs
+	// to the data root.
+	BlobInclusionProof(context.Context, *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error)
+	// NamespaceData returns all shares of the given namespace in the block at
+	// the given height together with a proof that no share of the namespace
+	// was omitted. Rows whose namespace range brackets the namespace but that
+	// contain none of its shares are covered by proofs of absence.
+	NamespaceData(context.Context, *QueryNamespaceDataRequest) (*QueryNamespaceDataResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobInclusionProof(ctx context.Context, req *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) NamespaceData(ctx context.Context, req *QueryNamespaceDataRequest) (*QueryNamespaceDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceData not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/BlobInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobInclusionProof(ctx, req.(*QueryBlobInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/NamespaceData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceData(ctx, req.(*QueryNamespaceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.proof.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobInclusionProof",
+			Handler:    _Query_BlobInclusionProof_Handler,
+		},
+		{
+			MethodName: "NamespaceData",
+			Handler:    _Query_NamespaceData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/proof/query.proto",
+}
+
+func (m *QueryBlobInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlobInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlobInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &NamespaceProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,124 @@
+package proof
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterQueryService registers the proof query service on the gRPC router.
+func RegisterQueryService(qrt gogogrpc.Server, clientCtx client.Context) {
+	RegisterQueryServer(qrt, NewQueryServer(clientCtx))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+}
+
+func NewQueryServer(clientCtx client.Context) QueryServer {
+	return &queryServer{clientCtx: clientCtx}
+}
+
+// BlobInclusionProof implements the QueryServer.BlobInclusionProof method. It
+// fetches the block at the requested height from the underlying celestia-core
+// RPC server and proves the shares of the blob with the requested namespace
+// and share commitment to the data root of that block.
+func (s *queryServer) BlobInclusionProof(ctx context.Context, req *QueryBlobInclusionProofRequest) (*QueryBlobInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be positive: %d", req.Height)
+	}
+
+	if len(req.Commitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commitment cannot be empty")
+	}
+
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := node.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	shareProof, err := NewBlobInclusionProof(resBlock.Block.Data.Txs.ToSliceOfBytes(), namespace, req.Commitment)
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shareProof.Validate(resBlock.Block.DataHash); err != nil {
+		return nil, status.Errorf(codes.Internal, "proof does not match the data root of block %d: %s", req.Height, err)
+	}
+
+	return &QueryBlobInclusionProofResponse{Proof: &shareProof}, nil
+}
+
+// NamespaceData implements the QueryServer.NamespaceData method. It fetches the
+// block at the requested height from the underlying celestia-core RPC server
+// and proves all shares of the requested namespace to the data root of that
+// block.
+func (s *queryServer) NamespaceData(ctx context.Context, req *QueryNamespaceDataRequest) (*QueryNamespaceDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be positive: %d", req.Height)
+	}
+
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := node.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceProof, err := NewNamespaceProof(resBlock.Block.Data.Txs.ToSliceOfBytes(), namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := namespaceProof.Validate(resBlock.Block.DataHash); err != nil {
+		return nil, status.Errorf(codes.Internal, "proof does not match the data root of block %d: %s", req.Height, err)
+	}
+
+	return &QueryNamespaceDataResponse{Proof: &namespaceProof}, nil
+}
@@ -0,0 +1,130 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
+	"github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BlockClient fetches blocks from a consensus node. It is implemented by the
+// CometBFT RPC clients.
+type BlockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+// BlobClient retrieves blobs from the blocks of a consensus node. The data
+// square of a block is rebuilt from its transactions and every retrieved blob
+// is verified against the data root of the block, so the node only needs to
+// be trusted for the block header.
+type BlobClient struct {
+	node BlockClient
+}
+
+// NewBlobClient returns a BlobClient that fetches blocks from the node.
+func NewBlobClient(node BlockClient) *BlobClient {
+	return &BlobClient{node: node}
+}
+
+// Blobs returns all blobs of the namespace in the block at the given height in
+// the order of the data square. The namespace proof covers every row of the
+// square, so no blob of the namespace can be omitted.
+func (c *BlobClient) Blobs(ctx context.Context, height int64, namespace share.Namespace) ([]*share.Blob, error) {
+	block, err := c.block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceProof, err := proof.NewNamespaceProof(block.Data.Txs.ToSliceOfBytes(), namespace)
+	if err != nil {
+		return nil, fmt.Errorf("proving namespace %x at height %d: %w", namespace.Bytes(), height, err)
+	}
+	if err := namespaceProof.Validate(block.DataHash); err != nil {
+		return nil, fmt.Errorf("verifying namespace %x at height %d: %w", namespace.Bytes(), height, err)
+	}
+
+	return parseBlobs(namespaceProof.Shares())
+}
+
+// Blob returns the blob with the namespace and share commitment in the block at
+// the given height. It returns an error wrapping proof.ErrBlobNotFound if the
+// block doesn't contain the blob.
+func (c *BlobClient) Blob(ctx context.Context, height int64, namespace share.Namespace, commitment []byte) (*share.Blob, error) {
+	block, err := c.block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	shareProof, err := proof.NewBlobInclusionProof(block.Data.Txs.ToSliceOfBytes(), namespace, commitment)
+	if err != nil {
+		return nil, fmt.Errorf("proving blob at height %d: %w", height, err)
+	}
+	if err := shareProof.Validate(block.DataHash); err != nil {
+		return nil, fmt.Errorf("verifying blob at height %d: %w", height, err)
+	}
+
+	blobs, err := parseBlobs(shareProof.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) != 1 {
+		return nil, fmt.Errorf("expected the proven shares to contain one blob, got %d", len(blobs))
+	}
+	blobCommitment, err := inclusion.CreateCommitment(blobs[0], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(blobCommitment, commitment) {
+		return nil, fmt.Errorf("commitment of the proven blob %x does not match %x", blobCommitment, commitment)
+	}
+	return blobs[0], nil
+}
+
+// Payload retrieves the blobs of a payload submitted with SubmitPayload and
+// returns the reassembled payload.
+func (c *BlobClient) Payload(ctx context.Context, manifest *PayloadManifest) ([]byte, error) {
+	payload := make([]byte, 0, manifest.Size)
+	for i, chunk := range manifest.Chunks {
+		blob, err := c.Blob(ctx, chunk.Height, manifest.Namespace, chunk.Commitment)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving chunk %d: %w", i, err)
+		}
+		if blob.DataLen() != chunk.Size {
+			return nil, fmt.Errorf("chunk %d has %d bytes, expected %d", i, blob.DataLen(), chunk.Size)
+		}
+		payload = append(payload, blob.Data()...)
+	}
+	if len(payload) != manifest.Size {
+		return nil, fmt.Errorf("payload has %d bytes, expected %d", len(payload), manifest.Size)
+	}
+	return payload, nil
+}
+
+func (c *BlobClient) block(ctx context.Context, height int64) (*types.Block, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("height must be positive: %d", height)
+	}
+	resp, err := c.node.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching block %d: %w", height, err)
+	}
+	if resp.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return resp.Block, nil
+}
+
+func parseBlobs(rawShares [][]byte) ([]*share.Blob, error) {
+	shares, err := share.FromBytes(rawShares)
+	if err != nil {
+		return nil, err
+	}
+	return share.ParseBlobs(shares)
+}
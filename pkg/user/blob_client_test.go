@@ -0,0 +1,115 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/da"
+	"github.com/celestiaorg/celestia-app/v6/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBlockClient serves a single block at every height.
+type mockBlockClient struct {
+	block *cmttypes.Block
+}
+
+func (m *mockBlockClient) Block(context.Context, *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: m.block}, nil
+}
+
+func TestBlobClient(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+
+	namespace := share.RandomBlobNamespace()
+	newBlob := func(namespace share.Namespace, size int) *share.Blob {
+		blob, err := share.NewV0Blob(namespace, random.Bytes(size))
+		require.NoError(t, err)
+		return blob
+	}
+	payloadBlobs, err := user.SplitPayload(namespace, random.Bytes(5000), 2000)
+	require.NoError(t, err)
+	otherBlob := newBlob(share.RandomBlobNamespace(), 1000)
+
+	var txs [][]byte
+	for _, blobs := range [][]*share.Blob{payloadBlobs[:2], {otherBlob, payloadBlobs[2]}} {
+		blobTx, _, err := signer.CreatePayForBlobs("account", blobs)
+		require.NoError(t, err)
+		txs = append(txs, blobTx)
+	}
+	eds, err := da.ConstructEDS(txs, appconsts.Version, appconsts.SquareSizeUpperBound)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{DataHash: dah.Hash()},
+		Data:   cmttypes.Data{Txs: cmttypes.ToTxs(txs)},
+	}
+	client := user.NewBlobClient(&mockBlockClient{block: block})
+	ctx := context.Background()
+
+	manifest := &user.PayloadManifest{Namespace: namespace}
+	for _, blob := range payloadBlobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		manifest.Size += blob.DataLen()
+		manifest.Chunks = append(manifest.Chunks, user.PayloadChunk{Height: 1, Commitment: commitment, Size: blob.DataLen()})
+	}
+
+	t.Run("returns all blobs of the namespace", func(t *testing.T) {
+		blobs, err := client.Blobs(ctx, 1, namespace)
+		require.NoError(t, err)
+		require.ElementsMatch(t, payloadBlobs, blobs)
+
+		blobs, err = client.Blobs(ctx, 1, share.RandomBlobNamespace())
+		require.NoError(t, err)
+		require.Empty(t, blobs)
+	})
+
+	t.Run("returns the blob with the commitment", func(t *testing.T) {
+		blob, err := client.Blob(ctx, 1, namespace, manifest.Chunks[1].Commitment)
+		require.NoError(t, err)
+		require.Equal(t, payloadBlobs[1], blob)
+
+		_, err = client.Blob(ctx, 1, namespace, random.Bytes(32))
+		require.True(t, errors.Is(err, proof.ErrBlobNotFound))
+	})
+
+	t.Run("reassembles a payload", func(t *testing.T) {
+		payload, err := client.Payload(ctx, manifest)
+		require.NoError(t, err)
+		var expected []byte
+		for _, blob := range payloadBlobs {
+			expected = append(expected, blob.Data()...)
+		}
+		require.Equal(t, expected, payload)
+	})
+
+	t.Run("rejects blobs that don't match the data root", func(t *testing.T) {
+		badBlock := &cmttypes.Block{
+			Header: cmttypes.Header{DataHash: random.Bytes(32)},
+			Data:   block.Data,
+		}
+		badClient := user.NewBlobClient(&mockBlockClient{block: badBlock})
+
+		_, err := badClient.Blobs(ctx, 1, namespace)
+		require.Error(t, err)
+		_, err = badClient.Blob(ctx, 1, namespace, manifest.Chunks[0].Commitment)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,207 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/cometbft/cometbft/rpc/core"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// errFeeBumpLimitReached is returned when a transaction can't be re-priced
+// because its fee already reached the max fee of the fee bump policy.
+var errFeeBumpLimitReached = errors.New("fee bump limit reached")
+
+// FeeBumpPolicy defines when and how ConfirmTx re-prices a transaction that
+// stays pending in the mempool or gets evicted from it, which usually means it
+// was outbid. The transaction is replaced by a transaction with the same
+// sequence and a higher gas price. A node only accepts the replacement of a
+// pending transaction once the original transaction left its mempool, so a
+// rejected replacement is retried after another PendingBlocks blocks while the
+// original transaction keeps being confirmed.
+type FeeBumpPolicy struct {
+	// PendingBlocks is the number of blocks a transaction can be pending
+	// before it is replaced.
+	PendingBlocks uint64
+	// GasPriceMultiplier is the minimum factor by which the gas price of the
+	// replacement is increased. The gas price estimated by the node for the
+	// next block is used instead if it's higher. Must be greater than 1.
+	GasPriceMultiplier float64
+	// MaxFee is the maximum fee in utia that a replacement can pay.
+	MaxFee uint64
+}
+
+// Validate returns an error if the fee bump policy is invalid.
+func (p FeeBumpPolicy) Validate() error {
+	if p.PendingBlocks == 0 {
+		return errors.New("fee bump pending blocks must be positive")
+	}
+	if p.GasPriceMultiplier <= 1 {
+		return fmt.Errorf("fee bump gas price multiplier must be greater than 1: %f", p.GasPriceMultiplier)
+	}
+	if p.MaxFee == 0 {
+		return errors.New("fee bump max fee must be positive")
+	}
+	return nil
+}
+
+// pendingTx tracks how long a transaction confirmed by ConfirmTx has been
+// pending. The zero value is a transaction that wasn't seen pending yet.
+type pendingTx struct {
+	// since is the height at which the transaction was first seen pending.
+	since uint64
+	// nextHeightCheck is the earliest time at which the node is queried for
+	// its height again. It avoids querying the height on every poll when the
+	// pending blocks can't have been produced yet.
+	nextHeightCheck time.Time
+}
+
+// maybeBumpFee replaces the transaction with a higher gas price once it has
+// been pending for the number of blocks of the fee bump policy. The pending
+// state is reset on every attempt. It returns the hash of the replacement if
+// the transaction was replaced. Failing to replace the transaction is not
+// fatal, as the original transaction may still be committed.
+func (client *TxClient) maybeBumpFee(ctx context.Context, txHash string, pending *pendingTx) (string, bool) {
+	span := trace.SpanFromContext(ctx)
+
+	now := time.Now()
+	if now.Before(pending.nextHeightCheck) {
+		return "", false
+	}
+	status, err := nodeservice.NewServiceClient(client.conns[0]).Status(ctx, &nodeservice.StatusRequest{})
+	if err != nil {
+		span.RecordError(fmt.Errorf("txclient/maybeBumpFee: querying height: %w", err))
+		return "", false
+	}
+	if pending.since == 0 {
+		*pending = pendingTx{since: status.Height}
+	}
+	if target := pending.since + client.feeBumpPolicy.PendingBlocks; status.Height < target {
+		pending.nextHeightCheck = now.Add(time.Duration(target-status.Height) * appconsts.DelayedPrecommitTimeout)
+		return "", false
+	}
+	*pending = pendingTx{}
+
+	newTxHash, err := client.bumpFee(ctx, txHash)
+	if err != nil {
+		span.RecordError(fmt.Errorf("txclient/maybeBumpFee: replacing tx %s: %w", txHash, err))
+		return "", false
+	}
+	return newTxHash, true
+}
+
+// bumpFee re-signs the tracked transaction with the same sequence and a higher
+// gas price, broadcasts it and tracks the replacement in the txTracker. The
+// replaced transaction is kept in the txTracker as it may still be committed.
+func (client *TxClient) bumpFee(ctx context.Context, txHash string) (string, error) {
+	client.mtx.Lock()
+	info, exists := client.txTracker[txHash]
+	client.mtx.Unlock()
+	if !exists {
+		return "", fmt.Errorf("tx: %s not found in txTracker", txHash)
+	}
+
+	newTxBytes, err := client.replaceTransactionFee(ctx, info)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = client.journalTx(info.signer, info.sequence, newTxBytes, core.TxStatusPending)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.sendTxToConnection(ctx, client.conns[0], newTxBytes)
+	if err != nil {
+		_, rejected := err.(*BroadcastTxError)
+		client.journalFailedBroadcast(info.signer, info.sequence, newTxBytes, rejected)
+		return "", err
+	}
+
+	client.mtx.Lock()
+	client.txTracker[resp.TxHash] = txInfo{
+		sequence:  info.sequence,
+		signer:    info.signer,
+		timestamp: time.Now(),
+		txBytes:   newTxBytes,
+	}
+	client.mtx.Unlock()
+
+	trace.SpanFromContext(ctx).AddEvent("txclient/bumpFee: transaction replaced with a higher fee", trace.WithAttributes(
+		attribute.String("tx_hash", txHash),
+		attribute.String("replacement_tx_hash", resp.TxHash),
+	))
+	return resp.TxHash, nil
+}
+
+// replaceTransactionFee returns the transaction re-signed with its original
+// sequence and the fee of the replacement gas price, capped at the max fee of
+// the fee bump policy.
+func (client *TxClient) replaceTransactionFee(ctx context.Context, info txInfo) ([]byte, error) {
+	client.mtx.Lock()
+	txBuilder, blobTx, err := client.rebuildTransaction(info.txBytes)
+	client.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := txBuilder.GetTx().GetGas()
+	if gasLimit == 0 {
+		return nil, errors.New("transaction has no gas limit")
+	}
+	fee := txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		return nil, fmt.Errorf("transaction fee %s is out of range", fee)
+	}
+
+	gasPrice := float64(fee.Uint64()) / float64(gasLimit) * client.feeBumpPolicy.GasPriceMultiplier
+	estimate, err := client.gasEstimationClient.EstimateGasPriceForInclusion(ctx, &gasestimation.EstimateGasPriceForInclusionRequest{
+		TargetBlocks: 1,
+		TxSize:       uint64(len(info.txBytes)),
+	})
+	if err != nil {
+		trace.SpanFromContext(ctx).RecordError(fmt.Errorf("txclient/replaceTransactionFee: estimating gas price: %w", err))
+	} else {
+		gasPrice = math.Max(gasPrice, estimate.EstimatedGasPrice)
+	}
+
+	newFee := min(uint64(math.Ceil(gasPrice*float64(gasLimit))), client.feeBumpPolicy.MaxFee)
+	if newFee <= fee.Uint64() {
+		return nil, errFeeBumpLimitReached
+	}
+	txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(newFee))))
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if _, err := client.signer.signTransactionWithSequence(txBuilder, info.sequence); err != nil {
+		return nil, fmt.Errorf("resigning transaction: %w", err)
+	}
+	return client.encodeRebuiltTransaction(txBuilder, blobTx)
+}
+
+// committedReplacedTx returns the hash and status of the first of the replaced
+// transactions that was committed, if any. A replaced transaction can still be
+// committed if its replacement was rejected or evicted.
+func (client *TxClient) committedReplacedTx(ctx context.Context, replacedTxHashes []string) (string, *tx.TxStatusResponse) {
+	txClient := tx.NewTxClient(client.conns[0])
+	for _, txHash := range replacedTxHashes {
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		if err != nil {
+			continue
+		}
+		if resp.Status == core.TxStatusCommitted {
+			return txHash, resp
+		}
+	}
+	return "", nil
+}
@@ -2,15 +2,25 @@ package user_test
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/rpc/core"
+	cmttypes "github.com/cometbft/cometbft/types"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -43,6 +53,51 @@ func (m *mockTxServer) BroadcastTx(ctx context.Context, req *sdktx.BroadcastTxRe
 	return m.broadcastHandler(ctx, req)
 }
 
+// mockBatchTxServer extends mockTxServer with support for TxStatusBatch.
+type mockBatchTxServer struct {
+	*mockTxServer
+	mtx                sync.Mutex
+	txStatusCallCount  int
+	batchCallCount     int
+	batchedTxIDsCounts []int
+	// omittedTxIDs are left out of TxStatusBatch responses to simulate a
+	// partial response.
+	omittedTxIDs map[string]bool
+	// batchErr is returned by every TxStatusBatch call if set.
+	batchErr error
+}
+
+func (m *mockBatchTxServer) TxStatus(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.txStatusCallCount++
+	return m.txStatusHandler(ctx, req)
+}
+
+func (m *mockBatchTxServer) TxStatusBatch(ctx context.Context, req *tx.TxStatusBatchRequest) (*tx.TxStatusBatchResponse, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.batchCallCount++
+	m.batchedTxIDsCounts = append(m.batchedTxIDsCounts, len(req.TxIds))
+	if m.batchErr != nil {
+		return nil, m.batchErr
+	}
+
+	statuses := make([]*tx.TxStatusResult, 0, len(req.TxIds))
+	for _, txID := range req.TxIds {
+		if m.omittedTxIDs[txID] {
+			continue
+		}
+		resp, err := m.txStatusHandler(ctx, &tx.TxStatusRequest{TxId: txID})
+		if err != nil {
+			statuses = append(statuses, &tx.TxStatusResult{TxId: txID, QueryError: err.Error()})
+			continue
+		}
+		statuses = append(statuses, &tx.TxStatusResult{TxId: txID, Status: resp})
+	}
+	return &tx.TxStatusBatchResponse{Statuses: statuses}, nil
+}
+
 // defaultTxStatusHandler implements the original default behavior for TxStatus
 func (m *mockTxServer) defaultTxStatusHandler(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
 	// Use predefined response sequences
@@ -115,11 +170,36 @@ func createMockServer(t *testing.T, txStatusResponses map[string][]*tx.TxStatusR
 	}
 	mockServer.txStatusHandler = mockServer.defaultTxStatusHandler
 
+	return serveMockServer(t, mockServer, mockServer)
+}
+
+// createMockBatchServer creates a mock gRPC server that additionally supports
+// TxStatusBatch, answering each entry with the predefined tx status responses.
+func createMockBatchServer(t *testing.T, txStatusResponses map[string][]*tx.TxStatusResponse) (*grpc.ClientConn, *mockBatchTxServer) {
+	mockServer := &mockBatchTxServer{
+		mockTxServer: &mockTxServer{
+			txStatusResponses:   txStatusResponses,
+			txStatusCallCounts:  make(map[string]int),
+			broadcastCallCounts: make(map[string]int),
+		},
+	}
+	mockServer.broadcastHandler = mockServer.defaultBroadcastHandler
+	mockServer.txStatusHandler = mockServer.defaultTxStatusHandler
+
+	return serveMockServer(t, mockServer, mockServer), mockServer
+}
+
+// serveMockServer serves the given mock services on an in-memory gRPC server and
+// returns a client connection to it.
+func serveMockServer(t *testing.T, serviceServer sdktx.ServiceServer, txServer tx.TxServer, registerServices ...func(*grpc.Server)) *grpc.ClientConn {
 	// Set up in-memory gRPC server
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
-	sdktx.RegisterServiceServer(s, mockServer) // For BroadcastTx
-	tx.RegisterTxServer(s, mockServer)         // For TxStatus
+	sdktx.RegisterServiceServer(s, serviceServer) // For BroadcastTx
+	tx.RegisterTxServer(s, txServer)              // For TxStatus
+	for _, register := range registerServices {
+		register(s)
+	}
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -182,3 +262,170 @@ func setupTxClientWithMockServers(t *testing.T, broadcastHandlers []BroadcastHan
 
 	return mockTxClient, conns
 }
+
+// mockStreamTxServer extends mockBatchTxServer with support for
+// SubscribeTxStatus, sending every predefined tx status response as a separate
+// message.
+type mockStreamTxServer struct {
+	*mockBatchTxServer
+	subscribeCallCount int
+}
+
+func (m *mockStreamTxServer) SubscribeTxStatus(req *tx.SubscribeTxStatusRequest, stream tx.Tx_SubscribeTxStatusServer) error {
+	m.mtx.Lock()
+	m.subscribeCallCount++
+	m.mtx.Unlock()
+
+	for i, txID := range req.TxIds {
+		for _, resp := range m.txStatusResponses[txID] {
+			err := stream.Send(&tx.SubscribeTxStatusResponse{
+				Height:   int64(i + 1),
+				Statuses: []*tx.TxStatusResult{{TxId: txID, Status: resp}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createMockStreamServer creates a mock gRPC server that additionally supports
+// SubscribeTxStatus, streaming the predefined tx status responses.
+func createMockStreamServer(t *testing.T, txStatusResponses map[string][]*tx.TxStatusResponse) (*grpc.ClientConn, *mockStreamTxServer) {
+	mockServer := &mockStreamTxServer{
+		mockBatchTxServer: &mockBatchTxServer{
+			mockTxServer: &mockTxServer{
+				txStatusResponses:   txStatusResponses,
+				txStatusCallCounts:  make(map[string]int),
+				broadcastCallCounts: make(map[string]int),
+			},
+		},
+	}
+	mockServer.broadcastHandler = mockServer.defaultBroadcastHandler
+	mockServer.txStatusHandler = mockServer.defaultTxStatusHandler
+
+	return serveMockServer(t, mockServer, mockServer), mockServer
+}
+
+// mockNodeServer implements the node service, reporting a new block height on
+// every Status call.
+type mockNodeServer struct {
+	nodeservice.UnimplementedServiceServer
+	height atomic.Uint64
+}
+
+func (m *mockNodeServer) Status(context.Context, *nodeservice.StatusRequest) (*nodeservice.StatusResponse, error) {
+	return &nodeservice.StatusResponse{Height: m.height.Add(1)}, nil
+}
+
+// mockFeeBumpServer extends mockBatchTxServer by assigning broadcast
+// transactions their real hash and keeping them by hash.
+type mockFeeBumpServer struct {
+	*mockBatchTxServer
+	nodeServer   *mockNodeServer
+	broadcastTxs map[string][]byte
+	// broadcastErr is returned by BroadcastTx if set
+	broadcastErr error
+	// broadcastCode is the code of the BroadcastTx response if set
+	broadcastCode uint32
+}
+
+func (m *mockFeeBumpServer) BroadcastTx(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(req.TxBytes).Hash())
+	m.broadcastCallCounts[txHash]++
+	if m.broadcastErr != nil {
+		return nil, m.broadcastErr
+	}
+	if m.broadcastCode != abci.CodeTypeOK {
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdk.TxResponse{TxHash: txHash, Code: m.broadcastCode},
+		}, nil
+	}
+	m.broadcastTxs[txHash] = req.TxBytes
+	return &sdktx.BroadcastTxResponse{
+		TxResponse: &sdk.TxResponse{
+			TxHash: txHash,
+			Code:   abci.CodeTypeOK,
+		},
+	}, nil
+}
+
+// createMockFeeBumpServer creates a mock gRPC server that keeps broadcast
+// transactions by their real hash and additionally supports the node Status
+// query used for fee bumping. Tx statuses are answered with the given handler.
+func createMockFeeBumpServer(t *testing.T, txStatusHandler TxStatusHandler, registerServices ...func(*grpc.Server)) (*grpc.ClientConn, *mockFeeBumpServer) {
+	mockServer := &mockFeeBumpServer{
+		mockBatchTxServer: &mockBatchTxServer{
+			mockTxServer: &mockTxServer{
+				txStatusCallCounts:  make(map[string]int),
+				broadcastCallCounts: make(map[string]int),
+			},
+		},
+		nodeServer:   &mockNodeServer{},
+		broadcastTxs: make(map[string][]byte),
+	}
+	mockServer.txStatusHandler = txStatusHandler
+
+	registerServices = append(registerServices, func(s *grpc.Server) {
+		nodeservice.RegisterServiceServer(s, mockServer.nodeServer)
+	})
+	conn := serveMockServer(t, mockServer, mockServer, registerServices...)
+	return conn, mockServer
+}
+
+// mockAuthQueryServer implements the auth Account query, reporting the same
+// account for every address.
+type mockAuthQueryServer struct {
+	authtypes.UnimplementedQueryServer
+	account *authtypes.BaseAccount
+}
+
+func (m *mockAuthQueryServer) Account(context.Context, *authtypes.QueryAccountRequest) (*authtypes.QueryAccountResponse, error) {
+	account, err := codectypes.NewAnyWithValue(m.account)
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: account}, nil
+}
+
+// mockBlobQueryServer implements the blob Params query.
+type mockBlobQueryServer struct {
+	blobtypes.UnimplementedQueryServer
+	params blobtypes.Params
+}
+
+func (m *mockBlobQueryServer) Params(context.Context, *blobtypes.QueryParamsRequest) (*blobtypes.QueryParamsResponse, error) {
+	return &blobtypes.QueryParamsResponse{Params: m.params}, nil
+}
+
+// mockConsensusQueryServer implements the consensus Params query.
+type mockConsensusQueryServer struct {
+	consensustypes.UnimplementedQueryServer
+	params *cmtproto.ConsensusParams
+}
+
+func (m *mockConsensusQueryServer) Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
+	return &consensustypes.QueryParamsResponse{Params: m.params}, nil
+}
+
+// registerParamsQueryServers registers the blob and consensus params queries
+// with the given max square size and max block size.
+func registerParamsQueryServers(govMaxSquareSize uint64, maxBytes int64) func(*grpc.Server) {
+	return registerBlobParamsQueryServers(blobtypes.NewParams(blobtypes.DefaultGasPerBlobByte, govMaxSquareSize), maxBytes)
+}
+
+// registerBlobParamsQueryServers is like registerParamsQueryServers but
+// reports the given blob params.
+func registerBlobParamsQueryServers(blobParams blobtypes.Params, maxBytes int64) func(*grpc.Server) {
+	return func(s *grpc.Server) {
+		blobtypes.RegisterQueryServer(s, &mockBlobQueryServer{
+			params: blobParams,
+		})
+		consensustypes.RegisterQueryServer(s, &mockConsensusQueryServer{
+			params: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: maxBytes}},
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// blobTxOverheadBytes is the number of bytes of a blob transaction reserved
+// for everything but the blob data, i.e. the PFB message, signature, fee and
+// blob encoding.
+const blobTxOverheadBytes = 4096
+
+// PayloadChunk is a blob holding a part of a payload.
+type PayloadChunk struct {
+	// Height is the height at which the blob was committed.
+	Height int64
+	// Commitment is the share commitment of the blob.
+	Commitment []byte
+	// TxHash is the hash of the PFB transaction that paid for the blob.
+	TxHash string
+	// Size is the number of bytes of the payload in the blob.
+	Size int
+}
+
+// PayloadManifest lists the blobs holding a payload submitted with
+// SubmitPayload. The payload is the concatenation of the data of the blobs in
+// the order of the chunks.
+type PayloadManifest struct {
+	Namespace share.Namespace
+	// Size is the total size of the payload in bytes.
+	Size   int
+	Chunks []PayloadChunk
+}
+
+// SubmitPayload splits a payload of arbitrary size into blobs of the namespace
+// that each fit in a single transaction and in the max effective square of the
+// chain, submits them in parallel through the tx queue and waits for all of
+// them to be committed. TxOptions are applied to every transaction. The
+// returned manifest holds the height and commitment of every blob, which are
+// needed to retrieve and reassemble the payload. The chunks may be committed
+// in different blocks and in any order.
+// The tx queue must be started, which SetupTxClient does.
+func (client *TxClient) SubmitPayload(ctx context.Context, namespace share.Namespace, payload []byte, opts ...TxOption) (*PayloadManifest, error) {
+	maxBlobSize, err := client.MaxBlobSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := SplitPayload(namespace, payload, maxBlobSize)
+	if err != nil {
+		return nil, err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("txclient/SubmitPayload: submitting payload", trace.WithAttributes(
+		attribute.Int("payload_size", len(payload)),
+		attribute.Int("num_blobs", len(blobs)),
+	))
+
+	manifest := &PayloadManifest{
+		Namespace: namespace,
+		Size:      len(payload),
+		Chunks:    make([]PayloadChunk, len(blobs)),
+	}
+	// every blob gets its own results channel so that the results can be
+	// matched to the chunks.
+	resultsCs := make([]chan SubmissionResult, len(blobs))
+	for i, blob := range blobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("creating commitment of chunk %d: %w", i, err)
+		}
+		manifest.Chunks[i] = PayloadChunk{Commitment: commitment, Size: blob.DataLen()}
+	}
+	for i, blob := range blobs {
+		resultsCs[i] = make(chan SubmissionResult, 1)
+		client.QueueBlob(ctx, resultsCs[i], []*share.Blob{blob}, opts...)
+	}
+
+	var errs []error
+	for i, resultsC := range resultsCs {
+		var result SubmissionResult
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result = <-resultsC:
+		}
+		if result.Error != nil {
+			errs = append(errs, fmt.Errorf("submitting chunk %d: %w", i, result.Error))
+			continue
+		}
+		manifest.Chunks[i].Height = result.TxResponse.Height
+		manifest.Chunks[i].TxHash = result.TxResponse.TxHash
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return manifest, nil
+}
+
+// MaxBlobSize returns the size of the largest blob that a single PFB
+// transaction can pay for, given the max square size, the max block size and
+// the per-namespace share quota currently set on chain.
+func (client *TxClient) MaxBlobSize(ctx context.Context) (int, error) {
+	blobParams, err := blobtypes.NewQueryClient(client.conns[0]).Params(ctx, &blobtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	consensusParams, err := consensustypes.NewQueryClient(client.conns[0]).Params(ctx, &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying consensus params: %w", err)
+	}
+
+	// A transaction can't be larger than a block, nor than the max tx size
+	// enforced by the app in CheckTx.
+	maxTxSize := appconsts.MaxTxSize
+	if block := consensusParams.Params.GetBlock(); block != nil && block.MaxBytes > 0 {
+		maxTxSize = int(min(block.MaxBytes, int64(maxTxSize)))
+	}
+	squareSize := int(min(blobParams.Params.GovMaxSquareSize, uint64(appconsts.SquareSizeUpperBound)))
+	maxBlobSize := MaxBlobSizeForSquare(squareSize, maxTxSize)
+	// The blobs of a namespace can't occupy more shares of a block than its
+	// quota, if one is set.
+	if maxShares := blobParams.Params.MaxNamespaceShares(squareSize); maxShares > 0 {
+		quotaLimit := share.FirstSparseShareContentSize + (maxShares-1)*share.ContinuationSparseShareContentSize
+		maxBlobSize = min(maxBlobSize, quotaLimit)
+	}
+	return maxBlobSize, nil
+}
+
+// MaxBlobSizeForSquare returns the size of the largest blob that a single PFB
+// transaction of at most maxTxSize bytes can pay for in a square of the given
+// size. The first row of the square is reserved for the PFB transaction. The
+// blob then starts at the beginning of a row, which is aligned to any subtree
+// width.
+func MaxBlobSizeForSquare(squareSize, maxTxSize int) int {
+	maxShares := squareSize*squareSize - squareSize
+	if maxShares <= 0 || maxTxSize <= blobTxOverheadBytes {
+		return 0
+	}
+	squareLimit := share.FirstSparseShareContentSize + (maxShares-1)*share.ContinuationSparseShareContentSize
+	return min(squareLimit, maxTxSize-blobTxOverheadBytes)
+}
+
+// SplitPayload splits the payload into v0 blobs of the namespace of at most
+// maxBlobSize bytes each.
+func SplitPayload(namespace share.Namespace, payload []byte, maxBlobSize int) ([]*share.Blob, error) {
+	if len(payload) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	if err := namespace.ValidateForBlob(); err != nil {
+		return nil, err
+	}
+	if maxBlobSize <= 0 {
+		return nil, fmt.Errorf("max blob size must be positive: %d", maxBlobSize)
+	}
+	blobs := make([]*share.Blob, 0, (len(payload)+maxBlobSize-1)/maxBlobSize)
+	for start := 0; start < len(payload); start += maxBlobSize {
+		end := min(start+maxBlobSize, len(payload))
+		blob, err := share.NewV0Blob(namespace, payload[start:end])
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
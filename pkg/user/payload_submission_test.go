@@ -0,0 +1,180 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBlobSizeForSquare(t *testing.T) {
+	require.Zero(t, user.MaxBlobSizeForSquare(1, appconsts.MaxTxSize))
+	// a 2x2 square leaves one row, i.e. two shares, for the blob
+	require.Equal(t, share.FirstSparseShareContentSize+share.ContinuationSparseShareContentSize, user.MaxBlobSizeForSquare(2, appconsts.MaxTxSize))
+	// large squares are bounded by the max tx size
+	require.Less(t, user.MaxBlobSizeForSquare(appconsts.SquareSizeUpperBound, appconsts.MaxTxSize), appconsts.MaxTxSize)
+	require.Less(t, user.MaxBlobSizeForSquare(appconsts.SquareSizeUpperBound, 100_000), 100_000)
+	require.Zero(t, user.MaxBlobSizeForSquare(appconsts.SquareSizeUpperBound, 1000))
+
+	for _, squareSize := range []int{2, 4, 8, 64} {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(user.MaxBlobSizeForSquare(squareSize, appconsts.MaxTxSize)))
+		require.NoError(t, err)
+		shares, err := blob.ToShares()
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(shares), squareSize*(squareSize-1))
+	}
+}
+
+func TestSplitPayload(t *testing.T) {
+	namespace := share.RandomBlobNamespace()
+	payload := random.Bytes(2500)
+
+	blobs, err := user.SplitPayload(namespace, payload, 1000)
+	require.NoError(t, err)
+	require.Len(t, blobs, 3)
+	var reassembled []byte
+	for _, blob := range blobs {
+		require.Equal(t, namespace, blob.Namespace())
+		require.LessOrEqual(t, blob.DataLen(), 1000)
+		reassembled = append(reassembled, blob.Data()...)
+	}
+	require.Equal(t, payload, reassembled)
+
+	_, err = user.SplitPayload(namespace, nil, 1000)
+	require.Error(t, err)
+	_, err = user.SplitPayload(namespace, payload, 0)
+	require.Error(t, err)
+	_, err = user.SplitPayload(share.TxNamespace, payload, 1000)
+	require.Error(t, err)
+}
+
+func TestSubmitPayload(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	conn, mockServer := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 10}, nil
+	}, registerParamsQueryServers(4, appconsts.DefaultMaxBytes))
+	t.Cleanup(func() { conn.Close() })
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(50*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, txClient.StartTxQueueForTest(ctx))
+	defer txClient.StopTxQueueForTest()
+
+	maxBlobSize, err := txClient.MaxBlobSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, user.MaxBlobSizeForSquare(4, appconsts.MaxTxSize), maxBlobSize)
+
+	namespace := share.RandomBlobNamespace()
+	payload := random.Bytes(3*maxBlobSize + 1)
+	manifest, err := txClient.SubmitPayload(ctx, namespace, payload)
+	require.NoError(t, err)
+	require.Equal(t, namespace, manifest.Namespace)
+	require.Equal(t, len(payload), manifest.Size)
+	require.Len(t, manifest.Chunks, 4)
+
+	// reassemble the payload from the broadcast blobs
+	mockServer.mtx.Lock()
+	defer mockServer.mtx.Unlock()
+	var reassembled []byte
+	for _, chunk := range manifest.Chunks {
+		require.Equal(t, int64(10), chunk.Height)
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(mockServer.broadcastTxs[chunk.TxHash])
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		require.Len(t, blobTx.Blobs, 1)
+		commitment, err := inclusion.CreateCommitment(blobTx.Blobs[0], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(chunk.Commitment, commitment))
+		require.Equal(t, chunk.Size, blobTx.Blobs[0].DataLen())
+		reassembled = append(reassembled, blobTx.Blobs[0].Data()...)
+	}
+	require.Equal(t, payload, reassembled)
+}
+
+// TestSubmitPayloadMaxBlockSize ensures that chunks are bounded by the max
+// block size set on chain.
+func TestSubmitPayloadMaxBlockSize(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	conn, _ := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 10}, nil
+	}, registerParamsQueryServers(uint64(appconsts.SquareSizeUpperBound), 100_000))
+	t.Cleanup(func() { conn.Close() })
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry)
+	require.NoError(t, err)
+
+	maxBlobSize, err := txClient.MaxBlobSize(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, user.MaxBlobSizeForSquare(appconsts.SquareSizeUpperBound, 100_000), maxBlobSize)
+}
+
+// TestSubmitPayloadMaxNamespaceShares ensures that chunks are bounded by the
+// per-namespace share quota set on chain.
+func TestSubmitPayloadMaxNamespaceShares(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobParams := blobtypes.NewParams(blobtypes.DefaultGasPerBlobByte, 64)
+	// 1% of the 4096 shares of the square
+	blobParams.MaxNamespaceShareBps = 100
+	conn, _ := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 10}, nil
+	}, registerBlobParamsQueryServers(blobParams, appconsts.DefaultMaxBytes))
+	t.Cleanup(func() { conn.Close() })
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry)
+	require.NoError(t, err)
+
+	maxBlobSize, err := txClient.MaxBlobSize(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, share.FirstSparseShareContentSize+39*share.ContinuationSparseShareContentSize, maxBlobSize)
+}
+
+// TestSubmitPayloadContextDone ensures that SubmitPayload returns once the
+// context is done even if chunks are still being confirmed.
+func TestSubmitPayloadContextDone(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	conn, _ := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusPending}, nil
+	}, registerParamsQueryServers(4, appconsts.DefaultMaxBytes))
+	t.Cleanup(func() { conn.Close() })
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(50*time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, txClient.StartTxQueueForTest(context.Background()))
+	defer txClient.StopTxQueueForTest()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err = txClient.SubmitPayload(ctx, share.RandomBlobNamespace(), random.Bytes(1000))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -5,12 +5,15 @@ import (
 	"testing"
 	"time"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
 
 func TestPruningInTxTracker(t *testing.T) {
+	txJournal := NewDBTxJournal(dbm.NewMemDB())
 	txClient := &TxClient{
 		txTracker: make(map[string]txInfo),
+		txJournal: txJournal,
 	}
 	numTransactions := 10
 
@@ -26,6 +29,7 @@ func TestPruningInTxTracker(t *testing.T) {
 				timestamp: time.Now().
 					Add(-10 * time.Minute),
 			}
+			require.NoError(t, txJournal.Put(TxJournalEntry{TxHash: "tx" + fmt.Sprint(i)}))
 			txsToBePruned++
 		} else {
 			txClient.txTracker["tx"+fmt.Sprint(i)] = txInfo{
@@ -47,4 +51,9 @@ func TestPruningInTxTracker(t *testing.T) {
 	// 5 transactions will be pruned
 	require.Equal(t, txsNotReadyToBePruned, txTrackerBeforePruning-txsToBePruned)
 	require.Equal(t, len(txClient.txTracker), txsNotReadyToBePruned)
+	// The journal entries of pruned transactions are kept, as the
+	// transactions may not have reached a final status.
+	entries, err := txJournal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, txsToBePruned)
 }
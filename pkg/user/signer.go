@@ -289,22 +289,44 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 		return "", 0, err
 	}
 
+	if err := s.setSignature(builder, account, account.sequence); err != nil {
+		return "", 0, err
+	}
+	return account.name, account.sequence, nil
+}
+
+// signTransactionWithSequence signs the transaction with the given sequence
+// instead of the current sequence of the account. It's used to replace a
+// transaction that was already submitted.
+func (s *Signer) signTransactionWithSequence(builder client.TxBuilder, sequence uint64) (string, error) {
+	account, err := s.findAccount(builder)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.setSignature(builder, account, sequence); err != nil {
+		return "", err
+	}
+	return account.name, nil
+}
+
+func (s *Signer) setSignature(builder client.TxBuilder, account *Account, sequence uint64) error {
 	// a dry run of the signing data
-	err = builder.SetSignatures(signing.SignatureV2{
+	err := builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
 			SignMode:  s.signMode,
 			Signature: nil,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
-		return "", 0, fmt.Errorf("error setting draft signatures: %w", err)
+		return fmt.Errorf("error setting draft signatures: %w", err)
 	}
 
-	signature, err := s.createSignature(builder, account, account.sequence)
+	signature, err := s.createSignature(builder, account, sequence)
 	if err != nil {
-		return "", 0, fmt.Errorf("error creating signature: %w", err)
+		return fmt.Errorf("error creating signature: %w", err)
 	}
 
 	err = builder.SetSignatures(signing.SignatureV2{
@@ -313,13 +335,12 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 			Signature: signature,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
-		return "", 0, fmt.Errorf("error setting signatures: %w", err)
+		return fmt.Errorf("error setting signatures: %w", err)
 	}
-
-	return account.name, account.sequence, nil
+	return nil
 }
 
 func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
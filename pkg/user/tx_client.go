@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -37,6 +38,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -181,6 +184,36 @@ func WithParallelQueueSize(size int) Option {
 	}
 }
 
+// WithTxStatusSubscription makes ConfirmTx receive status updates through the
+// SubscribeTxStatus stream instead of polling on the poll time interval. The
+// client falls back to polling if the node does not support the stream.
+func WithTxStatusSubscription() Option {
+	return func(c *TxClient) {
+		c.txStatusSubscription = true
+	}
+}
+
+// WithFeeBumpPolicy makes ConfirmTx replace transactions that stay pending for
+// the number of blocks of the policy with a transaction of the same sequence
+// and a higher gas price. It also makes ConfirmTx resubmit evicted
+// transactions with a higher gas price. The fee of a replacement never
+// exceeds the max fee of the policy.
+func WithFeeBumpPolicy(policy FeeBumpPolicy) Option {
+	return func(c *TxClient) {
+		c.feeBumpPolicy = &policy
+	}
+}
+
+// WithTxJournal makes the client record every transaction it submits in the
+// journal before broadcasting it, until the transaction reaches a final status:
+// committed, rejected, or evicted without being resubmitted. Unconfirmed
+// transactions of a previous run are recovered by ReconcileJournal.
+func WithTxJournal(journal TxJournal) Option {
+	return func(c *TxClient) {
+		c.txJournal = journal
+	}
+}
+
 // TxClient is an abstraction for building, signing, and broadcasting Celestia transactions
 // It supports multiple accounts.
 // TxClient is thread-safe.
@@ -202,9 +235,25 @@ type TxClient struct {
 	gasEstimationClient gasestimation.GasEstimatorClient
 	// txQueue manages parallel transaction submission when enabled
 	txQueue *txQueue
+	// txStatusPoller queries the status of all transactions that are being
+	// confirmed in a single call per poll
+	txStatusPoller *txStatusPoller
+	// txStatusSubscription makes ConfirmTx use the SubscribeTxStatus stream
+	// instead of polling
+	txStatusSubscription bool
+	// txStatusSubscriptionUnsupported is set once the node responded that it
+	// doesn't implement SubscribeTxStatus
+	txStatusSubscriptionUnsupported atomic.Bool
+	// feeBumpPolicy makes ConfirmTx replace pending and evicted transactions
+	// with a higher gas price if set
+	feeBumpPolicy *FeeBumpPolicy
+	// txJournal persists the submitted transactions until they reach a final
+	// status if set
+	txJournal TxJournal
 }
 
-// NewTxClient returns a new TxClient
+// NewTxClient returns a new TxClient. If the client is configured with
+// WithTxJournal, ReconcileJournal must be called before submitting transactions.
 func NewTxClient(
 	cdc codec.Codec,
 	signer *Signer,
@@ -242,6 +291,14 @@ func NewTxClient(
 		opt(txClient)
 	}
 
+	if txClient.feeBumpPolicy != nil {
+		if err := txClient.feeBumpPolicy.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	txClient.txStatusPoller = newTxStatusPoller(conn, txClient.pollTime)
+
 	// Always create a tx queue with at least 1 worker (the default account)
 	// unless already configured by WithTxWorkers option
 	if txClient.txQueue == nil {
@@ -252,7 +309,8 @@ func NewTxClient(
 }
 
 // SetupTxClient initializes a TxClient by querying the chain ID and account
-// details for all accounts in the keyring, then starts the transaction queue.
+// details for all accounts in the keyring, reconciles the tx journal if one is
+// configured, then starts the transaction queue.
 // The queue runs until the provided context is cancelled.
 func SetupTxClient(
 	ctx context.Context,
@@ -303,6 +361,10 @@ func SetupTxClient(
 		return nil, err
 	}
 
+	if _, err := txClient.ReconcileJournal(ctx); err != nil {
+		return nil, fmt.Errorf("failed to reconcile tx journal: %w", err)
+	}
+
 	if err := txClient.txQueue.start(ctx); err != nil {
 		return nil, fmt.Errorf("failed to start tx queue: %w", err)
 	}
@@ -525,8 +587,15 @@ func (client *TxClient) routeTx(ctx context.Context, txBytes []byte, signer stri
 func (client *TxClient) submitToSingleConnection(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
+	sequence := client.signer.Account(signer).Sequence()
+	if _, err := client.journalTx(signer, sequence, txBytes, core.TxStatusPending); err != nil {
+		return nil, err
+	}
+
 	resp, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
 	if err != nil {
+		_, rejected := err.(*BroadcastTxError)
+		client.journalFailedBroadcast(signer, sequence, txBytes, rejected)
 		broadcastTxErr, ok := err.(*BroadcastTxError)
 		if !ok || !apperrors.IsNonceMismatchCode(broadcastTxErr.Code) {
 			return nil, err
@@ -590,23 +659,42 @@ func (client *TxClient) sendTxToConnection(ctx context.Context, conn *grpc.Clien
 
 // resignTransactionWithNewSequence creates a new transaction with updated sequence from existing tx bytes
 func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte, error) {
+	txBuilder, blobTx, err := client.rebuildTransaction(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = client.signer.signTransaction(txBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("resigning transaction: %w", err)
+	}
+
+	return client.encodeRebuiltTransaction(txBuilder, blobTx)
+}
+
+// rebuildTransaction decodes the transaction and returns an unsigned tx builder
+// with the same content. If the transaction is a blob tx, the blob tx is
+// returned as well, so that the rebuilt transaction can be rewrapped.
+func (client *TxClient) rebuildTransaction(txBytes []byte) (client.TxBuilder, *blobtx.BlobTx, error) {
 	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
 	if isBlobTx && err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if isBlobTx {
 		txBytes = blobTx.Tx
+	} else {
+		blobTx = nil
 	}
 	tx, err := client.signer.DecodeTx(txBytes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	txBuilder, err := client.signer.txBuilder(tx.GetMsgs(), []TxOption{}...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := txBuilder.SetMsgs(tx.GetMsgs()...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if granter := tx.FeeGranter(); granter != nil {
 		txBuilder.SetFeeGranter(granter)
@@ -623,19 +711,22 @@ func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte
 	if gas := tx.GetGas(); gas > 0 {
 		txBuilder.SetGasLimit(gas)
 	}
-
-	_, _, err = client.signer.signTransaction(txBuilder)
-	if err != nil {
-		return nil, fmt.Errorf("resigning transaction: %w", err)
+	if timeoutHeight := tx.GetTimeoutHeight(); timeoutHeight > 0 {
+		txBuilder.SetTimeoutHeight(timeoutHeight)
 	}
+	return txBuilder, blobTx, nil
+}
 
+// encodeRebuiltTransaction encodes the signed transaction of the builder and
+// rewraps it in the blob tx if it was originally a blob tx.
+func (client *TxClient) encodeRebuiltTransaction(txBuilder client.TxBuilder, blobTx *blobtx.BlobTx) ([]byte, error) {
 	newTxBytes, err := client.signer.EncodeTx(txBuilder.GetTx())
 	if err != nil {
 		return nil, err
 	}
 
 	// Rewrap the blob tx if it was originally a blob tx
-	if isBlobTx {
+	if blobTx != nil {
 		newTxBytes, err = blobtx.MarshalBlobTx(newTxBytes, blobTx.Blobs...)
 		if err != nil {
 			return nil, err
@@ -650,6 +741,11 @@ func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte
 func (client *TxClient) submitToMultipleConnections(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
+	sequence := client.signer.Account(signer).Sequence()
+	if _, err := client.journalTx(signer, sequence, txBytes, core.TxStatusPending); err != nil {
+		return nil, err
+	}
+
 	respCh := make(chan *sdktypes.TxResponse, 1)
 	errCh := make(chan error, len(client.conns))
 
@@ -696,15 +792,22 @@ func (client *TxClient) submitToMultipleConnections(ctx context.Context, txBytes
 		return resp, nil
 	}
 
-	// Otherwise, return the first error encountered
+	// Otherwise, return the errors encountered. The transaction was only
+	// rejected if every node rejected it.
 	errs := make([]error, 0, len(errCh))
+	rejected := true
 	for err := range errCh {
+		if _, ok := err.(*BroadcastTxError); !ok {
+			rejected = false
+		}
 		errs = append(errs, err)
 	}
+	client.journalFailedBroadcast(signer, sequence, txBytes, rejected)
 	return nil, errors.Join(errs...)
 }
 
-// pruneTxTracker removes transactions from the local tx tracker that are older than 10 minutes
+// pruneTxTracker removes transactions from the local tx tracker that are older than 10 minutes.
+// Their journal entries are kept, as the transactions may not have reached a final status.
 func (client *TxClient) pruneTxTracker() {
 	for hash, txInfo := range client.txTracker {
 		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
@@ -715,25 +818,72 @@ func (client *TxClient) pruneTxTracker() {
 
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
 // hash. It will continually loop until the context is cancelled, the tx is found or an error
-// is encountered.
+// is encountered. The statuses of all transactions that are being confirmed concurrently
+// are polled together using a single TxStatusBatch call. The batch call is shared and
+// therefore bounded by a timeout derived from the poll time rather than by ctx, but
+// ConfirmTx itself returns as soon as ctx is done. If the client was configured with
+// WithTxStatusSubscription, status updates are pushed by the node instead.
+// If the client was configured with WithFeeBumpPolicy, the transaction may be replaced
+// by a transaction with a higher gas price, in which case the returned TxHash is the
+// hash of the transaction that was committed.
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	txClient := tx.NewTxClient(client.conns[0])
-
-	pollTicker := time.NewTicker(client.pollTime)
-	defer pollTicker.Stop()
+	updates, unsubscribe := client.txStatusUpdates(ctx, txHash)
+	defer func() { unsubscribe() }()
 	var evictionPollTimeStart *time.Time
+	// evictionTimeout fires if an evicted transaction doesn't change its status
+	// within the eviction poll timeout, which a subscription would not report.
+	var evictionTimeout <-chan time.Time
+	// replacedTxHashes are the hashes of the transactions that were replaced by
+	// txHash through fee bumping. Any of them may still be committed.
+	var replacedTxHashes []string
+	// pending tracks how long txHash has been pending. It's only used for fee
+	// bumping.
+	var pending pendingTx
+	replaceTx := func(newTxHash string) {
+		unsubscribe()
+		replacedTxHashes = append(replacedTxHashes, txHash)
+		txHash = newTxHash
+		pending = pendingTx{}
+		evictionPollTimeStart = nil
+		evictionTimeout = nil
+		updates, unsubscribe = client.txStatusUpdates(ctx, txHash)
+	}
 
 	for {
-		span.AddEvent("txclient/ConfirmTx: polling for TxStatus")
-		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
-		if err != nil {
-			return nil, err
+		var resp *tx.TxStatusResponse
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-evictionTimeout:
+			client.deleteFromTxJournal(append(replacedTxHashes, txHash)...)
+			return nil, fmt.Errorf("eviction poll timeout: transaction %s was evicted ", txHash)
+		case update := <-updates:
+			if update.err != nil {
+				return nil, update.err
+			}
+			if update.resp == nil {
+				return nil, fmt.Errorf("empty status received for tx %s", txHash)
+			}
+			resp = update.resp
+		}
+		span.AddEvent("txclient/ConfirmTx: received TxStatus")
+
+		if len(replacedTxHashes) > 0 && resp.Status != core.TxStatusPending && resp.Status != core.TxStatusCommitted {
+			// The replacement may have failed because a replaced transaction
+			// got committed in the meantime.
+			if committedTxHash, committedResp := client.committedReplacedTx(ctx, replacedTxHashes); committedResp != nil {
+				span.AddEvent("txclient/ConfirmTx: replaced transaction committed", trace.WithAttributes(
+					attribute.String("tx_hash", committedTxHash),
+				))
+				txHash, resp = committedTxHash, committedResp
+			}
 		}
 
 		if evictionPollTimeStart != nil {
 			if time.Since(*evictionPollTimeStart) > evictionPollTimeOut {
+				client.deleteFromTxJournal(append(replacedTxHashes, txHash)...)
 				return nil, fmt.Errorf("eviction poll timeout: transaction %s was evicted ", txHash)
 			}
 		}
@@ -741,6 +891,11 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 		switch resp.Status {
 		case core.TxStatusPending:
 			span.AddEvent("txclient/ConfirmTx: transaction pending")
+			if client.feeBumpPolicy != nil {
+				if newTxHash, replaced := client.maybeBumpFee(ctx, txHash, &pending); replaced {
+					replaceTx(newTxHash)
+				}
+			}
 			// Continue polling if the transaction is still pending
 		case core.TxStatusCommitted:
 			span.AddEvent("txclient/ConfirmTx: transaction committed", trace.WithAttributes(
@@ -758,11 +913,13 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 					ErrorLog: resp.Error,
 				}
 				span.RecordError(fmt.Errorf("txclient/ConfirmTx: execution error: %s", resp.Error))
-				client.deleteFromTxTracker(txHash)
+				client.deleteFromTxTracker(append(replacedTxHashes, txHash)...)
+				client.deleteFromTxJournal(append(replacedTxHashes, txHash)...)
 				return nil, executionErr
 			}
 			span.AddEvent("txclient/ConfirmTx: transaction confirmed successfully")
-			client.deleteFromTxTracker(txHash)
+			client.deleteFromTxTracker(append(replacedTxHashes, txHash)...)
+			client.deleteFromTxJournal(append(replacedTxHashes, txHash)...)
 			return txResponse, nil
 		case core.TxStatusEvicted:
 			_, _, exists := client.GetTxFromTxTracker(txHash)
@@ -775,6 +932,19 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				span.AddEvent("txclient/ConfirmTx: eviction timer already running")
 				break
 			}
+			client.updateTxJournalStatus(txHash, core.TxStatusEvicted)
+
+			if client.feeBumpPolicy != nil {
+				// An evicted transaction was likely outbid, so resubmit it with a
+				// higher gas price.
+				newTxHash, err := client.bumpFee(ctx, txHash)
+				if err == nil {
+					span.AddEvent("txclient/ConfirmTx: transaction resubmitted with a higher fee after eviction")
+					replaceTx(newTxHash)
+					break
+				}
+				span.RecordError(fmt.Errorf("txclient/ConfirmTx: replacing evicted tx: %w", err))
+			}
 
 			span.AddEvent("txclient/ConfirmTx: transaction evicted, attempting resubmission", trace.WithAttributes(
 				attribute.String("tx_hash", txHash),
@@ -792,6 +962,7 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				span.AddEvent("txclient/ConfirmTx: starting eviction timer for broadcast error")
 				now := time.Now()
 				evictionPollTimeStart = &now
+				evictionTimeout = time.After(evictionPollTimeOut)
 			}
 			span.AddEvent("txclient/ConfirmTx: transaction resubmitted successfully after eviction")
 		case core.TxStatusRejected:
@@ -805,24 +976,17 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			if err := client.signer.SetSequence(signer, sequence); err != nil {
 				return nil, fmt.Errorf("setting sequence: %w", err)
 			}
-			client.deleteFromTxTracker(txHash)
+			client.deleteFromTxTracker(append(replacedTxHashes, txHash)...)
+			client.deleteFromTxJournal(append(replacedTxHashes, txHash)...)
 			return nil, fmt.Errorf("tx with hash %s was rejected by the node with execution code %d", txHash, resp.ExecutionCode)
 		default:
 			span.RecordError(fmt.Errorf("txclient/ConfirmTx: unknown tx status for tx: %s", txHash))
-			client.deleteFromTxTracker(txHash)
+			client.deleteFromTxTracker(append(replacedTxHashes, txHash)...)
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
 			}
 			return nil, fmt.Errorf("transaction with hash %s not found", txHash)
 		}
-
-		// Single ticker wait point for all continuing cases
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-pollTicker.C:
-			continue
-		}
 	}
 }
 
@@ -838,11 +1002,13 @@ func extractSequenceError(fullError string) string {
 	return s
 }
 
-// deleteFromTxTracker safely deletes a transaction from the local tx tracker.
-func (client *TxClient) deleteFromTxTracker(txHash string) {
+// deleteFromTxTracker safely deletes transactions from the local tx tracker.
+func (client *TxClient) deleteFromTxTracker(txHashes ...string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
-	delete(client.txTracker, txHash)
+	for _, txHash := range txHashes {
+		delete(client.txTracker, txHash)
+	}
 }
 
 // EstimateGasPriceAndUsage returns the estimated gas price based on the provided priority,
@@ -1126,7 +1292,18 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return max(localMinPrice, networkMinPrice), nil
 }
 
+// QueryNetworkMinGasPrice returns the network min gas price of the next block.
+// It falls back to the params module for nodes that don't serve the minfee
+// query.
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	minfeeResponse, err := minfeetypes.NewQueryClient(grpcConn).NetworkMinGasPrice(ctx, &minfeetypes.QueryNetworkMinGasPrice{})
+	if err == nil {
+		return minfeeResponse.NetworkMinGasPrice.Float64()
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return 0, fmt.Errorf("querying minfee module: %w", err)
+	}
+
 	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
 	paramResponse, err := paramsClient.Params(ctx, &paramtypes.QueryParamsRequest{Subspace: minfeetypes.ModuleName, Key: string(minfeetypes.KeyNetworkMinGasPrice)})
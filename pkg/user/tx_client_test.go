@@ -2,11 +2,15 @@ package user_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,18 +26,23 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/test/util/random"
 	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v6/test/util/testnode"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -293,6 +302,432 @@ func (suite *TxClientTestSuite) TestConfirmTx() {
 	})
 }
 
+func (suite *TxClientTestSuite) TestTxStatusBatch() {
+	t := suite.T()
+
+	addr := suite.txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err := suite.txClient.SubmitTx(suite.ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e6), user.SetGasLimit(1e6))
+	require.NoError(t, err)
+
+	unknownTxHash := "E32BD15CAF57AF15D17B0D63CF4E63A9835DD1CEBB059C335C79586BC3013728"
+	batchResp, err := tx.NewTxClient(suite.ctx.GRPCClient).TxStatusBatch(suite.ctx.GoContext(), &tx.TxStatusBatchRequest{
+		TxIds: []string{resp.TxHash, "not-hex", unknownTxHash},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchResp.Statuses, 3)
+
+	require.Equal(t, resp.TxHash, batchResp.Statuses[0].TxId)
+	require.Empty(t, batchResp.Statuses[0].QueryError)
+	require.Equal(t, core.TxStatusCommitted, batchResp.Statuses[0].Status.Status)
+	require.Equal(t, resp.Height, batchResp.Statuses[0].Status.Height)
+
+	require.Contains(t, batchResp.Statuses[1].QueryError, "invalid tx id")
+	require.Nil(t, batchResp.Statuses[1].Status)
+
+	require.Empty(t, batchResp.Statuses[2].QueryError)
+	require.Equal(t, core.TxStatusUnknown, batchResp.Statuses[2].Status.Status)
+
+	t.Run("should report an empty tx id per entry", func(t *testing.T) {
+		batchResp, err := tx.NewTxClient(suite.ctx.GRPCClient).TxStatusBatch(suite.ctx.GoContext(), &tx.TxStatusBatchRequest{
+			TxIds: []string{"", resp.TxHash},
+		})
+		require.NoError(t, err)
+		require.Len(t, batchResp.Statuses, 2)
+		require.Equal(t, "tx id cannot be empty", batchResp.Statuses[0].QueryError)
+		require.Nil(t, batchResp.Statuses[0].Status)
+		require.Equal(t, core.TxStatusCommitted, batchResp.Statuses[1].Status.Status)
+	})
+
+	t.Run("should reject an empty request", func(t *testing.T) {
+		_, err := tx.NewTxClient(suite.ctx.GRPCClient).TxStatusBatch(suite.ctx.GoContext(), &tx.TxStatusBatchRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should reject too many tx ids", func(t *testing.T) {
+		_, err := tx.NewTxClient(suite.ctx.GRPCClient).TxStatusBatch(suite.ctx.GoContext(), &tx.TxStatusBatchRequest{
+			TxIds: make([]string, tx.MaxTxStatusBatchSize+1),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should serve the gateway route", func(t *testing.T) {
+		baseURL := strings.Replace(suite.ctx.APIAddress(), "tcp", "http", 1)
+		body, err := json.Marshal(map[string][]string{"tx_ids": {resp.TxHash, unknownTxHash}})
+		require.NoError(t, err)
+
+		out, err := testutil.PostRequest(fmt.Sprintf("%s/celestia/core/v1/tx/status/batch", baseURL), "application/json", body)
+		require.NoError(t, err)
+
+		var gatewayResp tx.TxStatusBatchResponse
+		require.NoError(t, suite.ctx.Codec.UnmarshalJSON(out, &gatewayResp))
+		require.Len(t, gatewayResp.Statuses, 2)
+		require.Equal(t, resp.TxHash, gatewayResp.Statuses[0].TxId)
+		require.Equal(t, core.TxStatusCommitted, gatewayResp.Statuses[0].Status.Status)
+		require.Equal(t, resp.Height, gatewayResp.Statuses[0].Status.Height)
+		require.Equal(t, core.TxStatusUnknown, gatewayResp.Statuses[1].Status.Status)
+	})
+}
+
+func (suite *TxClientTestSuite) TestSubscribeTxStatus() {
+	t := suite.T()
+
+	addr := suite.txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err := suite.txClient.SubmitTx(suite.ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e6), user.SetGasLimit(1e6))
+	require.NoError(t, err)
+
+	stream, err := tx.NewTxClient(suite.ctx.GRPCClient).SubscribeTxStatus(suite.ctx.GoContext(), &tx.SubscribeTxStatusRequest{
+		TxIds: []string{resp.TxHash, "not-hex"},
+	})
+	require.NoError(t, err)
+
+	streamResp, err := stream.Recv()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, streamResp.Height, resp.Height)
+	require.Len(t, streamResp.Statuses, 2)
+	require.Equal(t, resp.TxHash, streamResp.Statuses[0].TxId)
+	require.Equal(t, core.TxStatusCommitted, streamResp.Statuses[0].Status.Status)
+	require.Equal(t, resp.Height, streamResp.Statuses[0].Status.Height)
+	require.Contains(t, streamResp.Statuses[1].QueryError, "invalid tx id")
+
+	// the stream ends once all transactions reached a final status
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+
+	t.Run("should reject an empty request", func(t *testing.T) {
+		stream, err := tx.NewTxClient(suite.ctx.GRPCClient).SubscribeTxStatus(suite.ctx.GoContext(), &tx.SubscribeTxStatusRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestConfirmTxBatchesTxStatus ensures that the statuses of transactions that
+// are confirmed concurrently are queried together through TxStatusBatch.
+func TestConfirmTxBatchesTxStatus(t *testing.T) {
+	txHashes := []string{"tx-hash-1", "tx-hash-2", "tx-hash-3"}
+	responseSequences := make(map[string][]*tx.TxStatusResponse, len(txHashes))
+	for i, txHash := range txHashes {
+		responseSequences[txHash] = []*tx.TxStatusResponse{
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: int64(100 + i)},
+		}
+	}
+
+	conn, mockServer := createMockBatchServer(t, responseSequences)
+	defer conn.Close()
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i, txHash := range txHashes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := txClient.ConfirmTx(ctx, txHash)
+			assert.NoError(t, err)
+			if assert.NotNil(t, resp) {
+				assert.Equal(t, int64(100+i), resp.Height)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mockServer.mtx.Lock()
+	defer mockServer.mtx.Unlock()
+	require.Zero(t, mockServer.txStatusCallCount)
+	require.Equal(t, len(txHashes), slices.Max(mockServer.batchedTxIDsCounts))
+}
+
+// TestConfirmTxPartialTxStatusBatchResponse ensures that ConfirmTx returns an
+// error instead of panicking when the node leaves a requested tx out of a
+// TxStatusBatch response.
+func TestConfirmTxPartialTxStatusBatchResponse(t *testing.T) {
+	txHash := "tx-hash-1"
+	conn, mockServer := createMockBatchServer(t, map[string][]*tx.TxStatusResponse{
+		txHash: {{Status: core.TxStatusCommitted, Height: 100}},
+	})
+	defer conn.Close()
+	mockServer.omittedTxIDs = map[string]bool{txHash: true}
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := txClient.ConfirmTx(ctx, txHash)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing status for tx "+txHash)
+	require.Nil(t, resp)
+}
+
+// TestConfirmTxFailedTxStatusBatch ensures that a failed TxStatusBatch call
+// falls back to TxStatus instead of failing every caller of the batch.
+func TestConfirmTxFailedTxStatusBatch(t *testing.T) {
+	txHashes := []string{"tx-hash-1", "tx-hash-2"}
+	responseSequences := make(map[string][]*tx.TxStatusResponse, len(txHashes))
+	for i, txHash := range txHashes {
+		responseSequences[txHash] = []*tx.TxStatusResponse{
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: int64(100 + i)},
+		}
+	}
+
+	conn, mockServer := createMockBatchServer(t, responseSequences)
+	defer conn.Close()
+	mockServer.batchErr = status.Error(codes.Unavailable, "transient error")
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i, txHash := range txHashes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := txClient.ConfirmTx(ctx, txHash)
+			assert.NoError(t, err)
+			if assert.NotNil(t, resp) {
+				assert.Equal(t, int64(100+i), resp.Height)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mockServer.mtx.Lock()
+	defer mockServer.mtx.Unlock()
+	require.NotZero(t, mockServer.batchCallCount)
+	require.NotZero(t, mockServer.txStatusCallCount)
+}
+
+// TestConfirmTxWithTxStatusSubscription ensures that ConfirmTx receives status
+// updates through SubscribeTxStatus instead of polling when enabled.
+func TestConfirmTxWithTxStatusSubscription(t *testing.T) {
+	txHash := "tx-hash-1"
+	conn, mockServer := createMockStreamServer(t, map[string][]*tx.TxStatusResponse{
+		txHash: {
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: 100},
+		},
+	})
+	defer conn.Close()
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(time.Hour), user.WithTxStatusSubscription())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := txClient.ConfirmTx(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), resp.Height)
+
+	mockServer.mtx.Lock()
+	defer mockServer.mtx.Unlock()
+	require.Equal(t, 1, mockServer.subscribeCallCount)
+	require.Zero(t, mockServer.batchCallCount)
+	require.Zero(t, mockServer.txStatusCallCount)
+}
+
+// TestConfirmTxWithTxStatusSubscriptionFallback ensures that ConfirmTx falls
+// back to polling if the node does not support SubscribeTxStatus.
+func TestConfirmTxWithTxStatusSubscriptionFallback(t *testing.T) {
+	txHash := "tx-hash-1"
+	conn, mockServer := createMockBatchServer(t, map[string][]*tx.TxStatusResponse{
+		txHash: {
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: 100},
+		},
+	})
+	defer conn.Close()
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithPollTime(100*time.Millisecond), user.WithTxStatusSubscription())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := txClient.ConfirmTx(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), resp.Height)
+
+	mockServer.mtx.Lock()
+	defer mockServer.mtx.Unlock()
+	require.NotZero(t, mockServer.batchCallCount)
+}
+
+// TestConfirmTxWithFeeBumpPolicy ensures that ConfirmTx replaces a transaction
+// that stays pending or gets evicted with a transaction of the same sequence and
+// a higher fee.
+func TestConfirmTxWithFeeBumpPolicy(t *testing.T) {
+	const (
+		fee      = 1000
+		gasLimit = 10000
+	)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	// setup returns a tx client and the mock server. The first transaction
+	// keeps the given status while any replacement is committed.
+	setup := func(t *testing.T, policy user.FeeBumpPolicy, originalStatus string) (*user.TxClient, *mockFeeBumpServer, *string) {
+		var originalTxHash string
+		conn, mockServer := createMockFeeBumpServer(t, func(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+			if req.TxId == originalTxHash {
+				return &tx.TxStatusResponse{Status: originalStatus}, nil
+			}
+			return &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 100}, nil
+		})
+		t.Cleanup(func() { conn.Close() })
+
+		kr := testfactory.TestKeyring(enc.Codec, "account")
+		signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+		require.NoError(t, err)
+		txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry,
+			user.WithPollTime(50*time.Millisecond), user.WithFeeBumpPolicy(policy))
+		require.NoError(t, err)
+		return txClient, mockServer, &originalTxHash
+	}
+
+	decodeTx := func(t *testing.T, txBytes []byte) sdk.FeeTx {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		sdkTx, err := enc.TxConfig.TxDecoder()(blobTx.Tx)
+		require.NoError(t, err)
+		return sdkTx.(sdk.FeeTx)
+	}
+
+	t.Run("replaces an evicted transaction with a higher fee", func(t *testing.T) {
+		txClient, mockServer, originalTxHash := setup(t, user.FeeBumpPolicy{
+			PendingBlocks:      2,
+			GasPriceMultiplier: 1.5,
+			MaxFee:             1200,
+		}, core.TxStatusEvicted)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		blobs := blobfactory.ManyRandBlobs(random.New(), 100)
+		resp, err := txClient.BroadcastPayForBlob(ctx, blobs, user.SetFee(fee), user.SetGasLimit(gasLimit))
+		require.NoError(t, err)
+		*originalTxHash = resp.TxHash
+
+		confirmed, err := txClient.ConfirmTx(ctx, resp.TxHash)
+		require.NoError(t, err)
+		require.NotEqual(t, resp.TxHash, confirmed.TxHash)
+		require.Equal(t, int64(100), confirmed.Height)
+
+		mockServer.mtx.Lock()
+		original := decodeTx(t, mockServer.broadcastTxs[resp.TxHash])
+		replacement := decodeTx(t, mockServer.broadcastTxs[confirmed.TxHash])
+		mockServer.mtx.Unlock()
+
+		// the gas price is increased by 50% but capped at the max fee
+		require.Equal(t, int64(1200), replacement.GetFee().AmountOf(appconsts.BondDenom).Int64())
+		require.Equal(t, original.GetGas(), replacement.GetGas())
+		originalSigs, err := original.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		replacementSigs, err := replacement.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, originalSigs[0].Sequence, replacementSigs[0].Sequence)
+
+		for _, txHash := range []string{resp.TxHash, confirmed.TxHash} {
+			_, _, exists := txClient.GetTxFromTxTracker(txHash)
+			require.False(t, exists)
+		}
+	})
+
+	t.Run("replaces a transaction pending for too many blocks", func(t *testing.T) {
+		txClient, mockServer, originalTxHash := setup(t, user.FeeBumpPolicy{
+			PendingBlocks:      1,
+			GasPriceMultiplier: 1.5,
+			MaxFee:             1200,
+		}, core.TxStatusPending)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+
+		blobs := blobfactory.ManyRandBlobs(random.New(), 100)
+		resp, err := txClient.BroadcastPayForBlob(ctx, blobs, user.SetFee(fee), user.SetGasLimit(gasLimit))
+		require.NoError(t, err)
+		*originalTxHash = resp.TxHash
+
+		confirmed, err := txClient.ConfirmTx(ctx, resp.TxHash)
+		require.NoError(t, err)
+		require.NotEqual(t, resp.TxHash, confirmed.TxHash)
+
+		mockServer.mtx.Lock()
+		replacement := decodeTx(t, mockServer.broadcastTxs[confirmed.TxHash])
+		mockServer.mtx.Unlock()
+		require.Equal(t, int64(1200), replacement.GetFee().AmountOf(appconsts.BondDenom).Int64())
+		// the height is only queried when the transaction is first seen pending
+		// and once the pending blocks could have been produced, not on every poll
+		require.Equal(t, uint64(2), mockServer.nodeServer.height.Load())
+	})
+
+	t.Run("resubmits the transaction once the max fee is reached", func(t *testing.T) {
+		txClient, mockServer, originalTxHash := setup(t, user.FeeBumpPolicy{
+			PendingBlocks:      1,
+			GasPriceMultiplier: 1.5,
+			MaxFee:             fee,
+		}, core.TxStatusEvicted)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		blobs := blobfactory.ManyRandBlobs(random.New(), 100)
+		resp, err := txClient.BroadcastPayForBlob(ctx, blobs, user.SetFee(fee), user.SetGasLimit(gasLimit))
+		require.NoError(t, err)
+		*originalTxHash = resp.TxHash
+
+		_, err = txClient.ConfirmTx(ctx, resp.TxHash)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		mockServer.mtx.Lock()
+		defer mockServer.mtx.Unlock()
+		require.Len(t, mockServer.broadcastTxs, 1)
+		require.Greater(t, mockServer.broadcastCallCounts[resp.TxHash], 1)
+	})
+
+	t.Run("rejects an invalid policy", func(t *testing.T) {
+		kr := testfactory.TestKeyring(enc.Codec, "account")
+		signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+		require.NoError(t, err)
+		_, err = user.NewTxClient(enc.Codec, signer, nil, enc.InterfaceRegistry, user.WithFeeBumpPolicy(user.FeeBumpPolicy{
+			PendingBlocks:      1,
+			GasPriceMultiplier: 1,
+			MaxFee:             fee,
+		}))
+		require.Error(t, err)
+	})
+}
+
 func TestRejections(t *testing.T) {
 	ttlNumBlocks := int64(5)
 	_, txClient, ctx := setupTxClient(t, ttlNumBlocks, appconsts.DefaultMaxBytes)
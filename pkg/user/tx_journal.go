@@ -0,0 +1,306 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// txJournalKeyPrefix is the prefix of the keys of the journal entries in the
+// database of a DBTxJournal.
+var txJournalKeyPrefix = []byte("tx/")
+
+// TxJournalEntry is a transaction recorded in a TxJournal.
+type TxJournalEntry struct {
+	TxHash    string    `json:"tx_hash"`
+	Signer    string    `json:"signer"`
+	Sequence  uint64    `json:"sequence"`
+	TxBytes   []byte    `json:"tx_bytes"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TxJournal persists the transactions submitted by the TxClient until they
+// reach a final status, so that they can be recovered after a restart.
+// Implementations must be safe for concurrent use and must have persisted an
+// entry once Put returns.
+type TxJournal interface {
+	// Put stores the entry, replacing any entry with the same tx hash.
+	Put(entry TxJournalEntry) error
+	// Delete removes the entry with the tx hash. Deleting an entry that
+	// doesn't exist is not an error.
+	Delete(txHash string) error
+	// Entries returns all entries in the journal.
+	Entries() ([]TxJournalEntry, error)
+}
+
+var _ TxJournal = &DBTxJournal{}
+
+// DBTxJournal is a TxJournal backed by a key-value database.
+type DBTxJournal struct {
+	db dbm.DB
+}
+
+// NewDBTxJournal returns a TxJournal that stores its entries in the database.
+func NewDBTxJournal(db dbm.DB) *DBTxJournal {
+	return &DBTxJournal{db: db}
+}
+
+// NewLevelDBTxJournal returns a TxJournal that stores its entries in a LevelDB
+// database in the given directory. The journal must be closed once it's no
+// longer used.
+func NewLevelDBTxJournal(dir string) (*DBTxJournal, error) {
+	db, err := dbm.NewGoLevelDB("tx_journal", dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx journal: %w", err)
+	}
+	return NewDBTxJournal(db), nil
+}
+
+// Put implements TxJournal. The entry is written synchronously to disk.
+func (j *DBTxJournal) Put(entry TxJournalEntry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return j.db.SetSync(txJournalKey(entry.TxHash), value)
+}
+
+// Delete implements TxJournal.
+func (j *DBTxJournal) Delete(txHash string) error {
+	return j.db.DeleteSync(txJournalKey(txHash))
+}
+
+// Entries implements TxJournal.
+func (j *DBTxJournal) Entries() ([]TxJournalEntry, error) {
+	it, err := dbm.IteratePrefix(j.db, txJournalKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var entries []TxJournalEntry
+	for ; it.Valid(); it.Next() {
+		var entry TxJournalEntry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("decoding tx journal entry %s: %w", it.Key(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, it.Error()
+}
+
+// Close closes the underlying database.
+func (j *DBTxJournal) Close() error {
+	return j.db.Close()
+}
+
+func txJournalKey(txHash string) []byte {
+	return append(slices.Clone(txJournalKeyPrefix), txHash...)
+}
+
+// journalTx records the transaction in the journal with the given status
+// before it's broadcast. The tx hash is computed locally as the transaction
+// may never reach the node.
+func (client *TxClient) journalTx(signer string, sequence uint64, txBytes []byte, status string) (string, error) {
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+	if client.txJournal == nil {
+		return txHash, nil
+	}
+	err := client.txJournal.Put(TxJournalEntry{
+		TxHash:    txHash,
+		Signer:    signer,
+		Sequence:  sequence,
+		TxBytes:   txBytes,
+		Status:    status,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("recording tx %s in the tx journal: %w", txHash, err)
+	}
+	return txHash, nil
+}
+
+// deleteFromTxJournal removes the transactions from the journal. Failures are
+// ignored, as stale entries are removed on the next reconciliation.
+func (client *TxClient) deleteFromTxJournal(txHashes ...string) {
+	if client.txJournal == nil {
+		return
+	}
+	for _, txHash := range txHashes {
+		_ = client.txJournal.Delete(txHash)
+	}
+}
+
+// journalFailedBroadcast updates the journal entry of a transaction that failed
+// to be broadcast. The entry is removed if the node rejected the transaction.
+// Other errors, such as timeouts, may occur after the node accepted the
+// transaction, so its entry is kept with an unknown status for ReconcileJournal
+// to resolve. Failures are ignored like for deleteFromTxJournal.
+func (client *TxClient) journalFailedBroadcast(signer string, sequence uint64, txBytes []byte, rejected bool) {
+	if client.txJournal == nil {
+		return
+	}
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+	if rejected {
+		_ = client.txJournal.Delete(txHash)
+		return
+	}
+	_, _ = client.journalTx(signer, sequence, txBytes, core.TxStatusUnknown)
+}
+
+// updateTxJournalStatus records the new status of the tracked transaction in
+// the journal. Failures are ignored, as the status is queried again on the
+// next reconciliation.
+func (client *TxClient) updateTxJournalStatus(txHash, status string) {
+	if client.txJournal == nil {
+		return
+	}
+	client.mtx.Lock()
+	info, exists := client.txTracker[txHash]
+	client.mtx.Unlock()
+	if !exists {
+		return
+	}
+	_, _ = client.journalTx(info.signer, info.sequence, info.txBytes, status)
+}
+
+// ReconcileJournal recovers the transactions recorded in the tx journal after a
+// restart and must be called before submitting new transactions.
+// SetupTxClient calls it automatically. The status of every entry is queried:
+//   - committed and rejected transactions are removed from the journal.
+//   - pending and evicted transactions are tracked again so they can be
+//     confirmed with ConfirmTx.
+//   - transactions unknown to the node are broadcast again if their sequence
+//     has not been used yet, and removed otherwise. If broadcasting fails, the
+//     transaction and the later unknown transactions of its signer are kept in
+//     the journal without being tracked, and the sequence of the signer is not
+//     advanced past it. The entries are removed by a later reconciliation once
+//     their sequence has been used by new transactions.
+//
+// The sequence of every signer in the journal is then set after the highest
+// sequence of its tracked transactions, or to its sequence on chain if that's
+// higher. The tracked entries are returned in sequence order per signer.
+func (client *TxClient) ReconcileJournal(ctx context.Context) ([]TxJournalEntry, error) {
+	if client.txJournal == nil {
+		return nil, nil
+	}
+	span := trace.SpanFromContext(ctx)
+
+	entries, err := client.txJournal.Entries()
+	if err != nil {
+		return nil, fmt.Errorf("reading the tx journal: %w", err)
+	}
+	slices.SortFunc(entries, func(a, b TxJournalEntry) int {
+		if c := strings.Compare(a.Signer, b.Signer); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	// The accounts are resolved under the lock, but the queries and broadcasts
+	// below are made without it.
+	addresses := make(map[string]sdktypes.AccAddress)
+	client.mtx.Lock()
+	for _, entry := range entries {
+		if _, exists := addresses[entry.Signer]; exists {
+			continue
+		}
+		account := client.signer.Account(entry.Signer)
+		if account == nil {
+			client.mtx.Unlock()
+			return nil, fmt.Errorf("tx journal entry %s: account %s not found", entry.TxHash, entry.Signer)
+		}
+		addresses[entry.Signer] = account.Address()
+	}
+	client.mtx.Unlock()
+
+	// nextSequences holds the next sequence of every signer in the journal.
+	nextSequences := make(map[string]uint64)
+	// stalled holds the signers for which a transaction could not be broadcast
+	// again. Their later transactions depend on its sequence.
+	stalled := make(map[string]bool)
+	txClient := tx.NewTxClient(client.conns[0])
+	tracked := make([]TxJournalEntry, 0, len(entries))
+	for _, entry := range entries {
+		if _, exists := nextSequences[entry.Signer]; !exists {
+			_, sequence, err := QueryAccount(ctx, client.conns[0], client.registry, addresses[entry.Signer])
+			if err != nil {
+				return nil, fmt.Errorf("querying account %s: %w", entry.Signer, err)
+			}
+			nextSequences[entry.Signer] = sequence
+		}
+
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: entry.TxHash})
+		if err != nil {
+			return nil, fmt.Errorf("querying status of tx %s: %w", entry.TxHash, err)
+		}
+
+		switch resp.Status {
+		case core.TxStatusPending, core.TxStatusEvicted:
+		case core.TxStatusCommitted, core.TxStatusRejected:
+			if err := client.txJournal.Delete(entry.TxHash); err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			if entry.Sequence < nextSequences[entry.Signer] {
+				// the sequence was used by another transaction
+				if err := client.txJournal.Delete(entry.TxHash); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if stalled[entry.Signer] {
+				continue
+			}
+			if _, err := client.sendTxToConnection(ctx, client.conns[0], entry.TxBytes); err != nil {
+				span.RecordError(fmt.Errorf("txclient/ReconcileJournal: rebroadcasting tx %s: %w", entry.TxHash, err))
+				stalled[entry.Signer] = true
+				continue
+			}
+			resp.Status = core.TxStatusPending
+		}
+
+		entry.Status = resp.Status
+		if err := client.txJournal.Put(entry); err != nil {
+			return nil, err
+		}
+		nextSequences[entry.Signer] = max(nextSequences[entry.Signer], entry.Sequence+1)
+		tracked = append(tracked, entry)
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	for _, entry := range tracked {
+		client.txTracker[entry.TxHash] = txInfo{
+			sequence:  entry.Sequence,
+			signer:    entry.Signer,
+			timestamp: time.Now(),
+			txBytes:   entry.TxBytes,
+		}
+	}
+	for signer, sequence := range nextSequences {
+		if err := client.signer.SetSequence(signer, sequence); err != nil {
+			return nil, err
+		}
+	}
+
+	span.AddEvent("txclient/ReconcileJournal: reconciled tx journal", trace.WithAttributes(
+		attribute.Int("entries", len(entries)),
+		attribute.Int("tracked", len(tracked)),
+	))
+	return tracked, nil
+}
@@ -0,0 +1,296 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v6/pkg/user"
+	"github.com/celestiaorg/celestia-app/v6/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v6/test/util/random"
+	"github.com/celestiaorg/celestia-app/v6/test/util/testfactory"
+	"github.com/cometbft/cometbft/rpc/core"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDBTxJournal(t *testing.T) {
+	entry := user.TxJournalEntry{
+		TxHash:    "ABC",
+		Signer:    "account",
+		Sequence:  1,
+		TxBytes:   []byte("tx"),
+		Status:    core.TxStatusPending,
+		Timestamp: time.Now().UTC().Round(0),
+	}
+
+	t.Run("puts, replaces and deletes entries", func(t *testing.T) {
+		journal := user.NewDBTxJournal(dbm.NewMemDB())
+		require.NoError(t, journal.Put(entry))
+
+		evicted := entry
+		evicted.Status = core.TxStatusEvicted
+		require.NoError(t, journal.Put(evicted))
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Equal(t, []user.TxJournalEntry{evicted}, entries)
+
+		require.NoError(t, journal.Delete(entry.TxHash))
+		require.NoError(t, journal.Delete(entry.TxHash))
+		entries, err = journal.Entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("persists entries across restarts", func(t *testing.T) {
+		dir := t.TempDir()
+		journal, err := user.NewLevelDBTxJournal(dir)
+		require.NoError(t, err)
+		require.NoError(t, journal.Put(entry))
+		require.NoError(t, journal.Close())
+
+		journal, err = user.NewLevelDBTxJournal(dir)
+		require.NoError(t, err)
+		defer journal.Close()
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Equal(t, []user.TxJournalEntry{entry}, entries)
+	})
+}
+
+func TestTxClientJournal(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	conn, mockServer := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 10}, nil
+	})
+	t.Cleanup(func() { conn.Close() })
+
+	newTxClient := func(t *testing.T, journal user.TxJournal) *user.TxClient {
+		kr := testfactory.TestKeyring(enc.Codec, "account")
+		signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+		require.NoError(t, err)
+		txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry,
+			user.WithPollTime(50*time.Millisecond), user.WithTxJournal(journal))
+		require.NoError(t, err)
+		return txClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("journals transactions until they are confirmed", func(t *testing.T) {
+		journal := user.NewDBTxJournal(dbm.NewMemDB())
+		txClient := newTxClient(t, journal)
+
+		resp, err := txClient.BroadcastPayForBlob(ctx, blobfactory.ManyRandBlobs(random.New(), 100))
+		require.NoError(t, err)
+
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, resp.TxHash, entries[0].TxHash)
+		require.Equal(t, "account", entries[0].Signer)
+		require.Equal(t, uint64(0), entries[0].Sequence)
+		require.Equal(t, core.TxStatusPending, entries[0].Status)
+
+		_, err = txClient.ConfirmTx(ctx, resp.TxHash)
+		require.NoError(t, err)
+		entries, err = journal.Entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("keeps a transaction whose broadcast outcome is unknown", func(t *testing.T) {
+		journal := user.NewDBTxJournal(dbm.NewMemDB())
+		txClient := newTxClient(t, journal)
+		mockServer.mtx.Lock()
+		mockServer.broadcastErr = status.Error(codes.DeadlineExceeded, "timed out")
+		mockServer.mtx.Unlock()
+		t.Cleanup(func() {
+			mockServer.mtx.Lock()
+			mockServer.broadcastErr = nil
+			mockServer.mtx.Unlock()
+		})
+
+		_, err := txClient.BroadcastPayForBlob(ctx, blobfactory.ManyRandBlobs(random.New(), 100))
+		require.Error(t, err)
+
+		// the node may have accepted the transaction before the broadcast timed
+		// out, so the entry is left for ReconcileJournal to resolve
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, core.TxStatusUnknown, entries[0].Status)
+	})
+
+	t.Run("removes a transaction rejected by the node", func(t *testing.T) {
+		journal := user.NewDBTxJournal(dbm.NewMemDB())
+		txClient := newTxClient(t, journal)
+		mockServer.mtx.Lock()
+		mockServer.broadcastCode = sdkerrors.ErrInsufficientFee.ABCICode()
+		mockServer.mtx.Unlock()
+		t.Cleanup(func() {
+			mockServer.mtx.Lock()
+			mockServer.broadcastCode = 0
+			mockServer.mtx.Unlock()
+		})
+
+		_, err := txClient.BroadcastPayForBlob(ctx, blobfactory.ManyRandBlobs(random.New(), 100))
+		var broadcastTxErr *user.BroadcastTxError
+		require.ErrorAs(t, err, &broadcastTxErr)
+
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("doesn't broadcast a transaction that can't be journaled", func(t *testing.T) {
+		txClient := newTxClient(t, failingTxJournal{})
+		mockServer.mtx.Lock()
+		broadcastTxs := len(mockServer.broadcastTxs)
+		mockServer.mtx.Unlock()
+
+		_, err := txClient.BroadcastPayForBlob(ctx, blobfactory.ManyRandBlobs(random.New(), 100))
+		require.Error(t, err)
+		mockServer.mtx.Lock()
+		defer mockServer.mtx.Unlock()
+		require.Len(t, mockServer.broadcastTxs, broadcastTxs)
+	})
+}
+
+func TestReconcileJournal(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	newEntry := func(sequence uint64, txBytes string) user.TxJournalEntry {
+		return user.TxJournalEntry{
+			TxHash:   fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+			Signer:   "account",
+			Sequence: sequence,
+			TxBytes:  []byte(txBytes),
+			Status:   core.TxStatusPending,
+		}
+	}
+	committed := newEntry(3, "committed")
+	replaced := newEntry(4, "replaced")
+	pending := newEntry(5, "pending")
+	unknown := newEntry(6, "unknown")
+
+	statuses := map[string]string{
+		committed.TxHash: core.TxStatusCommitted,
+		replaced.TxHash:  core.TxStatusUnknown,
+		pending.TxHash:   core.TxStatusPending,
+		unknown.TxHash:   core.TxStatusUnknown,
+	}
+	conn, mockServer := createMockFeeBumpServer(t, func(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: statuses[req.TxId]}, nil
+	}, func(s *grpc.Server) {
+		authtypes.RegisterQueryServer(s, &mockAuthQueryServer{
+			account: &authtypes.BaseAccount{AccountNumber: 1, Sequence: 5},
+		})
+	})
+	t.Cleanup(func() { conn.Close() })
+
+	journal := user.NewDBTxJournal(dbm.NewMemDB())
+	for _, entry := range []user.TxJournalEntry{unknown, pending, replaced, committed} {
+		require.NoError(t, journal.Put(entry))
+	}
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithTxJournal(journal))
+	require.NoError(t, err)
+
+	tracked, err := txClient.ReconcileJournal(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{pending.TxHash, unknown.TxHash}, []string{tracked[0].TxHash, tracked[1].TxHash})
+
+	// only the unknown transaction with an unused sequence is broadcast again
+	mockServer.mtx.Lock()
+	require.Equal(t, map[string][]byte{unknown.TxHash: unknown.TxBytes}, mockServer.broadcastTxs)
+	mockServer.mtx.Unlock()
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	for _, entry := range []user.TxJournalEntry{pending, unknown} {
+		sequence, _, exists := txClient.GetTxFromTxTracker(entry.TxHash)
+		require.True(t, exists)
+		require.Equal(t, entry.Sequence, sequence)
+	}
+	require.Equal(t, uint64(7), signer.Account("account").Sequence())
+}
+
+// TestReconcileJournalFailedBroadcast ensures that unknown transactions that
+// can't be broadcast again are kept in the journal and that their sequence is
+// reused.
+func TestReconcileJournalFailedBroadcast(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	newEntry := func(sequence uint64, txBytes string) user.TxJournalEntry {
+		return user.TxJournalEntry{
+			TxHash:   fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+			Signer:   "account",
+			Sequence: sequence,
+			TxBytes:  []byte(txBytes),
+			Status:   core.TxStatusPending,
+		}
+	}
+	first := newEntry(5, "first")
+	second := newEntry(6, "second")
+
+	conn, mockServer := createMockFeeBumpServer(t, func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusUnknown}, nil
+	}, func(s *grpc.Server) {
+		authtypes.RegisterQueryServer(s, &mockAuthQueryServer{
+			account: &authtypes.BaseAccount{AccountNumber: 1, Sequence: 5},
+		})
+	})
+	t.Cleanup(func() { conn.Close() })
+	mockServer.broadcastErr = status.Error(codes.Unavailable, "node unavailable")
+
+	journal := user.NewDBTxJournal(dbm.NewMemDB())
+	for _, entry := range []user.TxJournalEntry{first, second} {
+		require.NoError(t, journal.Put(entry))
+	}
+
+	kr := testfactory.TestKeyring(enc.Codec, "account")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain-id", user.NewAccount("account", 1, 0))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, user.WithTxJournal(journal))
+	require.NoError(t, err)
+
+	tracked, err := txClient.ReconcileJournal(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, tracked)
+
+	// the second transaction depends on the first one so it isn't broadcast
+	mockServer.mtx.Lock()
+	require.Equal(t, map[string]int{first.TxHash: 1}, mockServer.broadcastCallCounts)
+	mockServer.mtx.Unlock()
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(5), signer.Account("account").Sequence())
+}
+
+// failingTxJournal is a TxJournal whose writes always fail.
+type failingTxJournal struct{}
+
+func (failingTxJournal) Put(user.TxJournalEntry) error { return errors.New("disk full") }
+
+func (failingTxJournal) Delete(string) error { return nil }
+
+func (failingTxJournal) Entries() ([]user.TxJournalEntry, error) { return nil, nil }
@@ -0,0 +1,263 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// txStatusPollTimeoutFactor is multiplied with the poll time to bound a
+	// single round of status queries performed by the txStatusPoller.
+	txStatusPollTimeoutFactor = 5
+	// minTxStatusPollTimeout is the lower bound of the timeout of a single round
+	// of status queries so that short poll times don't cause every query to
+	// time out.
+	minTxStatusPollTimeout = time.Second
+)
+
+// txStatusUpdate is the result of querying the status of a single transaction.
+type txStatusUpdate struct {
+	resp *tx.TxStatusResponse
+	err  error
+}
+
+// txStatusUpdateFromResult converts a per transaction result of TxStatusBatch or
+// SubscribeTxStatus into a txStatusUpdate.
+func txStatusUpdateFromResult(result *tx.TxStatusResult) txStatusUpdate {
+	switch {
+	case result.QueryError != "":
+		return txStatusUpdate{err: fmt.Errorf("querying status of tx %s: %s", result.TxId, result.QueryError)}
+	case result.Status == nil:
+		return txStatusUpdate{err: fmt.Errorf("missing status for tx %s", result.TxId)}
+	default:
+		return txStatusUpdate{resp: result.Status}
+	}
+}
+
+// txStatusSubscription is a single ConfirmTx call waiting for status updates of
+// a transaction.
+type txStatusSubscription struct {
+	// ctx is the context of the ConfirmTx call. Its values (trace span, gRPC
+	// metadata) are propagated to TxStatus calls made on its behalf.
+	ctx     context.Context
+	txHash  string
+	updates chan txStatusUpdate
+	// polled is true once the subscription has received at least one update.
+	polled bool
+}
+
+// txStatusPoller periodically queries the status of every transaction that is
+// currently being confirmed by the TxClient. All outstanding transactions are
+// queried through a single TxStatusBatch call per poll. If the node does not
+// support TxStatusBatch, or a batch fails, the poller falls back to one
+// TxStatus call per transaction.
+//
+// Because a batch is shared by many callers, TxStatusBatch calls don't inherit
+// the cancellation or gRPC metadata of any single ConfirmTx call. They are
+// bounded by a timeout derived from the poll time instead, and every caller's
+// trace span records an event for each batch its transaction was part of.
+type txStatusPoller struct {
+	mtx           sync.Mutex
+	conn          *grpc.ClientConn
+	pollTime      time.Duration
+	subscriptions map[*txStatusSubscription]struct{}
+	running       bool
+	// kick wakes up the poll loop so that new subscriptions don't have to wait
+	// for the next tick to receive their first update.
+	kick chan struct{}
+	// batchUnsupported is set once the node responded that it doesn't
+	// implement TxStatusBatch.
+	batchUnsupported bool
+}
+
+func newTxStatusPoller(conn *grpc.ClientConn, pollTime time.Duration) *txStatusPoller {
+	return &txStatusPoller{
+		conn:          conn,
+		pollTime:      pollTime,
+		subscriptions: make(map[*txStatusSubscription]struct{}),
+		kick:          make(chan struct{}, 1),
+	}
+}
+
+// subscribe registers the transaction for polling and returns a channel on which
+// its status updates are delivered. Only the latest update is kept if the
+// receiver falls behind. The returned function must be called once the caller
+// is no longer interested in updates.
+func (p *txStatusPoller) subscribe(ctx context.Context, txHash string) (<-chan txStatusUpdate, func()) {
+	sub := &txStatusSubscription{
+		ctx:     ctx,
+		txHash:  txHash,
+		updates: make(chan txStatusUpdate, 1),
+	}
+
+	p.mtx.Lock()
+	p.subscriptions[sub] = struct{}{}
+	if !p.running {
+		p.running = true
+		go p.run()
+	}
+	p.mtx.Unlock()
+
+	select {
+	case p.kick <- struct{}{}:
+	default:
+	}
+
+	return sub.updates, func() {
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+		delete(p.subscriptions, sub)
+	}
+}
+
+// run polls until there are no subscriptions left.
+func (p *txStatusPoller) run() {
+	ticker := time.NewTicker(p.pollTime)
+	defer ticker.Stop()
+
+	onlyNew := true
+	for {
+		pending := p.pendingSubscriptions(onlyNew)
+		if pending == nil {
+			return
+		}
+		if len(pending) > 0 {
+			p.dispatch(p.poll(pending))
+		}
+
+		select {
+		case <-ticker.C:
+			onlyNew = false
+		case <-p.kick:
+			onlyNew = true
+		}
+	}
+}
+
+// pendingSubscriptions returns the subscribed transactions keyed by hash. If
+// onlyNew is set, only transactions that have not been polled yet are
+// returned. It returns nil and marks the poller as stopped if there are no
+// subscriptions.
+func (p *txStatusPoller) pendingSubscriptions(onlyNew bool) map[string][]*txStatusSubscription {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if len(p.subscriptions) == 0 {
+		p.running = false
+		return nil
+	}
+
+	pending := make(map[string][]*txStatusSubscription, len(p.subscriptions))
+	for sub := range p.subscriptions {
+		if onlyNew && sub.polled {
+			continue
+		}
+		pending[sub.txHash] = append(pending[sub.txHash], sub)
+	}
+	return pending
+}
+
+// poll queries the status of the pending transactions.
+func (p *txStatusPoller) poll(pending map[string][]*txStatusSubscription) map[string]txStatusUpdate {
+	timeout := max(txStatusPollTimeoutFactor*p.pollTime, minTxStatusPollTimeout)
+	txClient := tx.NewTxClient(p.conn)
+
+	hashes := make([]string, 0, len(pending))
+	for txHash := range pending {
+		hashes = append(hashes, txHash)
+	}
+	updates := make(map[string]txStatusUpdate, len(hashes))
+
+	p.mtx.Lock()
+	useBatch := !p.batchUnsupported
+	p.mtx.Unlock()
+
+	// individual are the transactions queried through TxStatus.
+	var individual []string
+	for start := 0; useBatch && start < len(hashes); start += tx.MaxTxStatusBatchSize {
+		chunk := hashes[start:min(start+tx.MaxTxStatusBatchSize, len(hashes))]
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		resp, err := txClient.TxStatusBatch(ctx, &tx.TxStatusBatchRequest{TxIds: chunk})
+		cancel()
+		if status.Code(err) == codes.Unimplemented {
+			p.mtx.Lock()
+			p.batchUnsupported = true
+			p.mtx.Unlock()
+			useBatch = false
+			break
+		}
+		for _, txHash := range chunk {
+			for _, sub := range pending[txHash] {
+				trace.SpanFromContext(sub.ctx).AddEvent("txclient/txStatusPoller: queried TxStatusBatch", trace.WithAttributes(
+					attribute.Int("batch_size", len(chunk)),
+					attribute.Bool("success", err == nil),
+				))
+			}
+		}
+		if err != nil {
+			// The batch is shared by many callers, so a failed batch falls back
+			// to querying every transaction on its own below. A transient error
+			// then only fails the callers whose own query fails too.
+			individual = append(individual, chunk...)
+			continue
+		}
+		for _, result := range resp.Statuses {
+			updates[result.TxId] = txStatusUpdateFromResult(result)
+		}
+		for _, txHash := range chunk {
+			if _, ok := updates[txHash]; !ok {
+				updates[txHash] = txStatusUpdate{err: fmt.Errorf("missing status for tx %s", txHash)}
+			}
+		}
+	}
+
+	if !useBatch {
+		individual = individual[:0]
+		for _, txHash := range hashes {
+			if _, ok := updates[txHash]; !ok {
+				individual = append(individual, txHash)
+			}
+		}
+	}
+	for _, txHash := range individual {
+		// Query on behalf of the first subscriber so that its trace span and
+		// metadata reach the node. Cancellation is not inherited since the
+		// result is shared with the other subscribers of the same hash.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(pending[txHash][0].ctx), timeout)
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		cancel()
+		updates[txHash] = txStatusUpdate{resp: resp, err: err}
+	}
+
+	return updates
+}
+
+// dispatch delivers the updates to all subscriptions of the respective
+// transactions, replacing any update that has not been consumed yet.
+func (p *txStatusPoller) dispatch(updates map[string]txStatusUpdate) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for sub := range p.subscriptions {
+		update, ok := updates[sub.txHash]
+		if !ok {
+			continue
+		}
+		sub.polled = true
+		select {
+		case <-sub.updates:
+		default:
+		}
+		sub.updates <- update
+	}
+}
@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/v6/app/grpc/tx"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// txStatusUpdates returns a channel on which status updates of the transaction
+// are delivered. If the client was configured with WithTxStatusSubscription and
+// the node supports it, the updates are pushed by the node through
+// SubscribeTxStatus. Otherwise they come from the txStatusPoller. The returned
+// function must be called once the caller is no longer interested in updates.
+func (client *TxClient) txStatusUpdates(ctx context.Context, txHash string) (<-chan txStatusUpdate, func()) {
+	if client.txStatusSubscription && !client.txStatusSubscriptionUnsupported.Load() {
+		if updates, cancel, ok := client.subscribeTxStatus(ctx, txHash); ok {
+			return updates, cancel
+		}
+	}
+	return client.txStatusPoller.subscribe(ctx, txHash)
+}
+
+// subscribeTxStatus opens a SubscribeTxStatus stream for the transaction and
+// forwards its updates. Only the latest update is kept if the receiver falls
+// behind. It returns false if the node does not support the stream, in which
+// case the caller should fall back to polling.
+func (client *TxClient) subscribeTxStatus(ctx context.Context, txHash string) (<-chan txStatusUpdate, func(), bool) {
+	span := trace.SpanFromContext(ctx)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	updates := make(chan txStatusUpdate, 1)
+	send := func(update txStatusUpdate) {
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- update
+	}
+
+	stream, err := tx.NewTxClient(client.conns[0]).SubscribeTxStatus(streamCtx, &tx.SubscribeTxStatusRequest{TxIds: []string{txHash}})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			client.txStatusSubscriptionUnsupported.Store(true)
+			cancel()
+			return nil, nil, false
+		}
+		send(txStatusUpdate{err: err})
+		return updates, cancel, true
+	}
+
+	// Errors of server streams, including Unimplemented, surface on the first
+	// Recv rather than when opening the stream.
+	resp, err := stream.Recv()
+	if status.Code(err) == codes.Unimplemented {
+		span.AddEvent("txclient/subscribeTxStatus: node does not support SubscribeTxStatus, falling back to polling")
+		client.txStatusSubscriptionUnsupported.Store(true)
+		cancel()
+		return nil, nil, false
+	}
+
+	go func() {
+		for {
+			if err != nil {
+				// The node closes the stream once the transaction reached a final
+				// status, which has been delivered already.
+				if !errors.Is(err, io.EOF) {
+					send(txStatusUpdate{err: err})
+				}
+				return
+			}
+			for _, result := range resp.Statuses {
+				if result.TxId == txHash {
+					send(txStatusUpdateFromResult(result))
+				}
+			}
+			resp, err = stream.Recv()
+		}
+	}()
+
+	return updates, cancel, true
+}
@@ -45,6 +45,7 @@ type Tree interface {
 	Root() ([]byte, error)
 	Push(namespacedData namespace.PrefixedData) error
 	ProveRange(start, end int) (nmt.Proof, error)
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -127,6 +128,13 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle range proof for all leaves of the given
+// namespace. If the namespace is within the namespace range of the tree but
+// has no leaves, the returned proof is a proof of absence.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(nID)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
@@ -178,3 +178,29 @@ func TestErasuredNamespacedMerkleTree_ProveRange(t *testing.T) {
 		}
 	}
 }
+
+// TestErasuredNamespacedMerkleTree_ProveNamespace checks that the proof returned
+// by ProveNamespace covers all shares of a namespace in the original data.
+func TestErasuredNamespacedMerkleTree_ProveNamespace(t *testing.T) {
+	squareSize := 8
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), 0)
+	data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+	for _, d := range data {
+		err := tree.Push(d)
+		assert.NoError(t, err)
+	}
+
+	root, err := tree.Root()
+	assert.NoError(t, err)
+	for i := range squareSize {
+		namespaceID := nmtnamespace.ID(data[i][:share.NamespaceSize])
+		proof, err := tree.ProveNamespace(namespaceID)
+		assert.NoError(t, err)
+		assert.False(t, proof.IsOfAbsence())
+		assert.Equal(t, i, proof.Start())
+		assert.Equal(t, i+1, proof.End())
+
+		leaf := append(append([]byte{}, namespaceID...), data[i]...)
+		assert.True(t, proof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespaceID, [][]byte{leaf}, root))
+	}
+}
@@ -41,6 +41,7 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		a.IBCKeeper,
 		a.MinFeeKeeper,
 		&a.CircuitKeeper,
+		a.NsRegistryKeeper,
 		a.GovParamFilters(),
 	)
 
@@ -49,6 +50,8 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		a.GetEncodingConfig().TxConfig,
 		a.MaxEffectiveSquareSize(sdkCtx),
 		appconsts.SubtreeRootThreshold,
+		a.MaxNamespaceShares(sdkCtx),
+		nil,
 	)
 	if err != nil {
 		panic(err)
@@ -34,6 +34,10 @@ const (
 	// submitting multiple blobs.
 	FlagFileInput = "input-file"
 
+	// FlagSponsor allows the user to have the fees of a PayForBlob paid by a
+	// sponsor that allows the signer.
+	FlagSponsor = "sponsor"
+
 	// FileInputExtension is the only file extension supported for
 	// FlagFileInput.
 	FileInputExtension = ".json"
@@ -153,6 +157,7 @@ The blob must be a hex encoded string of non-zero length.
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
 	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	cmd.PersistentFlags().String(FlagSponsor, "", "Specify the address of the sponsor that pays the fees")
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 	return cmd
 }
@@ -212,6 +217,11 @@ func broadcastPFB(cmd *cobra.Command, b ...*share.Blob) error {
 		return err
 	}
 
+	pfbMsg.Sponsor, err = cmd.Flags().GetString(FlagSponsor)
+	if err != nil {
+		return err
+	}
+
 	// run message checks
 	if err = pfbMsg.ValidateBasic(); err != nil {
 		return err
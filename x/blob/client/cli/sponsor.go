@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDepositSponsorFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-sponsor-funds [amount]",
+		Short: "Deposit funds that pay the fees of the PayForBlobs sponsored by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositSponsorFunds(clientCtx.FromAddress.String(), amount)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdWithdrawSponsorFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-sponsor-funds [amount]",
+		Short: "Withdraw funds deposited by the sender as a sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSponsorFunds(clientCtx.FromAddress.String(), amount)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateSponsoredSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-sponsored-signers [signer...]",
+		Short: "Replace the signers whose PayForBlobs fees the sender pays. No signers stops sponsoring",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSponsoredSigners(clientCtx.FromAddress.String(), args)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuerySponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor [address]",
+		Short: "shows the remaining balance and the allowed signers of a sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Sponsor(context.Background(), &types.QuerySponsorRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySponsorsBySigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsors-by-signer [signer]",
+		Short: "shows the sponsors that pay the fees of a signer and their remaining balances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SponsorsBySigner(context.Background(), &types.QuerySponsorsBySignerRequest{
+				Signer:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsors-by-signer")
+
+	return cmd
+}
@@ -18,7 +18,12 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdPayForBlob())
+	cmd.AddCommand(
+		CmdPayForBlob(),
+		CmdDepositSponsorFunds(),
+		CmdWithdrawSponsorFunds(),
+		CmdUpdateSponsoredSigners(),
+	)
 
 	return cmd
 }
@@ -15,6 +15,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
 	}
 	k.SetParams(sdkCtx, genState.Params)
+	for _, sponsor := range genState.Sponsors {
+		k.setSponsor(sdkCtx, sponsor)
+	}
 	return nil
 }
 
@@ -23,5 +26,9 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(sdkCtx)
+	k.IterateSponsors(sdkCtx, func(sponsor types.Sponsor) bool {
+		genesis.Sponsors = append(genesis.Sponsors, sponsor)
+		return false
+	})
 	return genesis
 }
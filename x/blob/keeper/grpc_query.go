@@ -1,7 +1,61 @@
 package keeper
 
 import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
 	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
+
+// Sponsor returns the deposited balance and the allowed signers of a sponsor.
+func (k Keeper) Sponsor(c context.Context, req *types.QuerySponsorRequest) (*types.QuerySponsorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sponsor address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsor, found := k.GetSponsor(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "sponsor %s not found", req.Address)
+	}
+	return &types.QuerySponsorResponse{Sponsor: sponsor}, nil
+}
+
+// SponsorsBySigner returns the sponsors that pay the MsgPayForBlobs fees of a
+// signer.
+func (k Keeper) SponsorsBySigner(c context.Context, req *types.QuerySponsorsBySignerRequest) (*types.QuerySponsorsBySignerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signer address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sponsors []types.Sponsor
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerSponsorPrefix(signer))
+	pageRes, err := query.Paginate(store, req.Pagination, func(address, _ []byte) error {
+		sponsor, found := k.GetSponsor(ctx, address)
+		if !found {
+			return status.Errorf(codes.Internal, "signer index references unknown sponsor %s", sdk.AccAddress(address))
+		}
+		sponsors = append(sponsors, sponsor)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorsBySignerResponse{Sponsors: sponsors, Pagination: pageRes}, nil
+}
@@ -22,6 +22,7 @@ type Keeper struct {
 	cdc            codec.Codec
 	storeKey       storetypes.StoreKey
 	legacySubspace paramtypes.Subspace
+	bankKeeper     types.BankKeeper
 	authority      string
 }
 
@@ -29,6 +30,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	legacySubspace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) *Keeper {
 	if !legacySubspace.HasKeyTable() {
@@ -39,6 +41,7 @@ func NewKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		legacySubspace: legacySubspace,
+		bankKeeper:     bankKeeper,
 		authority:      authority,
 	}
 }
@@ -88,3 +91,86 @@ func (k Keeper) UpdateBlobParams(goCtx context.Context, msg *types.MsgUpdateBlob
 
 	return &types.MsgUpdateBlobParamsResponse{}, nil
 }
+
+// DepositSponsorFunds deposits funds in the blob module account that pay the
+// fees of the MsgPayForBlobs sponsored by the sponsor.
+func (k Keeper) DepositSponsorFunds(goCtx context.Context, msg *types.MsgDepositSponsorFunds) (*types.MsgDepositSponsorFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, types.ModuleName, msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "failed to deposit sponsor funds")
+	}
+
+	sponsor, found := k.GetSponsor(ctx, sponsorAddr)
+	if !found {
+		sponsor = types.Sponsor{Address: msg.Sponsor}
+	}
+	sponsor.Balance = sponsor.Balance.Add(msg.Amount...)
+	k.setSponsor(ctx, sponsor)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewDepositSponsorFundsEvent(msg.Sponsor, msg.Amount),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositSponsorFundsResponse{}, nil
+}
+
+// WithdrawSponsorFunds returns funds deposited by the sponsor to the sponsor.
+func (k Keeper) WithdrawSponsorFunds(goCtx context.Context, msg *types.MsgWithdrawSponsorFunds) (*types.MsgWithdrawSponsorFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	sponsor, found := k.GetSponsor(ctx, sponsorAddr)
+	if !found {
+		return nil, errors.Wrapf(types.ErrSponsorNotFound, "%s", msg.Sponsor)
+	}
+	balance, isNegative := sponsor.Balance.SafeSub(msg.Amount...)
+	if isNegative {
+		return nil, errors.Wrapf(types.ErrInsufficientSponsorFunds, "balance %s is smaller than %s", sponsor.Balance, msg.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsorAddr, msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "failed to withdraw sponsor funds")
+	}
+	sponsor.Balance = balance
+	if sponsor.IsEmpty() {
+		k.deleteSponsor(ctx, sponsor)
+	} else {
+		k.setSponsor(ctx, sponsor)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewWithdrawSponsorFundsEvent(msg.Sponsor, msg.Amount),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawSponsorFundsResponse{}, nil
+}
+
+// UpdateSponsoredSigners replaces the signers whose MsgPayForBlobs fees the
+// sponsor pays.
+func (k Keeper) UpdateSponsoredSigners(goCtx context.Context, msg *types.MsgUpdateSponsoredSigners) (*types.MsgUpdateSponsoredSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor, found := k.GetSponsor(ctx, sdk.MustAccAddressFromBech32(msg.Sponsor))
+	if found {
+		// the index of the previous signers is removed along with the sponsor
+		k.deleteSponsor(ctx, sponsor)
+	} else {
+		sponsor = types.Sponsor{Address: msg.Sponsor}
+	}
+	sponsor.AllowedSigners = msg.AllowedSigners
+	if !sponsor.IsEmpty() {
+		k.setSponsor(ctx, sponsor)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewUpdateSponsoredSignersEvent(sponsor)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSponsoredSignersResponse{}, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetSponsor returns the sponsor with the given address and whether it
+// exists.
+func (k Keeper) GetSponsor(ctx sdk.Context, address sdk.AccAddress) (types.Sponsor, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SponsorKey(address))
+	if bz == nil {
+		return types.Sponsor{}, false
+	}
+
+	var sponsor types.Sponsor
+	k.cdc.MustUnmarshal(bz, &sponsor)
+	return sponsor, true
+}
+
+// setSponsor stores the sponsor along with its entries in the index of the
+// sponsors of a signer. If the allowed signers of the sponsor changed, the
+// previous sponsor must have been removed with deleteSponsor.
+func (k Keeper) setSponsor(ctx sdk.Context, sponsor types.Sponsor) {
+	store := ctx.KVStore(k.storeKey)
+	address := sdk.MustAccAddressFromBech32(sponsor.Address)
+	store.Set(types.SponsorKey(address), k.cdc.MustMarshal(&sponsor))
+	for _, signer := range sponsor.AllowedSigners {
+		store.Set(types.SignerSponsorKey(sdk.MustAccAddressFromBech32(signer), address), []byte{})
+	}
+}
+
+// deleteSponsor removes the sponsor along with its entries in the index of
+// the sponsors of a signer.
+func (k Keeper) deleteSponsor(ctx sdk.Context, sponsor types.Sponsor) {
+	store := ctx.KVStore(k.storeKey)
+	address := sdk.MustAccAddressFromBech32(sponsor.Address)
+	store.Delete(types.SponsorKey(address))
+	for _, signer := range sponsor.AllowedSigners {
+		store.Delete(types.SignerSponsorKey(sdk.MustAccAddressFromBech32(signer), address))
+	}
+}
+
+// IterateSponsors iterates over all sponsors in address order until cb
+// returns true.
+func (k Keeper) IterateSponsors(ctx sdk.Context, cb func(sponsor types.Sponsor) (stop bool)) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SponsorKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsor types.Sponsor
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsor)
+		if cb(sponsor) {
+			return
+		}
+	}
+}
+
+// DeductSponsoredFee pays the fee of a transaction signed by signer from the
+// funds deposited by the sponsor to the fee collector. It returns an error if
+// the sponsor does not sponsor signer or its balance does not cover the fee.
+func (k Keeper) DeductSponsoredFee(ctx sdk.Context, sponsorAddr, signer string, fee sdk.Coins) error {
+	sponsor, found := k.GetSponsor(ctx, sdk.MustAccAddressFromBech32(sponsorAddr))
+	if !found {
+		return errors.Wrapf(types.ErrSponsorNotFound, "%s", sponsorAddr)
+	}
+	if !sponsor.Sponsors(signer) {
+		return errors.Wrapf(types.ErrSignerNotSponsored, "%s does not sponsor %s", sponsorAddr, signer)
+	}
+	balance, isNegative := sponsor.Balance.SafeSub(fee...)
+	if isNegative {
+		return errors.Wrapf(types.ErrInsufficientSponsorFunds, "balance %s of %s does not cover fee %s", sponsor.Balance, sponsorAddr, fee)
+	}
+
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+			return err
+		}
+	}
+	sponsor.Balance = balance
+	// the sponsor still has allowed signers so it is never empty
+	k.setSponsor(ctx, sponsor)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewPaySponsoredFeeEvent(sponsorAddr, signer, fee))
+}
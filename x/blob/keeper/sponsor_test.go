@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsor(t *testing.T) {
+	bank := &mockBankKeeper{}
+	k, _, ctx := createKeeperWithBank(t, appconsts.Version, bank)
+	sponsor := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	signer := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	stranger := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+
+	_, err := k.DepositSponsorFunds(ctx, types.NewMsgDepositSponsorFunds(sponsor.String(), utia(1000)))
+	require.NoError(t, err)
+	_, err = k.UpdateSponsoredSigners(ctx, types.NewMsgUpdateSponsoredSigners(sponsor.String(), []string{signer}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), bank.deposited)
+
+	res, err := k.SponsorsBySigner(ctx, &types.QuerySponsorsBySignerRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Len(t, res.Sponsors, 1)
+	assert.Equal(t, utia(1000), res.Sponsors[0].Balance)
+
+	t.Run("deducts the fee of a sponsored signer", func(t *testing.T) {
+		require.NoError(t, k.DeductSponsoredFee(ctx, sponsor.String(), signer, utia(400)))
+		got, found := k.GetSponsor(ctx, sponsor)
+		require.True(t, found)
+		assert.Equal(t, utia(600), got.Balance)
+		assert.Equal(t, int64(400), bank.collected)
+	})
+
+	t.Run("rejects a signer that is not sponsored", func(t *testing.T) {
+		err := k.DeductSponsoredFee(ctx, sponsor.String(), stranger, utia(1))
+		require.ErrorIs(t, err, types.ErrSignerNotSponsored)
+	})
+
+	t.Run("rejects a fee above the balance", func(t *testing.T) {
+		err := k.DeductSponsoredFee(ctx, sponsor.String(), signer, utia(601))
+		require.ErrorIs(t, err, types.ErrInsufficientSponsorFunds)
+		_, err = k.WithdrawSponsorFunds(ctx, types.NewMsgWithdrawSponsorFunds(sponsor.String(), utia(601)))
+		require.ErrorIs(t, err, types.ErrInsufficientSponsorFunds)
+	})
+
+	t.Run("removes the signer index when signers are replaced", func(t *testing.T) {
+		_, err := k.UpdateSponsoredSigners(ctx, types.NewMsgUpdateSponsoredSigners(sponsor.String(), []string{stranger}))
+		require.NoError(t, err)
+		res, err := k.SponsorsBySigner(ctx, &types.QuerySponsorsBySignerRequest{Signer: signer})
+		require.NoError(t, err)
+		assert.Empty(t, res.Sponsors)
+		res, err = k.SponsorsBySigner(ctx, &types.QuerySponsorsBySignerRequest{Signer: stranger})
+		require.NoError(t, err)
+		assert.Len(t, res.Sponsors, 1)
+	})
+
+	t.Run("removes an empty sponsor", func(t *testing.T) {
+		_, err := k.UpdateSponsoredSigners(ctx, types.NewMsgUpdateSponsoredSigners(sponsor.String(), nil))
+		require.NoError(t, err)
+		_, err = k.WithdrawSponsorFunds(ctx, types.NewMsgWithdrawSponsorFunds(sponsor.String(), utia(600)))
+		require.NoError(t, err)
+		_, found := k.GetSponsor(ctx, sponsor)
+		assert.False(t, found)
+		assert.Equal(t, int64(600), bank.withdrawn)
+	})
+}
+
+func TestSponsorGenesis(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.Version)
+	sponsor := types.Sponsor{
+		Address:        sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(),
+		Balance:        utia(10),
+		AllowedSigners: []string{sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()},
+	}
+	genesis := types.DefaultGenesis()
+	genesis.Sponsors = []types.Sponsor{sponsor}
+	require.NoError(t, genesis.Validate())
+
+	require.NoError(t, k.InitGenesis(ctx, *genesis))
+	assert.Equal(t, []types.Sponsor{sponsor}, k.ExportGenesis(ctx).Sponsors)
+}
+
+func utia(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(amount)))
+}
+
+// mockBankKeeper records the amounts moved in and out of the blob module
+// account.
+type mockBankKeeper struct {
+	deposited, withdrawn, collected int64
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	m.deposited += amt.AmountOf(appconsts.BondDenom).Int64()
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+	m.withdrawn += amt.AmountOf(appconsts.BondDenom).Int64()
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, _, recipientModule string, amt sdk.Coins) error {
+	if recipientModule == authtypes.FeeCollectorName {
+		m.collected += amt.AmountOf(appconsts.BondDenom).Int64()
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
 		&MsgUpdateBlobParams{},
+		&MsgDepositSponsorFunds{},
+		&MsgWithdrawSponsorFunds{},
+		&MsgUpdateSponsoredSigners{},
 	)
 
 	registry.RegisterInterface(
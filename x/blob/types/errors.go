@@ -34,7 +34,11 @@ var (
 	ErrInvalidNamespace               = errors.Register(ModuleName, 11136, "invalid namespace")
 	ErrInvalidNamespaceVersion        = errors.Register(ModuleName, 11137, "invalid namespace version")
 	// ErrTotalBlobSizeTooLarge is deprecated; use ErrBlobsTooLarge instead.
-	ErrTotalBlobSizeTooLarge = errors.Register(ModuleName, 11138, "total blob size too large")
-	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
-	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrTotalBlobSizeTooLarge    = errors.Register(ModuleName, 11138, "total blob size too large")
+	ErrBlobsTooLarge            = errors.Register(ModuleName, 11139, "blob(s) too large")
+	ErrInvalidBlobSigner        = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrSponsorNotFound          = errors.Register(ModuleName, 11141, "sponsor not found")
+	ErrSignerNotSponsored       = errors.Register(ModuleName, 11142, "signer is not sponsored")
+	ErrInsufficientSponsorFunds = errors.Register(ModuleName, 11143, "insufficient sponsor funds")
+	ErrInvalidSponsoredTx       = errors.Register(ModuleName, 11144, "invalid sponsored transaction")
 )
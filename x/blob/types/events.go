@@ -1,12 +1,17 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
 var (
-	EventTypePayForBlob       = proto.MessageName(&EventPayForBlobs{})
-	EventTypeUpdateBlobParams = proto.MessageName(&EventUpdateBlobParams{})
+	EventTypePayForBlob             = proto.MessageName(&EventPayForBlobs{})
+	EventTypeUpdateBlobParams       = proto.MessageName(&EventUpdateBlobParams{})
+	EventTypeDepositSponsorFunds    = proto.MessageName(&EventDepositSponsorFunds{})
+	EventTypeWithdrawSponsorFunds   = proto.MessageName(&EventWithdrawSponsorFunds{})
+	EventTypeUpdateSponsoredSigners = proto.MessageName(&EventUpdateSponsoredSigners{})
+	EventTypePaySponsoredFee        = proto.MessageName(&EventPaySponsoredFee{})
 )
 
 // NewPayForBlobsEvent returns a new EventPayForBlobs
@@ -25,3 +30,36 @@ func NewUpdateBlobParamsEvent(authority string, params Params) *EventUpdateBlobP
 		Params: params,
 	}
 }
+
+// NewDepositSponsorFundsEvent returns a new EventDepositSponsorFunds
+func NewDepositSponsorFundsEvent(sponsor string, amount sdk.Coins) *EventDepositSponsorFunds {
+	return &EventDepositSponsorFunds{
+		Sponsor: sponsor,
+		Amount:  amount,
+	}
+}
+
+// NewWithdrawSponsorFundsEvent returns a new EventWithdrawSponsorFunds
+func NewWithdrawSponsorFundsEvent(sponsor string, amount sdk.Coins) *EventWithdrawSponsorFunds {
+	return &EventWithdrawSponsorFunds{
+		Sponsor: sponsor,
+		Amount:  amount,
+	}
+}
+
+// NewUpdateSponsoredSignersEvent returns a new EventUpdateSponsoredSigners
+func NewUpdateSponsoredSignersEvent(sponsor Sponsor) *EventUpdateSponsoredSigners {
+	return &EventUpdateSponsoredSigners{
+		Sponsor:        sponsor.Address,
+		AllowedSigners: sponsor.AllowedSigners,
+	}
+}
+
+// NewPaySponsoredFeeEvent returns a new EventPaySponsoredFee
+func NewPaySponsoredFeeEvent(sponsor, signer string, fee sdk.Coins) *EventPaySponsoredFee {
+	return &EventPaySponsoredFee{
+		Sponsor: sponsor,
+		Signer:  signer,
+		Fee:     fee,
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -13,5 +15,19 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Sponsors))
+	for _, sponsor := range gs.Sponsors {
+		if err := sponsor.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[sponsor.Address]; ok {
+			return fmt.Errorf("duplicate sponsor %s", sponsor.Address)
+		}
+		seen[sponsor.Address] = struct{}{}
+	}
+	return nil
 }
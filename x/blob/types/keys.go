@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "blob"
@@ -14,6 +19,32 @@ const (
 	ParamsKey = "params"
 )
 
+var (
+	// SponsorKeyPrefix prefixes the sponsors, keyed by sponsor address.
+	SponsorKeyPrefix = []byte{0x01}
+
+	// SignerSponsorKeyPrefix prefixes the index of the sponsors of a signer,
+	// keyed by signer and sponsor address.
+	SignerSponsorKeyPrefix = []byte{0x02}
+)
+
+// SponsorKey returns the store key of a sponsor.
+func SponsorKey(sponsor sdk.AccAddress) []byte {
+	return append(append([]byte{}, SponsorKeyPrefix...), sponsor...)
+}
+
+// SignerSponsorKey returns the store key of a sponsor in the index of the
+// sponsors of a signer.
+func SignerSponsorKey(signer, sponsor sdk.AccAddress) []byte {
+	return append(SignerSponsorPrefix(signer), sponsor...)
+}
+
+// SignerSponsorPrefix returns the prefix of all sponsors of signer in the
+// index of the sponsors of a signer.
+func SignerSponsorPrefix(signer sdk.AccAddress) []byte {
+	return append(append([]byte{}, SignerSponsorKeyPrefix...), address.MustLengthPrefix(signer)...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
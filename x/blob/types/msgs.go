@@ -1,10 +1,22 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSponsoredSigners is the maximum number of allowed signers of a sponsor.
+// It bounds the work done by the ante handler for every sponsored
+// MsgPayForBlobs.
+const MaxSponsoredSigners = 100
 
 var (
 	_ sdk.Msg = (*MsgPayForBlobs)(nil)
 	_ sdk.Msg = (*MsgUpdateBlobParams)(nil)
+	_ sdk.Msg = (*MsgDepositSponsorFunds)(nil)
+	_ sdk.Msg = (*MsgWithdrawSponsorFunds)(nil)
+	_ sdk.Msg = (*MsgUpdateSponsoredSigners)(nil)
 )
 
 // NewMsgUpdateBlobParams creates a new MsgUpdateBlobParams instance.
@@ -14,3 +26,79 @@ func NewMsgUpdateBlobParams(authority string, params Params) *MsgUpdateBlobParam
 		Params:    params,
 	}
 }
+
+// NewMsgDepositSponsorFunds creates a new MsgDepositSponsorFunds instance.
+func NewMsgDepositSponsorFunds(sponsor string, amount sdk.Coins) *MsgDepositSponsorFunds {
+	return &MsgDepositSponsorFunds{
+		Sponsor: sponsor,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgDepositSponsorFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+	}
+	return validateSponsorAmount(msg.Amount)
+}
+
+// NewMsgWithdrawSponsorFunds creates a new MsgWithdrawSponsorFunds instance.
+func NewMsgWithdrawSponsorFunds(sponsor string, amount sdk.Coins) *MsgWithdrawSponsorFunds {
+	return &MsgWithdrawSponsorFunds{
+		Sponsor: sponsor,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgWithdrawSponsorFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+	}
+	return validateSponsorAmount(msg.Amount)
+}
+
+// NewMsgUpdateSponsoredSigners creates a new MsgUpdateSponsoredSigners
+// instance.
+func NewMsgUpdateSponsoredSigners(sponsor string, allowedSigners []string) *MsgUpdateSponsoredSigners {
+	return &MsgUpdateSponsoredSigners{
+		Sponsor:        sponsor,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+func (msg *MsgUpdateSponsoredSigners) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+	}
+	return ValidateSponsoredSigners(msg.AllowedSigners)
+}
+
+// validateSponsorAmount returns an error if amount can not be deposited or
+// withdrawn.
+func validateSponsorAmount(amount sdk.Coins) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", amount)
+	}
+	return nil
+}
+
+// ValidateSponsoredSigners returns an error if the allowed signers of a
+// sponsor contain an invalid or duplicate address or exceed
+// MaxSponsoredSigners.
+func ValidateSponsoredSigners(allowedSigners []string) error {
+	if len(allowedSigners) > MaxSponsoredSigners {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "%d allowed signers exceeds the maximum of %d", len(allowedSigners), MaxSponsoredSigners)
+	}
+
+	seen := make(map[string]struct{}, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed signer %s: %s", signer, err)
+		}
+		if _, ok := seen[signer]; ok {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
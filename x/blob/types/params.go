@@ -18,8 +18,14 @@ var (
 	KeyGovMaxSquareSize          = []byte("GovMaxSquareSize")
 	// DefaultGovMaxSquareSize is the initial value of the gov max square size parameter.
 	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	// DefaultMaxNamespaceShareBps is the initial value of the max namespace
+	// share parameter. It disables the namespace share quota.
+	DefaultMaxNamespaceShareBps uint32 = 0
 )
 
+// MaxBasisPoints is the number of basis points in a whole.
+const MaxBasisPoints = 10_000
+
 // ParamKeyTable returns the param key table for the blob module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -38,7 +44,9 @@ func DefaultParams() Params {
 	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
 }
 
-// ParamSetPairs gets the list of param key-value pairs
+// ParamSetPairs gets the list of param key-value pairs. MaxNamespaceShareBps
+// was added after the params were migrated out of the legacy subspace, so it
+// is not part of the legacy param set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
@@ -52,7 +60,23 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	return validateMaxNamespaceShareBps(p.MaxNamespaceShareBps)
+}
+
+// MaxNamespaceShares returns the maximum number of shares that the blobs of a
+// single namespace may occupy in a block whose max effective square size is
+// maxSquareSize. It returns zero if the quota is disabled. If the quota is
+// enabled, a namespace may always occupy at least one share.
+func (p Params) MaxNamespaceShares(maxSquareSize int) int {
+	if p.MaxNamespaceShareBps == 0 {
+		return 0
+	}
+	maxShares := maxSquareSize * maxSquareSize * int(p.MaxNamespaceShareBps) / MaxBasisPoints
+	return max(maxShares, 1)
 }
 
 // String implements the Stringer interface.
@@ -95,3 +119,17 @@ func validateGovMaxSquareSize(v any) error {
 
 	return nil
 }
+
+// validateMaxNamespaceShareBps validates the MaxNamespaceShareBps param
+func validateMaxNamespaceShareBps(v any) error {
+	maxNamespaceShareBps, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxNamespaceShareBps > MaxBasisPoints {
+		return fmt.Errorf("max namespace share bps cannot be larger than %d: %d", MaxBasisPoints, maxNamespaceShareBps)
+	}
+
+	return nil
+}
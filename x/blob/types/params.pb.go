@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// max_namespace_share_bps is the maximum fraction, in basis points, of the
+	// shares of the max effective square that the blobs of a single namespace
+	// may occupy in a block. Zero disables the quota.
+	MaxNamespaceShareBps uint32 `protobuf:"varint,3,opt,name=max_namespace_share_bps,json=maxNamespaceShareBps,proto3" json:"max_namespace_share_bps,omitempty" yaml:"max_namespace_share_bps"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +79,13 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxNamespaceShareBps() uint32 {
+	if m != nil {
+		return m.MaxNamespaceShareBps
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
 }
@@ -82,24 +93,27 @@ func init() {
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x93, 0xbe, 0xa5, 0x43, 0xe0, 0x95, 0x1a, 0x0b, 0x86, 0xa2, 0x97, 0x92, 0xa9, 0x8b,
+	0x89, 0xc5, 0xad, 0x63, 0x16, 0x41, 0xa8, 0x94, 0x76, 0xd2, 0xe5, 0x78, 0xae, 0x3c, 0x5c, 0x03,
+	0x89, 0x77, 0xe6, 0xae, 0x21, 0xe9, 0xe6, 0x37, 0x70, 0x74, 0xf4, 0xe3, 0x38, 0x76, 0x74, 0x2a,
+	0xd2, 0x7e, 0x83, 0x7e, 0x02, 0xb9, 0xd4, 0xba, 0x14, 0xb7, 0x87, 0xe7, 0xf7, 0x7b, 0xfe, 0x1c,
+	0xff, 0x73, 0x2e, 0x67, 0x98, 0xa2, 0xd2, 0x09, 0x44, 0x2c, 0x15, 0x2c, 0x2a, 0x06, 0x91, 0x84,
+	0x1c, 0x32, 0x15, 0xca, 0x5c, 0x68, 0xe1, 0xb6, 0x0f, 0x38, 0x34, 0x38, 0x2c, 0x06, 0xdd, 0x0e,
+	0x17, 0x5c, 0xd4, 0x30, 0x32, 0xd3, 0xde, 0x0b, 0x5e, 0x1a, 0x4e, 0x6b, 0x5c, 0x1f, 0xba, 0xb7,
+	0xce, 0x29, 0x07, 0x45, 0x25, 0xe6, 0xd4, 0xdc, 0x50, 0x56, 0x69, 0xf4, 0xec, 0x9e, 0xdd, 0xff,
+	0x1f, 0x5f, 0xec, 0xd6, 0xbe, 0x57, 0x41, 0x96, 0x0e, 0x83, 0x23, 0x25, 0x98, 0x9c, 0x70, 0x50,
+	0x63, 0xcc, 0xe3, 0x54, 0xb0, 0xb8, 0xd2, 0xe8, 0x8e, 0x9c, 0x33, 0x2e, 0x0a, 0x9a, 0x41, 0x49,
+	0xd5, 0xf3, 0x02, 0x72, 0xa4, 0x2a, 0x59, 0xa2, 0xd7, 0xe8, 0xd9, 0xfd, 0x66, 0x4c, 0x76, 0x6b,
+	0xbf, 0xfb, 0x13, 0x75, 0x2c, 0x05, 0x93, 0x36, 0x17, 0xc5, 0x08, 0xca, 0x69, 0xbd, 0x9b, 0x26,
+	0x4b, 0x74, 0x1f, 0x9c, 0x73, 0x63, 0x3d, 0x41, 0x86, 0x4a, 0xc2, 0x0c, 0xa9, 0x9a, 0x1b, 0x9d,
+	0x49, 0xe5, 0xfd, 0xab, 0x5f, 0x17, 0xec, 0xd6, 0x3e, 0xd9, 0x47, 0xfe, 0x21, 0x06, 0x93, 0x4e,
+	0x06, 0xe5, 0xfd, 0x01, 0x4c, 0xcd, 0x3e, 0x96, 0x6a, 0xd8, 0x7c, 0x7b, 0xf7, 0xad, 0xf8, 0xee,
+	0x63, 0x43, 0xec, 0xd5, 0x86, 0xd8, 0x5f, 0x1b, 0x62, 0xbf, 0x6e, 0x89, 0xb5, 0xda, 0x12, 0xeb,
+	0x73, 0x4b, 0xac, 0xc7, 0x6b, 0x9e, 0xe8, 0xf9, 0x82, 0x85, 0x33, 0x91, 0x45, 0x87, 0x42, 0x45,
+	0xce, 0x7f, 0xe7, 0x2b, 0x90, 0x32, 0x2a, 0xf7, 0x3f, 0xa0, 0x2b, 0x89, 0x8a, 0xb5, 0xea, 0x5a,
+	0x6f, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x63, 0xc3, 0x42, 0x33, 0x9f, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +136,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNamespaceShareBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNamespaceShareBps))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -158,6 +177,9 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	if m.MaxNamespaceShareBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxNamespaceShareBps))
+	}
 	return n
 }
 
@@ -234,6 +256,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNamespaceShareBps", wireType)
+			}
+			m.MaxNamespaceShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNamespaceShareBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
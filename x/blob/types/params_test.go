@@ -42,3 +42,58 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateMaxNamespaceShareBps(t *testing.T) {
+	type test struct {
+		name      string
+		input     any
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "disabled",
+			input:     uint32(0),
+			expectErr: false,
+		},
+		{
+			name:      "valid",
+			input:     uint32(2500),
+			expectErr: false,
+		},
+		{
+			name:      "whole square",
+			input:     uint32(MaxBasisPoints),
+			expectErr: false,
+		},
+		{
+			name:      "more than the whole square",
+			input:     uint32(MaxBasisPoints + 1),
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     uint64(2500),
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		err := validateMaxNamespaceShareBps(tt.input)
+		if tt.expectErr {
+			assert.Error(t, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+		}
+	}
+}
+
+func TestMaxNamespaceShares(t *testing.T) {
+	params := DefaultParams()
+	assert.Zero(t, params.MaxNamespaceShares(64))
+
+	params.MaxNamespaceShareBps = 2500
+	assert.Equal(t, 64*64/4, params.MaxNamespaceShares(64))
+
+	// a namespace may always occupy one share
+	params.MaxNamespaceShareBps = 1
+	assert.Equal(t, 1, params.MaxNamespaceShares(4))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/celestiaorg/go-square/v3/share"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -128,6 +129,12 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 		return err
 	}
 
+	if msg.Sponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+		}
+	}
+
 	for _, commitment := range msg.ShareCommitments {
 		if len(commitment) != appconsts.HashLength() {
 			return ErrInvalidShareCommitment
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -111,33 +112,250 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QuerySponsorRequest is the request type for the Query/Sponsor RPC method.
+type QuerySponsorRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySponsorRequest) Reset()         { *m = QuerySponsorRequest{} }
+func (m *QuerySponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorRequest) ProtoMessage()    {}
+func (*QuerySponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QuerySponsorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorRequest.Merge(m, src)
+}
+func (m *QuerySponsorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySponsorResponse is the response type for the Query/Sponsor RPC method.
+type QuerySponsorResponse struct {
+	Sponsor Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor"`
+}
+
+func (m *QuerySponsorResponse) Reset()         { *m = QuerySponsorResponse{} }
+func (m *QuerySponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorResponse) ProtoMessage()    {}
+func (*QuerySponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QuerySponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorResponse.Merge(m, src)
+}
+func (m *QuerySponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorResponse) GetSponsor() Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return Sponsor{}
+}
+
+// QuerySponsorsBySignerRequest is the request type for the
+// Query/SponsorsBySigner RPC method.
+type QuerySponsorsBySignerRequest struct {
+	Signer     string             `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsBySignerRequest) Reset()         { *m = QuerySponsorsBySignerRequest{} }
+func (m *QuerySponsorsBySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsBySignerRequest) ProtoMessage()    {}
+func (*QuerySponsorsBySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{4}
+}
+func (m *QuerySponsorsBySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsBySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsBySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsBySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsBySignerRequest.Merge(m, src)
+}
+func (m *QuerySponsorsBySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsBySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsBySignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsBySignerRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorsBySignerRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QuerySponsorsBySignerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorsBySignerResponse is the response type for the
+// Query/SponsorsBySigner RPC method.
+type QuerySponsorsBySignerResponse struct {
+	Sponsors   []Sponsor           `protobuf:"bytes,1,rep,name=sponsors,proto3" json:"sponsors"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsBySignerResponse) Reset()         { *m = QuerySponsorsBySignerResponse{} }
+func (m *QuerySponsorsBySignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsBySignerResponse) ProtoMessage()    {}
+func (*QuerySponsorsBySignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{5}
+}
+func (m *QuerySponsorsBySignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsBySignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsBySignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsBySignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsBySignerResponse.Merge(m, src)
+}
+func (m *QuerySponsorsBySignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsBySignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsBySignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsBySignerResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorsBySignerResponse) GetSponsors() []Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *QuerySponsorsBySignerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySponsorRequest)(nil), "celestia.blob.v1.QuerySponsorRequest")
+	proto.RegisterType((*QuerySponsorResponse)(nil), "celestia.blob.v1.QuerySponsorResponse")
+	proto.RegisterType((*QuerySponsorsBySignerRequest)(nil), "celestia.blob.v1.QuerySponsorsBySignerRequest")
+	proto.RegisterType((*QuerySponsorsBySignerResponse)(nil), "celestia.blob.v1.QuerySponsorsBySignerResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
-	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x16, 0x12, 0x78, 0x0c, 0x94, 0x23, 0x82, 0x60, 0x12, 0x83, 0x4c, 0x29, 0xa8,
+	0x12, 0x77, 0xa4, 0x48, 0x48, 0x88, 0x2d, 0x03, 0x48, 0x48, 0x48, 0x6d, 0xba, 0xb1, 0x9d, 0xd3,
+	0xd3, 0x61, 0x29, 0xf1, 0xb9, 0x3e, 0x27, 0x22, 0xaa, 0xc2, 0xc0, 0x27, 0x40, 0x62, 0x61, 0x63,
+	0xe2, 0xbb, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x07, 0x41, 0xbe, 0x7b, 0x4e, 0x13, 0x9b,
+	0x92, 0x6c, 0x67, 0xbf, 0xff, 0xfb, 0xff, 0x7f, 0xe7, 0xf7, 0x0c, 0xcd, 0x9e, 0xe8, 0x0b, 0x9d,
+	0x86, 0x9c, 0x05, 0x7d, 0x15, 0xb0, 0x51, 0x9b, 0x1d, 0x0f, 0x45, 0x32, 0xa6, 0x71, 0xa2, 0x52,
+	0x45, 0xb6, 0xf2, 0x2a, 0xcd, 0xaa, 0x74, 0xd4, 0x76, 0xeb, 0x52, 0x49, 0x65, 0x8a, 0x2c, 0x3b,
+	0x59, 0x9d, 0xdb, 0x94, 0x4a, 0xc9, 0xbe, 0x60, 0x3c, 0x0e, 0x19, 0x8f, 0x22, 0x95, 0xf2, 0x34,
+	0x54, 0x91, 0xc6, 0xea, 0x6e, 0x4f, 0xe9, 0x81, 0xd2, 0x2c, 0xe0, 0x5a, 0x58, 0x7b, 0x36, 0x6a,
+	0x07, 0x22, 0xe5, 0x6d, 0x16, 0x73, 0x19, 0x46, 0x46, 0x8c, 0xda, 0x56, 0x89, 0x27, 0xe6, 0x09,
+	0x1f, 0xe4, 0x56, 0x5e, 0xa9, 0xac, 0x63, 0x15, 0x69, 0x95, 0xd8, 0xba, 0x5f, 0x07, 0x72, 0x90,
+	0x05, 0xec, 0x9b, 0xa6, 0xae, 0x38, 0x1e, 0x0a, 0x9d, 0xfa, 0x6f, 0xe1, 0xe6, 0xd2, 0x5b, 0xd3,
+	0x23, 0xc8, 0x73, 0xa8, 0x5a, 0xf3, 0x86, 0x73, 0xdf, 0x79, 0x7c, 0x6d, 0xaf, 0x41, 0x8b, 0xd7,
+	0xa5, 0xb6, 0xa3, 0x73, 0xe9, 0xf4, 0xd7, 0xbd, 0x4a, 0x17, 0xd5, 0x3e, 0x43, 0xbb, 0x43, 0x1b,
+	0x8d, 0x29, 0xa4, 0x01, 0x35, 0x7e, 0x74, 0x94, 0x08, 0x6d, 0xfd, 0xae, 0x76, 0xf3, 0x47, 0xff,
+	0x00, 0xea, 0xcb, 0x0d, 0x08, 0xf0, 0x02, 0x6a, 0x88, 0x8f, 0x04, 0x77, 0xca, 0x04, 0xd8, 0x83,
+	0x08, 0xb9, 0xde, 0xff, 0x08, 0xcd, 0x45, 0x4b, 0xdd, 0x19, 0x1f, 0x86, 0x32, 0x12, 0x73, 0x98,
+	0x5b, 0x50, 0xd5, 0xe6, 0x05, 0xb2, 0xe0, 0x13, 0x79, 0x05, 0x70, 0xfe, 0xcd, 0x1b, 0x1b, 0x26,
+	0x75, 0x87, 0xda, 0x01, 0xd1, 0x6c, 0x40, 0xd4, 0xce, 0x1f, 0x07, 0x44, 0xf7, 0xb9, 0x14, 0xe8,
+	0xd9, 0x5d, 0xe8, 0xf4, 0xbf, 0x3b, 0xd0, 0xba, 0x00, 0x00, 0x2f, 0xf7, 0x12, 0xae, 0x20, 0x6c,
+	0xf6, 0x3d, 0x36, 0xd7, 0xb9, 0xdd, 0xbc, 0x81, 0xbc, 0xfe, 0x07, 0xe6, 0xa3, 0x95, 0x98, 0x36,
+	0x79, 0x91, 0x73, 0xef, 0xeb, 0x26, 0x5c, 0x36, 0x9c, 0x24, 0x82, 0xaa, 0x9d, 0x26, 0xd9, 0x2e,
+	0x73, 0x94, 0x97, 0xc6, 0x7d, 0xb8, 0x42, 0x65, 0xc3, 0xfc, 0xdb, 0x9f, 0x7e, 0xfc, 0xf9, 0xb2,
+	0x71, 0x83, 0x5c, 0x2f, 0x2c, 0x2c, 0x99, 0x40, 0x0d, 0x6f, 0x47, 0x2e, 0xb2, 0x5a, 0x5e, 0x20,
+	0x77, 0x67, 0x95, 0x0c, 0x23, 0x1f, 0x98, 0xc8, 0x16, 0xb9, 0x5b, 0xfc, 0x09, 0x34, 0x3b, 0xc1,
+	0x95, 0x9b, 0x90, 0x6f, 0x0e, 0x6c, 0x15, 0x67, 0x43, 0xe8, 0xff, 0x13, 0x8a, 0x5b, 0xe4, 0xb2,
+	0xb5, 0xf5, 0x88, 0xb6, 0x6b, 0xd0, 0xb6, 0x89, 0x7f, 0x8e, 0x66, 0x04, 0x9a, 0x9d, 0xd8, 0xc3,
+	0x64, 0xce, 0xda, 0x79, 0x73, 0x3a, 0xf5, 0x9c, 0xb3, 0xa9, 0xe7, 0xfc, 0x9e, 0x7a, 0xce, 0xe7,
+	0x99, 0x57, 0x39, 0x9b, 0x79, 0x95, 0x9f, 0x33, 0xaf, 0xf2, 0xee, 0xa9, 0x0c, 0xd3, 0xf7, 0xc3,
+	0x80, 0xf6, 0xd4, 0x80, 0xe5, 0x00, 0x2a, 0x91, 0xf3, 0xf3, 0x13, 0x1e, 0xc7, 0xec, 0x83, 0x8d,
+	0x48, 0xc7, 0xb1, 0xd0, 0x41, 0xd5, 0xfc, 0xfe, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x23,
+	0x7d, 0xbb, 0x54, 0xcf, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +372,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Sponsor queries the deposited balance and the allowed signers of a
+	// sponsor.
+	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
+	// SponsorsBySigner queries the sponsors that pay the MsgPayForBlobs fees of
+	// a signer along with their remaining balances.
+	SponsorsBySigner(ctx context.Context, in *QuerySponsorsBySignerRequest, opts ...grpc.CallOption) (*QuerySponsorsBySignerResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +397,34 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
+	out := new(QuerySponsorResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/Sponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorsBySigner(ctx context.Context, in *QuerySponsorsBySignerRequest, opts ...grpc.CallOption) (*QuerySponsorsBySignerResponse, error) {
+	out := new(QuerySponsorsBySignerResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/SponsorsBySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Sponsor queries the deposited balance and the allowed signers of a
+	// sponsor.
+	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
+	// SponsorsBySigner queries the sponsors that pay the MsgPayForBlobs fees of
+	// a signer along with their remaining balances.
+	SponsorsBySigner(context.Context, *QuerySponsorsBySignerRequest) (*QuerySponsorsBySignerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +434,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Sponsor(ctx context.Context, req *QuerySponsorRequest) (*QuerySponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+}
+func (*UnimplementedQueryServer) SponsorsBySigner(ctx context.Context, req *QuerySponsorsBySignerRequest) (*QuerySponsorsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorsBySigner not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +463,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/Sponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/SponsorsBySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorsBySigner(ctx, req.(*QuerySponsorsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Query",
@@ -218,6 +508,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Sponsor",
+			Handler:    _Query_Sponsor_Handler,
+		},
+		{
+			MethodName: "SponsorsBySigner",
+			Handler:    _Query_SponsorsBySigner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/query.proto",
@@ -279,66 +577,280 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySponsorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySponsorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Sponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySponsorsBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySponsorsBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *QuerySponsorsBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorsBySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorsBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorsBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsors) > 0 {
+		for iNdEx := len(m.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorsBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorsBySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsors) > 0 {
+		for _, e := range m.Sponsors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
 			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
@@ -449,6 +961,409 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsBySignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsBySignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsBySignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsBySignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsors = append(m.Sponsors, Sponsor{})
+			if err := m.Sponsors[len(m.Sponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
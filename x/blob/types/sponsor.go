@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the sponsor is malformed.
+func (s Sponsor) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return fmt.Errorf("invalid sponsor address: %w", err)
+	}
+	if !s.Balance.IsValid() {
+		return fmt.Errorf("invalid balance of sponsor %s: %s", s.Address, s.Balance)
+	}
+	return ValidateSponsoredSigners(s.AllowedSigners)
+}
+
+// IsEmpty returns true if the sponsor has neither funds nor allowed signers
+// and therefore does not need to be stored.
+func (s Sponsor) IsEmpty() bool {
+	return s.Balance.IsZero() && len(s.AllowedSigners) == 0
+}
+
+// Sponsors returns true if the sponsor pays the fees of signer.
+func (s Sponsor) Sponsors(signer string) bool {
+	return slices.Contains(s.AllowedSigners, signer)
+}
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/sponsor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Sponsor describes an account that pays the fees of the MsgPayForBlobs of
+// its allowed signers from the funds it deposited in the blob module.
+type Sponsor struct {
+	// Address is the account of the sponsor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Balance is the remaining amount deposited by the sponsor.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// AllowedSigners are the accounts whose MsgPayForBlobs fees the sponsor
+	// pays.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *Sponsor) Reset()         { *m = Sponsor{} }
+func (m *Sponsor) String() string { return proto.CompactTextString(m) }
+func (*Sponsor) ProtoMessage()    {}
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befeeb5f81ace218, []int{0}
+}
+func (m *Sponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsor.Merge(m, src)
+}
+func (m *Sponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+
+func (m *Sponsor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Sponsor) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Sponsor) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Sponsor)(nil), "celestia.blob.v1.Sponsor")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/sponsor.proto", fileDescriptor_befeeb5f81ace218) }
+
+var fileDescriptor_befeeb5f81ace218 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xfb, 0x30,
+	0x10, 0xc6, 0x93, 0x7f, 0xa5, 0x7f, 0x45, 0x90, 0x00, 0x45, 0x1d, 0xd2, 0x0e, 0x6e, 0xc5, 0xd4,
+	0xa5, 0x76, 0x53, 0x9e, 0xa0, 0x65, 0x63, 0x6c, 0x37, 0x96, 0xca, 0x4e, 0xac, 0x60, 0x91, 0xfa,
+	0x22, 0x9f, 0x29, 0xf0, 0x16, 0x3c, 0x07, 0x33, 0x0f, 0xd1, 0xb1, 0x62, 0x62, 0x02, 0xd4, 0x0e,
+	0xbc, 0x06, 0x6a, 0xec, 0x20, 0x26, 0x26, 0xdf, 0xe9, 0x77, 0xdf, 0x7d, 0x9f, 0xce, 0x11, 0xc9,
+	0x64, 0x29, 0xd1, 0x2a, 0xce, 0x44, 0x09, 0x82, 0xad, 0x53, 0x86, 0x15, 0x68, 0x04, 0x43, 0x2b,
+	0x03, 0x16, 0xe2, 0xb3, 0x86, 0xd3, 0x03, 0xa7, 0xeb, 0xb4, 0xd7, 0x29, 0xa0, 0x80, 0x1a, 0xb2,
+	0x43, 0xe5, 0xe6, 0x7a, 0xdd, 0x0c, 0x70, 0x05, 0xb8, 0x74, 0xc0, 0x35, 0x1e, 0x11, 0xd7, 0x31,
+	0xc1, 0x51, 0xb2, 0x75, 0x2a, 0xa4, 0xe5, 0x29, 0xcb, 0x40, 0x69, 0xc7, 0xcf, 0xbf, 0xc2, 0xa8,
+	0xbd, 0x70, 0xa6, 0xf1, 0x24, 0x6a, 0xf3, 0x3c, 0x37, 0x12, 0x31, 0x09, 0x07, 0xe1, 0xf0, 0x68,
+	0x96, 0xbc, 0xbe, 0x8c, 0x3a, 0x7e, 0xdd, 0xd4, 0x91, 0x85, 0x35, 0x4a, 0x17, 0xf3, 0x66, 0x30,
+	0x96, 0x51, 0x5b, 0xf0, 0x92, 0xeb, 0x4c, 0x26, 0xff, 0x06, 0xad, 0xe1, 0xf1, 0xa4, 0x4b, 0xbd,
+	0xe0, 0xe0, 0x48, 0xbd, 0x23, 0xbd, 0x04, 0xa5, 0x67, 0xe3, 0xcd, 0x7b, 0x3f, 0x78, 0xfe, 0xe8,
+	0x0f, 0x0b, 0x65, 0x6f, 0xee, 0x04, 0xcd, 0x60, 0xe5, 0xc3, 0xfa, 0x67, 0x84, 0xf9, 0x2d, 0xb3,
+	0x8f, 0x95, 0xc4, 0x5a, 0x80, 0xf3, 0x66, 0x77, 0x3c, 0x8d, 0x4e, 0x79, 0x59, 0xc2, 0xbd, 0xcc,
+	0x97, 0xa8, 0x0a, 0x2d, 0x0d, 0x26, 0xad, 0x41, 0xeb, 0xcf, 0x88, 0x27, 0x5e, 0xb0, 0x70, 0xf3,
+	0xb3, 0xab, 0xcd, 0x8e, 0x84, 0xdb, 0x1d, 0x09, 0x3f, 0x77, 0x24, 0x7c, 0xda, 0x93, 0x60, 0xbb,
+	0x27, 0xc1, 0xdb, 0x9e, 0x04, 0xd7, 0xe3, 0xdf, 0x79, 0xfc, 0xc5, 0xc1, 0x14, 0x3f, 0xf5, 0x88,
+	0x57, 0x15, 0x7b, 0x70, 0x7f, 0x54, 0xa7, 0x13, 0xff, 0xeb, 0xe3, 0x5d, 0x7c, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0x51, 0x3f, 0x39, 0xae, 0xc1, 0x01, 0x00, 0x00,
+}
+
+func (m *Sponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintSponsor(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsor(x uint64) (n int) {
+	return sovSponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsor = fmt.Errorf("proto: unexpected end of group")
+)
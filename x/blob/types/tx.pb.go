@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -49,6 +51,10 @@ type MsgPayForBlobs struct {
 	// share_versions specified must match the share_versions used to generate the
 	// share_commitment in this message.
 	ShareVersions []uint32 `protobuf:"varint,8,rep,packed,name=share_versions,json=shareVersions,proto3" json:"share_versions,omitempty"`
+	// sponsor is the optional bech32 encoded address of the sponsor that pays
+	// the fees of the transaction from its deposited funds. The signer must be
+	// one of the sponsor's allowed signers.
+	Sponsor string `protobuf:"bytes,9,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
 }
 
 func (m *MsgPayForBlobs) Reset()         { *m = MsgPayForBlobs{} }
@@ -119,6 +125,13 @@ func (m *MsgPayForBlobs) GetShareVersions() []uint32 {
 	return nil
 }
 
+func (m *MsgPayForBlobs) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
 // MsgPayForBlobsResponse describes the response returned after the submission
 // of a PayForBlobs
 type MsgPayForBlobsResponse struct {
@@ -251,50 +264,346 @@ func (m *MsgUpdateBlobParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateBlobParamsResponse proto.InternalMessageInfo
 
+// MsgDepositSponsorFunds deposits funds in the blob module account that pay
+// the fees of the MsgPayForBlobs sponsored by the sponsor.
+type MsgDepositSponsorFunds struct {
+	Sponsor string                                   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgDepositSponsorFunds) Reset()         { *m = MsgDepositSponsorFunds{} }
+func (m *MsgDepositSponsorFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSponsorFunds) ProtoMessage()    {}
+func (*MsgDepositSponsorFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{4}
+}
+func (m *MsgDepositSponsorFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSponsorFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSponsorFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSponsorFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSponsorFunds.Merge(m, src)
+}
+func (m *MsgDepositSponsorFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSponsorFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSponsorFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSponsorFunds proto.InternalMessageInfo
+
+func (m *MsgDepositSponsorFunds) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgDepositSponsorFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgDepositSponsorFundsResponse is the response type for the
+// DepositSponsorFunds method.
+type MsgDepositSponsorFundsResponse struct {
+}
+
+func (m *MsgDepositSponsorFundsResponse) Reset()         { *m = MsgDepositSponsorFundsResponse{} }
+func (m *MsgDepositSponsorFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSponsorFundsResponse) ProtoMessage()    {}
+func (*MsgDepositSponsorFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{5}
+}
+func (m *MsgDepositSponsorFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSponsorFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSponsorFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSponsorFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSponsorFundsResponse.Merge(m, src)
+}
+func (m *MsgDepositSponsorFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSponsorFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSponsorFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSponsorFundsResponse proto.InternalMessageInfo
+
+// MsgWithdrawSponsorFunds withdraws funds deposited by the sponsor.
+type MsgWithdrawSponsorFunds struct {
+	Sponsor string                                   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawSponsorFunds) Reset()         { *m = MsgWithdrawSponsorFunds{} }
+func (m *MsgWithdrawSponsorFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorFunds) ProtoMessage()    {}
+func (*MsgWithdrawSponsorFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{6}
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorFunds.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorFunds proto.InternalMessageInfo
+
+func (m *MsgWithdrawSponsorFunds) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawSponsorFundsResponse is the response type for the
+// WithdrawSponsorFunds method.
+type MsgWithdrawSponsorFundsResponse struct {
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Reset()         { *m = MsgWithdrawSponsorFundsResponse{} }
+func (m *MsgWithdrawSponsorFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorFundsResponse) ProtoMessage()    {}
+func (*MsgWithdrawSponsorFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{7}
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorFundsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorFundsResponse proto.InternalMessageInfo
+
+// MsgUpdateSponsoredSigners replaces the signers whose MsgPayForBlobs fees
+// the sponsor pays.
+type MsgUpdateSponsoredSigners struct {
+	Sponsor        string   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	AllowedSigners []string `protobuf:"bytes,2,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *MsgUpdateSponsoredSigners) Reset()         { *m = MsgUpdateSponsoredSigners{} }
+func (m *MsgUpdateSponsoredSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsoredSigners) ProtoMessage()    {}
+func (*MsgUpdateSponsoredSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{8}
+}
+func (m *MsgUpdateSponsoredSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSponsoredSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSponsoredSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSponsoredSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsoredSigners.Merge(m, src)
+}
+func (m *MsgUpdateSponsoredSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSponsoredSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsoredSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsoredSigners proto.InternalMessageInfo
+
+func (m *MsgUpdateSponsoredSigners) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgUpdateSponsoredSigners) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// MsgUpdateSponsoredSignersResponse is the response type for the
+// UpdateSponsoredSigners method.
+type MsgUpdateSponsoredSignersResponse struct {
+}
+
+func (m *MsgUpdateSponsoredSignersResponse) Reset()         { *m = MsgUpdateSponsoredSignersResponse{} }
+func (m *MsgUpdateSponsoredSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsoredSignersResponse) ProtoMessage()    {}
+func (*MsgUpdateSponsoredSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{9}
+}
+func (m *MsgUpdateSponsoredSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSponsoredSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSponsoredSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSponsoredSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsoredSignersResponse.Merge(m, src)
+}
+func (m *MsgUpdateSponsoredSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSponsoredSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsoredSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsoredSignersResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
 	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
 	proto.RegisterType((*MsgUpdateBlobParams)(nil), "celestia.blob.v1.MsgUpdateBlobParams")
 	proto.RegisterType((*MsgUpdateBlobParamsResponse)(nil), "celestia.blob.v1.MsgUpdateBlobParamsResponse")
+	proto.RegisterType((*MsgDepositSponsorFunds)(nil), "celestia.blob.v1.MsgDepositSponsorFunds")
+	proto.RegisterType((*MsgDepositSponsorFundsResponse)(nil), "celestia.blob.v1.MsgDepositSponsorFundsResponse")
+	proto.RegisterType((*MsgWithdrawSponsorFunds)(nil), "celestia.blob.v1.MsgWithdrawSponsorFunds")
+	proto.RegisterType((*MsgWithdrawSponsorFundsResponse)(nil), "celestia.blob.v1.MsgWithdrawSponsorFundsResponse")
+	proto.RegisterType((*MsgUpdateSponsoredSigners)(nil), "celestia.blob.v1.MsgUpdateSponsoredSigners")
+	proto.RegisterType((*MsgUpdateSponsoredSignersResponse)(nil), "celestia.blob.v1.MsgUpdateSponsoredSignersResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
 
 var fileDescriptor_9157fbf3d3cd004d = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xf5, 0xc6, 0xa9, 0xa9, 0xd7, 0x8d, 0x71, 0x55, 0xd3, 0xca, 0x6e, 0xac, 0x18, 0x41, 0x40,
-	0xb8, 0x58, 0x4a, 0x52, 0xe8, 0xc1, 0xb7, 0xba, 0xd0, 0x43, 0xc1, 0x10, 0x14, 0xda, 0x43, 0x2f,
-	0x66, 0x25, 0x6f, 0xd7, 0x02, 0x4b, 0x2b, 0x76, 0x36, 0x26, 0x4e, 0x2f, 0x25, 0x5f, 0x50, 0xe8,
-	0x8f, 0xe4, 0xd0, 0x8f, 0xc8, 0x31, 0xb4, 0x97, 0x9e, 0x4a, 0xb1, 0x0b, 0xb9, 0xf7, 0x0b, 0x8a,
-	0xb4, 0x52, 0xec, 0xc4, 0x81, 0xf6, 0x36, 0x7a, 0xef, 0xcd, 0x9b, 0x79, 0x9a, 0xc5, 0x0d, 0x9f,
-	0x4e, 0x28, 0xc8, 0x80, 0x38, 0xde, 0x84, 0x7b, 0xce, 0x74, 0xdf, 0x91, 0x27, 0x76, 0x2c, 0xb8,
-	0xe4, 0x5a, 0x2d, 0xa7, 0xec, 0x84, 0xb2, 0xa7, 0xfb, 0xcd, 0xd6, 0x9a, 0x38, 0x26, 0x82, 0x84,
-	0xa0, 0x1a, 0x9a, 0x75, 0xc6, 0x19, 0x4f, 0x4b, 0x27, 0xa9, 0x32, 0x74, 0x9b, 0x71, 0xce, 0x26,
-	0xd4, 0x21, 0x71, 0xe0, 0x90, 0x28, 0xe2, 0x92, 0xc8, 0x80, 0x47, 0x79, 0xcf, 0x13, 0x9f, 0x43,
-	0xc8, 0xc1, 0x09, 0x81, 0x25, 0x7e, 0x21, 0xb0, 0x8c, 0x68, 0x28, 0x62, 0xa8, 0xfc, 0xd4, 0x87,
-	0xa2, 0xcc, 0x39, 0xc2, 0xd5, 0x01, 0xb0, 0x43, 0x32, 0x7b, 0xcd, 0x45, 0x7f, 0xc2, 0x3d, 0xd0,
-	0xf6, 0x70, 0x09, 0x02, 0x16, 0x51, 0xa1, 0xa3, 0x36, 0xb2, 0xca, 0x7d, 0xfd, 0xdb, 0xd7, 0x6e,
-	0x3d, 0x6b, 0x7a, 0x39, 0x1a, 0x09, 0x0a, 0x70, 0x24, 0x45, 0x10, 0x31, 0x37, 0xd3, 0x69, 0x06,
-	0xc6, 0x11, 0x09, 0x29, 0xc4, 0xc4, 0xa7, 0xa0, 0x6f, 0xb4, 0x8b, 0xd6, 0x03, 0x77, 0x05, 0xd1,
-	0x5a, 0x18, 0x27, 0x21, 0x87, 0x10, 0x9c, 0x52, 0xd0, 0x8b, 0xed, 0xa2, 0xb5, 0xe5, 0x96, 0x13,
-	0xe4, 0x28, 0x01, 0xb4, 0x67, 0xf8, 0x21, 0x8c, 0x89, 0xa0, 0x43, 0x9f, 0x87, 0x61, 0x20, 0x43,
-	0x1a, 0x49, 0xd0, 0x37, 0x53, 0x97, 0x5a, 0x4a, 0xbc, 0x5a, 0xe2, 0xda, 0x2e, 0xae, 0x2a, 0xf1,
-	0x94, 0x0a, 0x48, 0xc2, 0xeb, 0xf7, 0x53, 0xbf, 0xad, 0x14, 0x7d, 0x97, 0x81, 0xbd, 0xca, 0xd9,
-	0xd5, 0x79, 0x27, 0xdb, 0xcf, 0xd4, 0xf1, 0xe3, 0x9b, 0x19, 0x5d, 0x0a, 0x31, 0x8f, 0x80, 0x9a,
-	0x1f, 0xf1, 0xa3, 0x01, 0xb0, 0xb7, 0xf1, 0x88, 0x48, 0x9a, 0x30, 0x87, 0xe9, 0x0d, 0xb4, 0x6d,
-	0x5c, 0x26, 0xc7, 0x72, 0xcc, 0x45, 0x20, 0x67, 0xea, 0x2f, 0xb8, 0x4b, 0x40, 0x7b, 0x81, 0x4b,
-	0xea, 0x56, 0xfa, 0x46, 0x1b, 0x59, 0x95, 0x03, 0xdd, 0xbe, 0x7d, 0x5d, 0x5b, 0xf9, 0xf4, 0x37,
-	0x2f, 0x7e, 0xee, 0x14, 0xdc, 0x4c, 0xdd, 0xab, 0x26, 0x3b, 0x2d, 0x7d, 0xcc, 0x16, 0x7e, 0x7a,
-	0xc7, 0xf0, 0x7c, 0xb7, 0x83, 0x3f, 0x08, 0x17, 0x07, 0xc0, 0xb4, 0x53, 0x5c, 0x59, 0x3d, 0x4f,
-	0x7b, 0x7d, 0xda, 0xcd, 0x70, 0x4d, 0xeb, 0x5f, 0x8a, 0xeb, 0xf8, 0x3b, 0x67, 0xdf, 0x7f, 0x7f,
-	0xd9, 0x68, 0xf4, 0x50, 0xc7, 0xac, 0xaf, 0xbc, 0xc3, 0xd9, 0x07, 0x2e, 0xbc, 0x74, 0xd8, 0x18,
-	0xd7, 0xd6, 0x7e, 0xce, 0xee, 0x9d, 0xf6, 0xb7, 0x65, 0xcd, 0xee, 0x7f, 0xc9, 0xf2, 0x55, 0x9a,
-	0xf7, 0x3e, 0x5d, 0x9d, 0x77, 0x50, 0xff, 0xcd, 0xc5, 0xdc, 0x40, 0x97, 0x73, 0x03, 0xfd, 0x9a,
-	0x1b, 0xe8, 0xf3, 0xc2, 0x28, 0x5c, 0x2e, 0x8c, 0xc2, 0x8f, 0x85, 0x51, 0x78, 0xbf, 0xc7, 0x02,
-	0x39, 0x3e, 0xf6, 0x6c, 0x9f, 0x87, 0x4e, 0xee, 0xcc, 0x05, 0xbb, 0xae, 0xbb, 0x24, 0x8e, 0x9d,
-	0x13, 0x95, 0x42, 0xce, 0x62, 0x0a, 0x5e, 0x29, 0x7d, 0xe2, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf0, 0x8e, 0x82, 0x98, 0x03, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x12, 0xc8, 0xa5, 0x0d, 0xc5, 0x8d, 0x5a, 0xc7, 0xb4, 0x4e, 0x1a, 0x54, 0x29,
+	0xb4, 0x8a, 0x9d, 0xa4, 0x12, 0x43, 0xb6, 0xa6, 0xa8, 0x03, 0x52, 0xa4, 0x2a, 0x11, 0x20, 0xb1,
+	0x44, 0xe7, 0xf8, 0x70, 0x2c, 0x62, 0x9f, 0xf1, 0x5d, 0xd2, 0xa6, 0x2c, 0xa8, 0x7f, 0x01, 0x12,
+	0x12, 0x33, 0x33, 0x53, 0x07, 0x76, 0x18, 0x3b, 0x56, 0x30, 0xc0, 0x04, 0xa8, 0x45, 0xea, 0xbf,
+	0x81, 0x6c, 0x5f, 0xdc, 0x34, 0x75, 0xdb, 0xc0, 0xc6, 0x94, 0xcb, 0x7b, 0xdf, 0xfb, 0xf1, 0xbd,
+	0xfb, 0xde, 0x19, 0x64, 0xda, 0xa8, 0x8b, 0x08, 0x35, 0xa0, 0xa2, 0x76, 0xb1, 0xaa, 0xf4, 0xcb,
+	0x0a, 0xdd, 0x95, 0x6d, 0x07, 0x53, 0xcc, 0xcf, 0x0e, 0x5d, 0xb2, 0xeb, 0x92, 0xfb, 0x65, 0x71,
+	0xe9, 0x02, 0xd8, 0x86, 0x0e, 0x34, 0x89, 0x1f, 0x20, 0xa6, 0x75, 0xac, 0x63, 0xef, 0xa8, 0xb8,
+	0x27, 0x66, 0x95, 0xda, 0x98, 0x98, 0x98, 0x28, 0x2a, 0x24, 0x48, 0xe9, 0x97, 0x55, 0x44, 0x61,
+	0x59, 0x69, 0x63, 0xc3, 0x62, 0xfe, 0x45, 0x1d, 0x63, 0xbd, 0x8b, 0x14, 0x68, 0x1b, 0x0a, 0xb4,
+	0x2c, 0x4c, 0x21, 0x35, 0xb0, 0x35, 0xcc, 0xb9, 0xc0, 0xa2, 0x4d, 0xa2, 0xbb, 0xf5, 0x4c, 0xa2,
+	0x33, 0x47, 0xc6, 0x77, 0xb4, 0xfc, 0x7a, 0xfe, 0x1f, 0xdf, 0x95, 0x7f, 0x17, 0x05, 0xa9, 0x3a,
+	0xd1, 0xb7, 0xe1, 0x60, 0x0b, 0x3b, 0xb5, 0x2e, 0x56, 0x09, 0x5f, 0x02, 0x71, 0x62, 0xe8, 0x16,
+	0x72, 0x04, 0x2e, 0xc7, 0x15, 0x12, 0x35, 0xe1, 0xcb, 0xc7, 0x62, 0x9a, 0x05, 0x6d, 0x68, 0x9a,
+	0x83, 0x08, 0x69, 0x52, 0xc7, 0xb0, 0xf4, 0x06, 0xc3, 0xf1, 0x12, 0x00, 0x16, 0x34, 0x11, 0xb1,
+	0x61, 0x1b, 0x11, 0x21, 0x9a, 0x8b, 0x15, 0xa6, 0x1b, 0x23, 0x16, 0x7e, 0x09, 0x00, 0x77, 0x08,
+	0x2d, 0x62, 0xec, 0x21, 0x22, 0xc4, 0x72, 0xb1, 0xc2, 0x4c, 0x23, 0xe1, 0x5a, 0x9a, 0xae, 0x81,
+	0x5f, 0x03, 0x77, 0x48, 0x07, 0x3a, 0xa8, 0xd5, 0xc6, 0xa6, 0x69, 0x50, 0x13, 0x59, 0x94, 0x08,
+	0x53, 0x5e, 0x96, 0x59, 0xcf, 0xb1, 0x79, 0x66, 0xe7, 0x57, 0x40, 0xca, 0x07, 0xf7, 0x91, 0x43,
+	0x5c, 0xf2, 0xc2, 0x2d, 0x2f, 0xdf, 0x8c, 0x67, 0x7d, 0xc2, 0x8c, 0x7c, 0x05, 0xdc, 0x24, 0x36,
+	0xb6, 0x08, 0x76, 0x84, 0xc4, 0x35, 0x2c, 0x86, 0xc0, 0x6a, 0x72, 0xff, 0xf4, 0x60, 0x95, 0x71,
+	0xca, 0x0b, 0x60, 0xfe, 0xfc, 0x5c, 0x1a, 0xc8, 0xc3, 0xa1, 0xfc, 0x2b, 0x30, 0x57, 0x27, 0xfa,
+	0x63, 0x5b, 0x83, 0x14, 0xb9, 0x9e, 0x6d, 0xef, 0x5e, 0xf9, 0x45, 0x90, 0x80, 0x3d, 0xda, 0xc1,
+	0x8e, 0x41, 0x07, 0xfe, 0xe4, 0x1a, 0x67, 0x06, 0xfe, 0x01, 0x88, 0xfb, 0xf7, 0x2f, 0x44, 0x73,
+	0x5c, 0x21, 0x59, 0x11, 0xe4, 0x71, 0xc5, 0xc8, 0x7e, 0x9e, 0xda, 0xd4, 0xe1, 0x8f, 0x6c, 0xa4,
+	0xc1, 0xd0, 0xd5, 0x94, 0xdb, 0xd3, 0x59, 0x9e, 0xfc, 0x12, 0xb8, 0x1b, 0x52, 0x3c, 0xe8, 0xed,
+	0x13, 0xe7, 0xb5, 0xfd, 0x10, 0xd9, 0x98, 0x18, 0xb4, 0xe9, 0x13, 0xdb, 0xea, 0x59, 0xda, 0xb9,
+	0x89, 0x70, 0x13, 0x4e, 0x84, 0x6f, 0x83, 0x38, 0x34, 0x71, 0xcf, 0xa2, 0xde, 0xa5, 0x26, 0x2b,
+	0x19, 0x99, 0xe1, 0x5d, 0x81, 0xca, 0x4c, 0xa0, 0xf2, 0x26, 0x36, 0xac, 0x5a, 0xc9, 0x6d, 0xfb,
+	0xc3, 0xcf, 0x6c, 0x41, 0x37, 0x68, 0xa7, 0xa7, 0xca, 0x6d, 0x6c, 0x32, 0xa5, 0xb1, 0x9f, 0x22,
+	0xd1, 0x5e, 0x28, 0x74, 0x60, 0x23, 0xe2, 0x05, 0x90, 0x06, 0x4b, 0x5d, 0x9d, 0x76, 0x29, 0x0e,
+	0x4b, 0xe6, 0x73, 0x40, 0x0a, 0x27, 0x10, 0x70, 0xfc, 0xcc, 0x81, 0x85, 0x3a, 0xd1, 0x9f, 0x1a,
+	0xb4, 0xa3, 0x39, 0x70, 0xe7, 0x7f, 0x24, 0xb9, 0x0c, 0xb2, 0x97, 0x30, 0x08, 0x58, 0xbe, 0xe7,
+	0x40, 0x26, 0xb8, 0x69, 0x86, 0x40, 0x5a, 0xd3, 0x13, 0xe7, 0xbf, 0xf1, 0xdc, 0x00, 0xb7, 0x61,
+	0xb7, 0x8b, 0x77, 0x90, 0xd6, 0xf2, 0x35, 0xee, 0xaf, 0xea, 0x55, 0xb1, 0x29, 0x16, 0xc0, 0xca,
+	0x8e, 0xb1, 0xb8, 0x07, 0x96, 0x2f, 0xed, 0x70, 0xc8, 0xa3, 0xf2, 0x6d, 0x0a, 0xc4, 0xea, 0x44,
+	0xe7, 0xf7, 0x40, 0x72, 0xf4, 0x91, 0xc9, 0x5d, 0xd4, 0xff, 0xf9, 0x75, 0x13, 0x0b, 0xd7, 0x21,
+	0x82, 0x51, 0x65, 0xf7, 0xbf, 0xfe, 0x7e, 0x1b, 0xcd, 0x54, 0xb9, 0xd5, 0x7c, 0x7a, 0xe4, 0xb5,
+	0x1d, 0x3c, 0xc7, 0x8e, 0xea, 0x15, 0xeb, 0x80, 0xd9, 0x0b, 0xeb, 0xba, 0x12, 0x9a, 0x7e, 0x1c,
+	0x26, 0x16, 0x27, 0x82, 0x0d, 0x5b, 0xe1, 0x5f, 0x82, 0xb9, 0xb0, 0xdd, 0x0b, 0xe7, 0x12, 0x82,
+	0x14, 0x4b, 0x93, 0x22, 0x83, 0x92, 0x14, 0xa4, 0x43, 0x57, 0xe1, 0x7e, 0x68, 0xa6, 0x30, 0xa8,
+	0x58, 0x9e, 0x18, 0x1a, 0x54, 0xdd, 0x03, 0xf3, 0x97, 0x48, 0x73, 0xed, 0x8a, 0x89, 0x8d, 0x83,
+	0xc5, 0xf5, 0xbf, 0x00, 0x0f, 0x6b, 0x8b, 0x37, 0x5e, 0x9f, 0x1e, 0xac, 0x72, 0xb5, 0x47, 0x87,
+	0xc7, 0x12, 0x77, 0x74, 0x2c, 0x71, 0xbf, 0x8e, 0x25, 0xee, 0xcd, 0x89, 0x14, 0x39, 0x3a, 0x91,
+	0x22, 0xdf, 0x4f, 0xa4, 0xc8, 0xb3, 0xd2, 0xe8, 0x7a, 0xb2, 0xfc, 0xd8, 0xd1, 0x83, 0x73, 0x11,
+	0xda, 0xb6, 0xb2, 0xeb, 0x4b, 0xc5, 0x5b, 0x56, 0x35, 0xee, 0x7d, 0x0d, 0xd7, 0xff, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x72, 0x2e, 0xbb, 0xca, 0xe3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -313,6 +622,14 @@ type MsgClient interface {
 	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error)
+	// DepositSponsorFunds deposits funds that pay the fees of the
+	// MsgPayForBlobs sponsored by an account.
+	DepositSponsorFunds(ctx context.Context, in *MsgDepositSponsorFunds, opts ...grpc.CallOption) (*MsgDepositSponsorFundsResponse, error)
+	// WithdrawSponsorFunds withdraws funds deposited by a sponsor.
+	WithdrawSponsorFunds(ctx context.Context, in *MsgWithdrawSponsorFunds, opts ...grpc.CallOption) (*MsgWithdrawSponsorFundsResponse, error)
+	// UpdateSponsoredSigners updates which signers a sponsor pays the
+	// MsgPayForBlobs fees of.
+	UpdateSponsoredSigners(ctx context.Context, in *MsgUpdateSponsoredSigners, opts ...grpc.CallOption) (*MsgUpdateSponsoredSignersResponse, error)
 }
 
 type msgClient struct {
@@ -341,12 +658,47 @@ func (c *msgClient) UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParam
 	return out, nil
 }
 
+func (c *msgClient) DepositSponsorFunds(ctx context.Context, in *MsgDepositSponsorFunds, opts ...grpc.CallOption) (*MsgDepositSponsorFundsResponse, error) {
+	out := new(MsgDepositSponsorFundsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/DepositSponsorFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSponsorFunds(ctx context.Context, in *MsgWithdrawSponsorFunds, opts ...grpc.CallOption) (*MsgWithdrawSponsorFundsResponse, error) {
+	out := new(MsgWithdrawSponsorFundsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/WithdrawSponsorFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSponsoredSigners(ctx context.Context, in *MsgUpdateSponsoredSigners, opts ...grpc.CallOption) (*MsgUpdateSponsoredSignersResponse, error) {
+	out := new(MsgUpdateSponsoredSignersResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateSponsoredSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
 	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(context.Context, *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error)
+	// DepositSponsorFunds deposits funds that pay the fees of the
+	// MsgPayForBlobs sponsored by an account.
+	DepositSponsorFunds(context.Context, *MsgDepositSponsorFunds) (*MsgDepositSponsorFundsResponse, error)
+	// WithdrawSponsorFunds withdraws funds deposited by a sponsor.
+	WithdrawSponsorFunds(context.Context, *MsgWithdrawSponsorFunds) (*MsgWithdrawSponsorFundsResponse, error)
+	// UpdateSponsoredSigners updates which signers a sponsor pays the
+	// MsgPayForBlobs fees of.
+	UpdateSponsoredSigners(context.Context, *MsgUpdateSponsoredSigners) (*MsgUpdateSponsoredSignersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -359,6 +711,15 @@ func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBl
 func (*UnimplementedMsgServer) UpdateBlobParams(ctx context.Context, req *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobParams not implemented")
 }
+func (*UnimplementedMsgServer) DepositSponsorFunds(ctx context.Context, req *MsgDepositSponsorFunds) (*MsgDepositSponsorFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositSponsorFunds not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSponsorFunds(ctx context.Context, req *MsgWithdrawSponsorFunds) (*MsgWithdrawSponsorFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSponsorFunds not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSponsoredSigners(ctx context.Context, req *MsgUpdateSponsoredSigners) (*MsgUpdateSponsoredSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSponsoredSigners not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -400,6 +761,60 @@ func _Msg_UpdateBlobParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositSponsorFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositSponsorFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositSponsorFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/DepositSponsorFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositSponsorFunds(ctx, req.(*MsgDepositSponsorFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSponsorFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSponsorFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSponsorFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/WithdrawSponsorFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSponsorFunds(ctx, req.(*MsgWithdrawSponsorFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSponsoredSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSponsoredSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSponsoredSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/UpdateSponsoredSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSponsoredSigners(ctx, req.(*MsgUpdateSponsoredSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Msg",
@@ -413,6 +828,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBlobParams",
 			Handler:    _Msg_UpdateBlobParams_Handler,
 		},
+		{
+			MethodName: "DepositSponsorFunds",
+			Handler:    _Msg_DepositSponsorFunds_Handler,
+		},
+		{
+			MethodName: "WithdrawSponsorFunds",
+			Handler:    _Msg_WithdrawSponsorFunds_Handler,
+		},
+		{
+			MethodName: "UpdateSponsoredSigners",
+			Handler:    _Msg_UpdateSponsoredSigners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/tx.proto",
@@ -438,6 +865,13 @@ func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ShareVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ShareVersions)*10)
 		var j1 int
@@ -588,24 +1022,220 @@ func (m *MsgUpdateBlobParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDepositSponsorFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPayForBlobs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDepositSponsorFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSponsorFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSponsorFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSponsorFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSponsorFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsoredSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsoredSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSponsoredSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsoredSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsoredSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSponsoredSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -635,6 +1265,10 @@ func (m *MsgPayForBlobs) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -671,6 +1305,90 @@ func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDepositSponsorFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositSponsorFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSponsorFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSponsoredSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSponsoredSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -954,6 +1672,38 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1190,6 +1940,502 @@ func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDepositSponsorFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositSponsorFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositSponsorFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositSponsorFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositSponsorFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositSponsorFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSponsorFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSponsorFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSponsoredSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSponsoredSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSponsoredSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSponsoredSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSponsoredSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSponsoredSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNetworkMinGasPrice returns the network min gas price that transactions of
+// the current block must pay. It is the NetworkMinGasPrice param unless the
+// dynamic min gas price is enabled, in which case it is the price adjusted at
+// the end of the previous block.
+func (k Keeper) GetNetworkMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if !params.DynamicMinGasPriceEnabled {
+		return params.NetworkMinGasPrice
+	}
+
+	price := params.NetworkMinGasPrice
+	if bz := ctx.KVStore(k.storeKey).Get(types.DynamicNetworkMinGasPriceKey); len(bz) != 0 {
+		var dynamicPrice math.LegacyDec
+		if err := dynamicPrice.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		price = dynamicPrice
+	}
+	// the bounds may have been changed by governance since the price was set.
+	return clampDec(price, params.MinGasPriceFloor, params.MinGasPriceCeiling)
+}
+
+// SetSquareUtilization records how many of the shares of the max square are
+// used by the block that is being executed. The dynamic min gas price is
+// adjusted based on it at the end of the block.
+func (k Keeper) SetSquareUtilization(ctx sdk.Context, usedShares, maxShares int) {
+	if maxShares <= 0 {
+		return
+	}
+	utilization := math.LegacyNewDec(int64(usedShares)).QuoInt64(int64(maxShares))
+	bz, err := math.LegacyMinDec(utilization, math.LegacyOneDec()).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.SquareUtilizationKey, bz)
+}
+
+// EndBlocker adjusts the dynamic network min gas price for the next block
+// based on the square utilization of the current block.
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+
+	utilization := math.LegacyZeroDec()
+	if bz := store.Get(types.SquareUtilizationKey); len(bz) != 0 {
+		utilization = math.LegacyDec{}
+		if err := utilization.Unmarshal(bz); err != nil {
+			return err
+		}
+		store.Delete(types.SquareUtilizationKey)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.DynamicMinGasPriceEnabled {
+		// start over from the NetworkMinGasPrice param if the dynamic min gas
+		// price is enabled again.
+		store.Delete(types.DynamicNetworkMinGasPriceKey)
+		return nil
+	}
+
+	price := k.GetNetworkMinGasPrice(ctx)
+	nextPrice := NextNetworkMinGasPrice(params, price, utilization)
+	bz, err := nextPrice.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.DynamicNetworkMinGasPriceKey, bz)
+
+	if nextPrice.Equal(price) {
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewUpdateNetworkMinGasPriceEvent(nextPrice, utilization))
+}
+
+// NextNetworkMinGasPrice returns the network min gas price that follows price
+// after a block with the given square utilization. The price changes by
+// MaxChangeRate times the relative deviation of the utilization from
+// TargetSquareUtilization, so it changes the most for blocks that are full or
+// empty, and it stays within MinGasPriceFloor and MinGasPriceCeiling.
+func NextNetworkMinGasPrice(params types.Params, price, utilization math.LegacyDec) math.LegacyDec {
+	target := params.TargetSquareUtilization
+	var deviation math.LegacyDec
+	if utilization.GT(target) {
+		deviation = utilization.Sub(target).Quo(math.LegacyOneDec().Sub(target))
+	} else {
+		deviation = utilization.Sub(target).Quo(target)
+	}
+
+	change := math.LegacyOneDec().Add(params.MaxChangeRate.Mul(deviation))
+	return clampDec(price.Mul(change), params.MinGasPriceFloor, params.MinGasPriceCeiling)
+}
+
+func clampDec(d, lower, upper math.LegacyDec) math.LegacyDec {
+	return math.LegacyMaxDec(lower, math.LegacyMinDec(d, upper))
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/keeper"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	"github.com/stretchr/testify/require"
+)
+
+func dynamicParams() types.Params {
+	params := types.NewParams(math.LegacyMustNewDecFromStr("0.01"))
+	params.DynamicMinGasPriceEnabled = true
+	params.MinGasPriceFloor = math.LegacyMustNewDecFromStr("0.005")
+	params.MinGasPriceCeiling = math.LegacyMustNewDecFromStr("0.02")
+	params.MaxChangeRate = math.LegacyMustNewDecFromStr("0.1")
+	params.TargetSquareUtilization = math.LegacyMustNewDecFromStr("0.5")
+	return params
+}
+
+func TestNextNetworkMinGasPrice(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       string
+		utilization string
+		want        string
+	}{
+		{name: "at target", price: "0.01", utilization: "0.5", want: "0.01"},
+		{name: "full square", price: "0.01", utilization: "1", want: "0.011"},
+		{name: "empty square", price: "0.01", utilization: "0", want: "0.009"},
+		{name: "above target", price: "0.01", utilization: "0.75", want: "0.0105"},
+		{name: "below target", price: "0.01", utilization: "0.25", want: "0.0095"},
+		{name: "clamped to ceiling", price: "0.0199", utilization: "1", want: "0.02"},
+		{name: "clamped to floor", price: "0.0051", utilization: "0", want: "0.005"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := keeper.NextNetworkMinGasPrice(
+				dynamicParams(),
+				math.LegacyMustNewDecFromStr(tc.price),
+				math.LegacyMustNewDecFromStr(tc.utilization),
+			)
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.want).String(), got.String())
+		})
+	}
+}
+
+func TestDynamicNetworkMinGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	// the static param is used while the dynamic min gas price is disabled.
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.DefaultNetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+
+	params := dynamicParams()
+	k.SetParams(ctx, params)
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+
+	// a full square raises the price of the next block.
+	k.SetSquareUtilization(ctx, 64, 64)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.011"), k.GetNetworkMinGasPrice(ctx))
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// a block without a recorded utilization counts as empty.
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0099"), k.GetNetworkMinGasPrice(ctx))
+
+	resp, err := k.NetworkMinGasPrice(ctx, &types.QueryNetworkMinGasPrice{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0099"), resp.NetworkMinGasPrice)
+
+	// lowering the ceiling applies to the current price.
+	params.MinGasPriceCeiling = math.LegacyMustNewDecFromStr("0.008")
+	k.SetParams(ctx, params)
+	require.Equal(t, params.MinGasPriceCeiling, k.GetNetworkMinGasPrice(ctx))
+
+	// disabling the dynamic min gas price restores the static param.
+	params.DynamicMinGasPriceEnabled = false
+	k.SetParams(ctx, params)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+
+	params.DynamicMinGasPriceEnabled = true
+	k.SetParams(ctx, params)
+	require.Equal(t, params.MinGasPriceCeiling, k.GetNetworkMinGasPrice(ctx))
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.Params{NetworkMinGasPrice: math.LegacyMustNewDecFromStr("0.1")}.Validate())
+	require.NoError(t, dynamicParams().Validate())
+
+	invalid := dynamicParams()
+	invalid.MinGasPriceCeiling = math.LegacyMustNewDecFromStr("0.001")
+	require.Error(t, invalid.Validate())
+
+	invalid = dynamicParams()
+	invalid.MaxChangeRate = math.LegacyZeroDec()
+	require.Error(t, invalid.Validate())
+
+	invalid = dynamicParams()
+	invalid.TargetSquareUtilization = math.LegacyOneDec()
+	require.Error(t, invalid.Validate())
+
+	invalid = dynamicParams()
+	invalid.MinGasPriceFloor = math.LegacyDec{}
+	require.Error(t, invalid.Validate())
+}
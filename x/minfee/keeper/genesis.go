@@ -17,14 +17,20 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	}
 
 	k.SetParams(sdkCtx, genState.Params)
+	for _, change := range genState.ParamsHistory {
+		k.AppendParamsChange(sdkCtx, change)
+	}
 	return nil
 }
 
 // ExportGenesis returns the minfee module's exported genesis.
 func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(sdkCtx)
 	genesis := types.DefaultGenesis()
 	// TODO: genesis should hold params not this field.
-	genesis.NetworkMinGasPrice = k.GetParams(sdkCtx).NetworkMinGasPrice
+	genesis.NetworkMinGasPrice = params.NetworkMinGasPrice
+	genesis.Params = params
+	genesis.ParamsHistory = k.GetParamsHistory(sdkCtx)
 	return genesis
 }
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -13,8 +15,7 @@ var _ types.QueryServer = &Keeper{}
 
 // NetworkMinGasPrice returns the network minimum gas price.
 func (k *Keeper) NetworkMinGasPrice(ctx context.Context, _ *types.QueryNetworkMinGasPrice) (*types.QueryNetworkMinGasPriceResponse, error) {
-	// delegate to the self managed params.
-	networkMinGasPrice := k.GetParams(sdk.UnwrapSDKContext(ctx)).NetworkMinGasPrice
+	networkMinGasPrice := k.GetNetworkMinGasPrice(sdk.UnwrapSDKContext(ctx))
 	return &types.QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: networkMinGasPrice}, nil
 }
 
@@ -25,3 +26,27 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+// ParamsHistory returns the recorded changes of the parameters, oldest first.
+func (k Keeper) ParamsHistory(c context.Context, req *types.QueryParamsHistoryRequest) (*types.QueryParamsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var changes []types.ParamsChange
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamsHistoryKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var change types.ParamsChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
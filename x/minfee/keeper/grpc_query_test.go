@@ -9,6 +9,7 @@ import (
 	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
 	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -96,3 +97,82 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryParamsHistory(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	sdkCtx := testApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	resp, err := k.ParamsHistory(sdkCtx, &types.QueryParamsHistoryRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Changes)
+
+	oldParams := k.GetParams(sdkCtx)
+	prices := []string{"0.0005", "0.001", "0.002"}
+	for i, price := range prices {
+		ctx := sdkCtx.WithBlockHeight(int64(10 + i))
+		_, err := k.UpdateMinfeeParams(ctx, &types.MsgUpdateMinfeeParams{
+			Authority: authority,
+			Params:    types.NewParams(sdkmath.LegacyMustNewDecFromStr(price)),
+		})
+		require.NoError(t, err)
+	}
+
+	// a rejected update is not recorded.
+	_, err = k.UpdateMinfeeParams(sdkCtx, &types.MsgUpdateMinfeeParams{
+		Authority: "invalid-authority",
+		Params:    types.NewParams(sdkmath.LegacyMustNewDecFromStr("0.1")),
+	})
+	require.Error(t, err)
+
+	history := k.GetParamsHistory(sdkCtx)
+	require.Len(t, history, len(prices))
+	for i, change := range history {
+		require.Equal(t, int64(10+i), change.Height)
+		require.Equal(t, authority, change.Authority)
+		require.Equal(t, oldParams, change.OldParams)
+		require.Equal(t, sdkmath.LegacyMustNewDecFromStr(prices[i]), change.NewParams.NetworkMinGasPrice)
+		oldParams = change.NewParams
+	}
+
+	resp, err = k.ParamsHistory(sdkCtx, &types.QueryParamsHistoryRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, history[:2], resp.Changes)
+	require.EqualValues(t, len(prices), resp.Pagination.Total)
+
+	resp, err = k.ParamsHistory(sdkCtx, &types.QueryParamsHistoryRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, history[2:], resp.Changes)
+
+	_, err = k.ParamsHistory(sdkCtx, nil)
+	require.Error(t, err)
+
+	// the history survives a genesis export and import.
+	genesis := k.ExportGenesis(sdkCtx)
+	require.Equal(t, history, genesis.ParamsHistory)
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	importApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	importCtx := importApp.NewContext(false)
+	require.NoError(t, importApp.MinFeeKeeper.InitGenesis(importCtx, *genesis))
+	require.Equal(t, history, importApp.MinFeeKeeper.GetParamsHistory(importCtx))
+	require.Equal(t, k.GetParams(sdkCtx), importApp.MinFeeKeeper.GetParams(importCtx))
+}
+
+func TestValidateGenesisParamsHistory(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	genesis := types.DefaultGenesis()
+	genesis.ParamsHistory = []types.ParamsChange{
+		{Height: 5, OldParams: types.DefaultParams(), NewParams: types.DefaultParams(), Authority: authority},
+		{Height: 5, OldParams: types.DefaultParams(), NewParams: types.DefaultParams(), Authority: authority},
+	}
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	genesis.ParamsHistory[1].Height = 4
+	require.Error(t, types.ValidateGenesis(genesis))
+
+	genesis.ParamsHistory[1].Height = 6
+	genesis.ParamsHistory[1].Authority = ""
+	require.Error(t, types.ValidateGenesis(genesis))
+}
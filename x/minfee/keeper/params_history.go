@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendParamsChange records a change of the parameters after all previously
+// recorded changes.
+func (k Keeper) AppendParamsChange(ctx sdk.Context, change types.ParamsChange) {
+	store := ctx.KVStore(k.storeKey)
+
+	var index uint64
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.ParamsHistoryKeyPrefix)
+	if iterator.Valid() {
+		index = binary.BigEndian.Uint64(iterator.Key()[len(types.ParamsHistoryKeyPrefix):]) + 1
+	}
+	iterator.Close()
+
+	store.Set(types.ParamsHistoryKey(index), k.cdc.MustMarshal(&change))
+}
+
+// GetParamsHistory returns all recorded parameter changes, oldest first.
+func (k Keeper) GetParamsHistory(ctx sdk.Context) []types.ParamsChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamsHistoryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.ParamsChange
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ParamsChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
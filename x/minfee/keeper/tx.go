@@ -24,6 +24,12 @@ func (k Keeper) UpdateMinfeeParams(goCtx context.Context, msg *types.MsgUpdateMi
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
 	}
 
+	k.AppendParamsChange(ctx, types.ParamsChange{
+		Height:    ctx.BlockHeight(),
+		OldParams: k.GetParams(ctx),
+		NewParams: msg.Params,
+		Authority: msg.Authority,
+	})
 	k.SetParams(ctx, msg.Params)
 
 	// Emit an event indicating successful parameter update.
@@ -1,10 +1,12 @@
 package minfee
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/core/appmodule"
+	"github.com/celestiaorg/celestia-app/v6/x/minfee/client/cli"
 	"github.com/celestiaorg/celestia-app/v6/x/minfee/keeper"
 	"github.com/celestiaorg/celestia-app/v6/x/minfee/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 )
 
 var (
@@ -23,6 +26,7 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 // AppModule implements the AppModule interface for the minfee module.
@@ -66,6 +70,11 @@ func (AppModule) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
 func (am AppModule) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
 
+// GetQueryCmd returns the minfee module's root query command.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.minfeeKeeper)
@@ -110,5 +119,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	return am.cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock adjusts the dynamic network min gas price for the next block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.minfeeKeeper.EndBlocker(ctx)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -77,28 +79,81 @@ func (m *EventUpdateMinfeeParams) GetParams() Params {
 	return Params{}
 }
 
+// EventUpdateNetworkMinGasPrice defines an event that is emitted when the
+// dynamic network min gas price is adjusted at the end of a block.
+type EventUpdateNetworkMinGasPrice struct {
+	// network_min_gas_price is the network min gas price of the next block.
+	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// square_utilization is the utilization of the square of the block that
+	// the price was adjusted for.
+	SquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=square_utilization,json=squareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"square_utilization"`
+}
+
+func (m *EventUpdateNetworkMinGasPrice) Reset()         { *m = EventUpdateNetworkMinGasPrice{} }
+func (m *EventUpdateNetworkMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateNetworkMinGasPrice) ProtoMessage()    {}
+func (*EventUpdateNetworkMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c24135af0aaa5c3, []int{1}
+}
+func (m *EventUpdateNetworkMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateNetworkMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateNetworkMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateNetworkMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateNetworkMinGasPrice.Merge(m, src)
+}
+func (m *EventUpdateNetworkMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateNetworkMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateNetworkMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateNetworkMinGasPrice proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventUpdateMinfeeParams)(nil), "celestia.minfee.v1.EventUpdateMinfeeParams")
+	proto.RegisterType((*EventUpdateNetworkMinGasPrice)(nil), "celestia.minfee.v1.EventUpdateNetworkMinGasPrice")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/event.proto", fileDescriptor_0c24135af0aaa5c3) }
 
 var fileDescriptor_0c24135af0aaa5c3 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xc9, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0xe4, 0xb1, 0xe8, 0x29, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x68,
-	0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x36, 0x97,
-	0xb8, 0x2b, 0xc8, 0xe4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0x54, 0x5f, 0xb0, 0xd6, 0x00, 0xb0, 0x36,
-	0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x28, 0x4f, 0xc8, 0x82, 0x8b, 0x0d, 0x62, 0xb0, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x94, 0x1e, 0xa6, 0x73, 0xf4, 0x20, 0x66, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xef, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0xd3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c,
-	0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x98, 0xd7, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x3e, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x1a, 0x05, 0xdd, 0x2e, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xbf, 0x4e, 0x3a, 0x41,
+	0x10, 0xc7, 0xef, 0x7e, 0xf9, 0x85, 0xc4, 0xb5, 0xf2, 0xe2, 0x1f, 0xc4, 0x78, 0x10, 0x2a, 0x1a,
+	0xf6, 0x02, 0x36, 0xd6, 0x04, 0x63, 0x03, 0x86, 0x90, 0xd0, 0xd8, 0x9c, 0xcb, 0x32, 0x2e, 0x1b,
+	0xb8, 0xdd, 0x73, 0x77, 0x41, 0xf1, 0x29, 0x7c, 0x18, 0x1f, 0x82, 0x92, 0x58, 0x19, 0x0b, 0x62,
+	0xa0, 0xf0, 0x35, 0xcc, 0xdd, 0x1e, 0x6a, 0x82, 0x95, 0xdd, 0xcc, 0x7c, 0x77, 0x3e, 0xdf, 0x99,
+	0x1d, 0xe4, 0x53, 0x18, 0x83, 0x36, 0x9c, 0x04, 0x11, 0x17, 0xb7, 0x00, 0xc1, 0xb4, 0x16, 0xc0,
+	0x14, 0x84, 0xc1, 0xb1, 0x92, 0x46, 0x7a, 0xde, 0x46, 0xc7, 0x56, 0xc7, 0xd3, 0x5a, 0xa1, 0xf8,
+	0x4b, 0x4f, 0x4c, 0x14, 0x89, 0xb4, 0x6d, 0x2a, 0xec, 0x33, 0xc9, 0x64, 0x1a, 0x06, 0x49, 0x94,
+	0x55, 0x8f, 0xa9, 0xd4, 0x91, 0xd4, 0xa1, 0x15, 0x6c, 0x62, 0xa5, 0xf2, 0x08, 0x1d, 0x5d, 0x24,
+	0xa6, 0xbd, 0x78, 0x40, 0x0c, 0xb4, 0x53, 0x6a, 0x27, 0x25, 0x7a, 0x87, 0x28, 0xa7, 0x39, 0x13,
+	0xa0, 0xf2, 0x6e, 0xc9, 0xad, 0xec, 0x74, 0xb3, 0xcc, 0x3b, 0x47, 0x39, 0xeb, 0x99, 0xff, 0x57,
+	0x72, 0x2b, 0xbb, 0xf5, 0x02, 0xde, 0x9e, 0x14, 0x5b, 0x46, 0xe3, 0xff, 0x7c, 0x59, 0x74, 0xba,
+	0xd9, 0xfb, 0xf2, 0x87, 0x8b, 0x4e, 0x7f, 0xb8, 0x5d, 0x81, 0xb9, 0x97, 0x6a, 0xd4, 0xe6, 0xe2,
+	0x92, 0xe8, 0x8e, 0xe2, 0x14, 0xbc, 0x01, 0x3a, 0x10, 0xb6, 0x1a, 0x46, 0x5c, 0x84, 0x8c, 0x24,
+	0x43, 0x73, 0x0a, 0x76, 0x84, 0x46, 0x2d, 0xc1, 0xbd, 0x2d, 0x8b, 0x27, 0x76, 0x07, 0x3d, 0x18,
+	0x61, 0x2e, 0x83, 0x88, 0x98, 0x21, 0x6e, 0x01, 0x23, 0x74, 0xd6, 0x04, 0xfa, 0xf2, 0x5c, 0x45,
+	0xd9, 0x8a, 0x4d, 0xa0, 0x5d, 0x4f, 0x6c, 0xbb, 0xdc, 0x20, 0x4f, 0xdf, 0x4d, 0x88, 0x82, 0x70,
+	0x62, 0xf8, 0x98, 0x3f, 0x12, 0xc3, 0xa5, 0x48, 0xb7, 0xf9, 0x93, 0xc5, 0x9e, 0x85, 0xf5, 0xbe,
+	0x59, 0x8d, 0xd6, 0x7c, 0xe5, 0xbb, 0x8b, 0x95, 0xef, 0xbe, 0xaf, 0x7c, 0xf7, 0x69, 0xed, 0x3b,
+	0x8b, 0xb5, 0xef, 0xbc, 0xae, 0x7d, 0xe7, 0xba, 0xce, 0xb8, 0x19, 0x4e, 0xfa, 0x98, 0xca, 0x28,
+	0xd8, 0xfc, 0x9b, 0x54, 0xec, 0x2b, 0xae, 0x92, 0x38, 0x0e, 0x1e, 0x36, 0xf7, 0x35, 0xb3, 0x18,
+	0x74, 0x3f, 0x97, 0xde, 0xea, 0xec, 0x33, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xbf, 0x1e, 0x4a, 0x33,
+	0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateMinfeeParams) Marshal() (dAtA []byte, err error) {
@@ -141,6 +196,49 @@ func (m *EventUpdateMinfeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateNetworkMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateNetworkMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateNetworkMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SquareUtilization.Size()
+		i -= size
+		if _, err := m.SquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -167,6 +265,19 @@ func (m *EventUpdateMinfeeParams) Size() (n int) {
 	return n
 }
 
+func (m *EventUpdateNetworkMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SquareUtilization.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -288,6 +399,124 @@ func (m *EventUpdateMinfeeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventUpdateNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateNetworkMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateNetworkMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
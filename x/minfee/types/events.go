@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/math"
+
 // NewUpdateMinfeeParamsEvent returns a new EventUpdateMinfeeParams
 func NewUpdateMinfeeParamsEvent(authority string, params Params) *EventUpdateMinfeeParams {
 	return &EventUpdateMinfeeParams{
@@ -7,3 +9,11 @@ func NewUpdateMinfeeParamsEvent(authority string, params Params) *EventUpdateMin
 		Params: params,
 	}
 }
+
+// NewUpdateNetworkMinGasPriceEvent returns a new EventUpdateNetworkMinGasPrice
+func NewUpdateNetworkMinGasPriceEvent(networkMinGasPrice, squareUtilization math.LegacyDec) *EventUpdateNetworkMinGasPrice {
+	return &EventUpdateNetworkMinGasPrice{
+		NetworkMinGasPrice: networkMinGasPrice,
+		SquareUtilization:  squareUtilization,
+	}
+}
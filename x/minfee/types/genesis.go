@@ -8,9 +8,7 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		NetworkMinGasPrice: DefaultNetworkMinGasPrice, // TODO: remove this field
-		Params: Params{
-			NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-		},
+		Params:             DefaultParams(),
 	}
 }
 
@@ -20,5 +18,17 @@ func ValidateGenesis(genesis *GenesisState) error {
 		return fmt.Errorf("network min gas price cannot be negative or zero: %g", genesis.NetworkMinGasPrice)
 	}
 
+	for i, change := range genesis.ParamsHistory {
+		if change.Height < 0 {
+			return fmt.Errorf("params change %d has a negative height: %d", i, change.Height)
+		}
+		if i > 0 && change.Height < genesis.ParamsHistory[i-1].Height {
+			return fmt.Errorf("params change %d at height %d is recorded after a change at height %d", i, change.Height, genesis.ParamsHistory[i-1].Height)
+		}
+		if change.Authority == "" {
+			return fmt.Errorf("params change %d has no authority", i)
+		}
+	}
+
 	return genesis.Params.Validate()
 }
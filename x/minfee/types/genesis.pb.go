@@ -29,6 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
 	Params             Params                      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// params_history are the recorded parameter changes, oldest first.
+	ParamsHistory []ParamsChange `protobuf:"bytes,3,rep,name=params_history,json=paramsHistory,proto3" json:"params_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +73,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetParamsHistory() []ParamsChange {
+	if m != nil {
+		return m.ParamsHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.minfee.v1.GenesisState")
 }
@@ -78,26 +87,28 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/genesis.proto", fileDescriptor_40506204178306cf) }
 
 var fileDescriptor_40506204178306cf = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0x5f, 0xbf, 0x2a, 0x11, 0x98, 0x22, 0x90, 0x4a, 0x91, 0xdc, 0x88, 0xa9, 0x4b,
-	0x6d, 0xa5, 0x2c, 0xcc, 0x51, 0xa5, 0x2e, 0x45, 0xaa, 0xca, 0xc6, 0x12, 0xb9, 0xee, 0xc5, 0xb5,
-	0x8a, 0xe3, 0x28, 0x36, 0x85, 0xbe, 0x05, 0x4f, 0xc2, 0xc4, 0x43, 0x74, 0xac, 0x98, 0x10, 0x43,
-	0x85, 0x92, 0x17, 0x41, 0x89, 0x13, 0x16, 0xd8, 0x8e, 0x7d, 0xcf, 0xf9, 0x8e, 0x7d, 0xfd, 0x90,
-	0xc3, 0x03, 0x18, 0x2b, 0x19, 0x55, 0x32, 0xbd, 0x07, 0xa0, 0x9b, 0x88, 0x0a, 0x48, 0xc1, 0x48,
-	0x43, 0xb2, 0x5c, 0x5b, 0x1d, 0x04, 0xad, 0x83, 0x38, 0x07, 0xd9, 0x44, 0xbd, 0xfe, 0x1f, 0xa9,
-	0x8c, 0xe5, 0x4c, 0x35, 0xa1, 0xde, 0xa9, 0xd0, 0x42, 0xd7, 0x92, 0x56, 0xaa, 0xb9, 0x3d, 0xe7,
-	0xda, 0x28, 0x6d, 0x12, 0x37, 0x70, 0x07, 0x37, 0xba, 0x7c, 0x45, 0xfe, 0xc9, 0xc4, 0xf5, 0xde,
-	0x5a, 0x66, 0x21, 0x58, 0xfa, 0x67, 0x29, 0xd8, 0x27, 0x9d, 0xaf, 0x13, 0x25, 0xd3, 0x44, 0xb0,
-	0x2a, 0x26, 0x39, 0x74, 0x51, 0x88, 0x06, 0x47, 0x71, 0xb4, 0x3b, 0xf4, 0xbd, 0xcf, 0x43, 0xff,
-	0xc2, 0x51, 0xcc, 0x72, 0x4d, 0xa4, 0xa6, 0x8a, 0xd9, 0x15, 0x99, 0x82, 0x60, 0x7c, 0x3b, 0x06,
-	0xfe, 0xfe, 0x36, 0xf4, 0x9b, 0x92, 0x31, 0xf0, 0x79, 0xd0, 0xf0, 0x6e, 0x64, 0x3a, 0x61, 0x66,
-	0x56, 0xc1, 0x82, 0x6b, 0xbf, 0xe3, 0xde, 0xdd, 0xfd, 0x17, 0xa2, 0xc1, 0xf1, 0xa8, 0x47, 0x7e,
-	0xff, 0x96, 0xcc, 0x6a, 0x47, 0xfc, 0xbf, 0xaa, 0x9c, 0x37, 0xfe, 0x78, 0xba, 0x2b, 0x30, 0xda,
-	0x17, 0x18, 0x7d, 0x15, 0x18, 0xbd, 0x94, 0xd8, 0xdb, 0x97, 0xd8, 0xfb, 0x28, 0xb1, 0x77, 0x37,
-	0x12, 0xd2, 0xae, 0x1e, 0x17, 0x84, 0x6b, 0x45, 0x5b, 0x9a, 0xce, 0xc5, 0x8f, 0x1e, 0xb2, 0x2c,
-	0xa3, 0xcf, 0xed, 0xe6, 0xec, 0x36, 0x03, 0xb3, 0xe8, 0xd4, 0x5b, 0xb8, 0xfa, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0x34, 0xb0, 0x67, 0x8f, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4f, 0x02, 0x31,
+	0x18, 0xc6, 0xaf, 0x62, 0x48, 0x3c, 0xd4, 0xe1, 0xa2, 0x09, 0x62, 0x72, 0x5c, 0x9c, 0x58, 0x68,
+	0x03, 0x2e, 0xce, 0x48, 0x82, 0x03, 0x24, 0x04, 0x37, 0x97, 0x4b, 0x29, 0xaf, 0xbd, 0x06, 0xaf,
+	0xbd, 0x5c, 0x2b, 0xca, 0xb7, 0xf0, 0xc3, 0xf8, 0x21, 0x18, 0x89, 0x93, 0x71, 0x20, 0x06, 0x3e,
+	0x87, 0x89, 0x39, 0x5a, 0x5c, 0xd4, 0xed, 0x69, 0xdf, 0xe7, 0xf9, 0x3d, 0xfd, 0xe3, 0x47, 0x0c,
+	0x1e, 0x40, 0x1b, 0x41, 0x49, 0x2a, 0xe4, 0x3d, 0x00, 0x99, 0xb5, 0x08, 0x07, 0x09, 0x5a, 0x68,
+	0x9c, 0xe5, 0xca, 0xa8, 0x20, 0xd8, 0x39, 0xb0, 0x75, 0xe0, 0x59, 0xab, 0x56, 0xff, 0x23, 0x95,
+	0xd1, 0x9c, 0xa6, 0x2e, 0x54, 0x3b, 0xe1, 0x8a, 0xab, 0xad, 0x24, 0x85, 0x72, 0xbb, 0x67, 0x4c,
+	0xe9, 0x54, 0xe9, 0xd8, 0x0e, 0xec, 0xc2, 0x8e, 0x2e, 0xbe, 0x90, 0x7f, 0xd8, 0xb3, 0xbd, 0xb7,
+	0x86, 0x1a, 0x08, 0x26, 0xfe, 0xa9, 0x04, 0xf3, 0xa4, 0xf2, 0x69, 0x9c, 0x0a, 0x19, 0x73, 0x5a,
+	0xc4, 0x04, 0x83, 0x2a, 0x8a, 0x50, 0xe3, 0xa0, 0xd3, 0x5a, 0xac, 0xea, 0xde, 0xc7, 0xaa, 0x7e,
+	0x6e, 0x29, 0x7a, 0x32, 0xc5, 0x42, 0x91, 0x94, 0x9a, 0x04, 0xf7, 0x81, 0x53, 0x36, 0xef, 0x02,
+	0x7b, 0x7b, 0x6d, 0xfa, 0xae, 0xa4, 0x0b, 0x6c, 0x14, 0x38, 0xde, 0x40, 0xc8, 0x1e, 0xd5, 0xc3,
+	0x02, 0x16, 0x5c, 0xf9, 0x65, 0x7b, 0xee, 0xea, 0x5e, 0x84, 0x1a, 0x95, 0x76, 0x0d, 0xff, 0xbe,
+	0x2d, 0x1e, 0x6e, 0x1d, 0x9d, 0xfd, 0xa2, 0x72, 0xe4, 0xfc, 0xc1, 0xc0, 0x3f, 0xb6, 0x2a, 0x4e,
+	0x84, 0x36, 0x2a, 0x9f, 0x57, 0x4b, 0x51, 0xa9, 0x51, 0x69, 0x47, 0xff, 0x13, 0xae, 0x13, 0x2a,
+	0x39, 0x38, 0xce, 0x91, 0x4d, 0xdf, 0xd8, 0x70, 0xa7, 0xbf, 0x58, 0x87, 0x68, 0xb9, 0x0e, 0xd1,
+	0xe7, 0x3a, 0x44, 0x2f, 0x9b, 0xd0, 0x5b, 0x6e, 0x42, 0xef, 0x7d, 0x13, 0x7a, 0x77, 0x6d, 0x2e,
+	0x4c, 0xf2, 0x38, 0xc6, 0x4c, 0xa5, 0x64, 0x87, 0x56, 0x39, 0xff, 0xd1, 0x4d, 0x9a, 0x65, 0xe4,
+	0x79, 0xf7, 0x11, 0x66, 0x9e, 0x81, 0x1e, 0x97, 0xb7, 0x8f, 0x7a, 0xf9, 0x1d, 0x00, 0x00, 0xff,
+	0xff, 0x46, 0xda, 0x0c, 0x57, 0xde, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +131,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamsHistory) > 0 {
+		for iNdEx := len(m.ParamsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -164,6 +189,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ParamsHistory) > 0 {
+		for _, e := range m.ParamsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +300,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsHistory = append(m.ParamsHistory, ParamsChange{})
+			if err := m.ParamsHistory[len(m.ParamsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
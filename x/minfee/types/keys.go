@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "minfee"
@@ -10,3 +12,22 @@ const (
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
 )
+
+var (
+	// DynamicNetworkMinGasPriceKey is the key of the current network min gas
+	// price when the dynamic min gas price is enabled.
+	DynamicNetworkMinGasPriceKey = []byte("dynamic_network_min_gas_price")
+
+	// SquareUtilizationKey is the key of the utilization of the square of the
+	// block that is being executed.
+	SquareUtilizationKey = []byte("square_utilization")
+
+	// ParamsHistoryKeyPrefix is the prefix of the recorded parameter changes.
+	ParamsHistoryKeyPrefix = []byte{0x01}
+)
+
+// ParamsHistoryKey returns the key of the parameter change with the given
+// index. Changes are indexed in the order they were recorded.
+func ParamsHistoryKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, ParamsHistoryKeyPrefix...), index)
+}
@@ -7,7 +7,21 @@ import (
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 )
 
-var DefaultNetworkMinGasPrice math.LegacyDec
+var (
+	DefaultNetworkMinGasPrice math.LegacyDec
+	// DefaultMinGasPriceFloor is the default lowest value of the dynamic min
+	// gas price.
+	DefaultMinGasPriceFloor math.LegacyDec
+	// DefaultMinGasPriceCeiling is the default highest value of the dynamic min
+	// gas price.
+	DefaultMinGasPriceCeiling = math.LegacyMustNewDecFromStr("0.1")
+	// DefaultMaxChangeRate is the default largest fraction by which the dynamic
+	// min gas price changes per block.
+	DefaultMaxChangeRate = math.LegacyMustNewDecFromStr("0.125")
+	// DefaultTargetSquareUtilization is the default fraction of the max square
+	// that the dynamic min gas price aims for.
+	DefaultTargetSquareUtilization = math.LegacyMustNewDecFromStr("0.5")
+)
 
 func init() {
 	DefaultNetworkMinGasPriceDec, err := math.LegacyNewDecFromStr(fmt.Sprintf("%f", appconsts.DefaultNetworkMinGasPrice))
@@ -15,23 +29,49 @@ func init() {
 		panic(err)
 	}
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
+	DefaultMinGasPriceFloor = DefaultNetworkMinGasPriceDec
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if !p.DynamicMinGasPriceEnabled {
+		return nil
+	}
+
+	if p.NetworkMinGasPrice.IsNil() {
+		return fmt.Errorf("network min gas price must be set")
+	}
+	if p.MinGasPriceFloor.IsNil() || !p.MinGasPriceFloor.IsPositive() {
+		return fmt.Errorf("min gas price floor must be positive: %s", p.MinGasPriceFloor)
+	}
+	if p.MinGasPriceCeiling.IsNil() || p.MinGasPriceCeiling.LT(p.MinGasPriceFloor) {
+		return fmt.Errorf("min gas price ceiling %s must not be lower than the floor %s", p.MinGasPriceCeiling, p.MinGasPriceFloor)
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be in (0, 1]: %s", p.MaxChangeRate)
+	}
+	if p.TargetSquareUtilization.IsNil() || !p.TargetSquareUtilization.IsPositive() || p.TargetSquareUtilization.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("target square utilization must be in (0, 1): %s", p.TargetSquareUtilization)
+	}
 	return nil
 }
 
 // DefaultParams returns the default parameters for the module.
 func DefaultParams() Params {
 	return Params{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
+		NetworkMinGasPrice:        DefaultNetworkMinGasPrice,
+		DynamicMinGasPriceEnabled: false,
+		MinGasPriceFloor:          DefaultMinGasPriceFloor,
+		MinGasPriceCeiling:        DefaultMinGasPriceCeiling,
+		MaxChangeRate:             DefaultMaxChangeRate,
+		TargetSquareUtilization:   DefaultTargetSquareUtilization,
 	}
 }
 
-// NewParams creates a new instance of Params with the provided NetworkMinGasPrice.
+// NewParams creates a new instance of Params with the provided NetworkMinGasPrice
+// and the default dynamic min gas price parameters.
 func NewParams(networkMinGasPrice math.LegacyDec) Params {
-	return Params{
-		NetworkMinGasPrice: networkMinGasPrice,
-	}
+	params := DefaultParams()
+	params.NetworkMinGasPrice = networkMinGasPrice
+	return params
 }
@@ -27,7 +27,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// network_min_gas_price is the minimum gas price that every transaction must
+	// pay. If the dynamic min gas price is enabled, it is the price that the
+	// adjustment starts from.
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// dynamic_min_gas_price_enabled enables adjusting the network min gas price
+	// every block based on the utilization of the previous square.
+	DynamicMinGasPriceEnabled bool `protobuf:"varint,2,opt,name=dynamic_min_gas_price_enabled,json=dynamicMinGasPriceEnabled,proto3" json:"dynamic_min_gas_price_enabled,omitempty"`
+	// min_gas_price_floor is the lowest value the dynamic min gas price can
+	// reach.
+	MinGasPriceFloor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_gas_price_floor,json=minGasPriceFloor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_floor"`
+	// min_gas_price_ceiling is the highest value the dynamic min gas price can
+	// reach.
+	MinGasPriceCeiling cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_gas_price_ceiling,json=minGasPriceCeiling,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_ceiling"`
+	// max_change_rate is the largest fraction by which the dynamic min gas price
+	// can change from one block to the next. It is reached when the square is
+	// either full or empty.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// target_square_utilization is the fraction of the max square that the
+	// dynamic min gas price aims for. The price rises when a square is fuller
+	// than the target and falls when it is emptier.
+	TargetSquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=target_square_utilization,json=targetSquareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_square_utilization"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,30 +83,125 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicMinGasPriceEnabled() bool {
+	if m != nil {
+		return m.DynamicMinGasPriceEnabled
+	}
+	return false
+}
+
+// ParamsChange records a change of the minfee parameters.
+type ParamsChange struct {
+	// height is the block height at which the parameters were changed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// old_params are the parameters before the change.
+	OldParams Params `protobuf:"bytes,2,opt,name=old_params,json=oldParams,proto3" json:"old_params"`
+	// new_params are the parameters after the change.
+	NewParams Params `protobuf:"bytes,3,opt,name=new_params,json=newParams,proto3" json:"new_params"`
+	// authority is the address that changed the parameters.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *ParamsChange) Reset()         { *m = ParamsChange{} }
+func (m *ParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ParamsChange) ProtoMessage()    {}
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_821eedeb4e2f93bf, []int{1}
+}
+func (m *ParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsChange.Merge(m, src)
+}
+func (m *ParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsChange proto.InternalMessageInfo
+
+func (m *ParamsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamsChange) GetOldParams() Params {
+	if m != nil {
+		return m.OldParams
+	}
+	return Params{}
+}
+
+func (m *ParamsChange) GetNewParams() Params {
+	if m != nil {
+		return m.NewParams
+	}
+	return Params{}
+}
+
+func (m *ParamsChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
+	proto.RegisterType((*ParamsChange)(nil), "celestia.minfee.v1.ParamsChange")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
 
 var fileDescriptor_821eedeb4e2f93bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0x94, 0xc7, 0xc5, 0x16, 0x00, 0x36, 0x54, 0x28, 0x85, 0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c,
-	0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11, 0xa4, 0x3e, 0x33, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x21,
-	0xda, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c, 0x52, 0xd3,
-	0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x9a, 0xee, 0x92, 0x9a, 0x1c,
-	0x24, 0x04, 0x35, 0xcf, 0x37, 0x33, 0xcf, 0x3d, 0xb1, 0x38, 0x00, 0x64, 0x98, 0x93, 0xcf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0x96, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14,
-	0xe8, 0x57, 0xc0, 0x02, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x21, 0xaa, 0xfb, 0x2c, 0x01, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x5a, 0x2a, 0x6a, 0x40, 0x20, 0xf3, 0x2f, 0x2d, 0x90, 0x4e, 0x3b, 0xed, 0xb2,
+	0x44, 0x1d, 0x1f, 0x60, 0xa8, 0x1b, 0x70, 0x19, 0xd2, 0x14, 0xc4, 0x01, 0x2e, 0xe1, 0xad, 0xf3,
+	0xce, 0xb1, 0x16, 0xdb, 0x21, 0x71, 0xd7, 0x96, 0x4f, 0xc1, 0x87, 0xe1, 0x13, 0x70, 0x40, 0x3b,
+	0x4e, 0x9c, 0x10, 0x87, 0x09, 0xb5, 0x5f, 0x04, 0x25, 0x4e, 0xe9, 0x2a, 0x2e, 0x53, 0x6f, 0xb6,
+	0xdf, 0xe7, 0xfd, 0x3d, 0xaf, 0xec, 0xc7, 0xa4, 0xcf, 0x30, 0xc5, 0xc2, 0x08, 0x08, 0xa4, 0x50,
+	0x27, 0x88, 0xc1, 0xd9, 0x20, 0xc8, 0x20, 0x07, 0x59, 0xf8, 0x59, 0xae, 0x8d, 0xa6, 0x74, 0x29,
+	0xf0, 0xad, 0xc0, 0x3f, 0x1b, 0xf4, 0x1e, 0x72, 0xcd, 0x75, 0x55, 0x0e, 0xca, 0x95, 0x55, 0xf6,
+	0xba, 0x4c, 0x17, 0x52, 0x17, 0x91, 0x2d, 0xd8, 0x8d, 0x2d, 0x6d, 0x7f, 0x6f, 0x91, 0xf6, 0x71,
+	0x45, 0xa5, 0x31, 0x79, 0xa4, 0xd0, 0x4c, 0x74, 0x7e, 0x1a, 0x49, 0xa1, 0x22, 0x0e, 0x65, 0x83,
+	0x60, 0xe8, 0x3a, 0x5b, 0xce, 0x4e, 0x67, 0x38, 0x38, 0xbf, 0xec, 0x37, 0x7e, 0x5f, 0xf6, 0x9f,
+	0xda, 0xfe, 0x22, 0x3e, 0xf5, 0x85, 0x0e, 0x24, 0x98, 0xc4, 0x3f, 0x42, 0x0e, 0x6c, 0x76, 0x88,
+	0xec, 0xe7, 0xb7, 0x5d, 0x52, 0xe3, 0x0f, 0x91, 0x85, 0xb4, 0xe6, 0xbd, 0x15, 0xea, 0x0d, 0x14,
+	0xc7, 0x25, 0x8c, 0xbe, 0x24, 0xcf, 0xe3, 0x99, 0x02, 0x29, 0xd8, 0xba, 0x4b, 0x84, 0x0a, 0x46,
+	0x29, 0xc6, 0xee, 0x8d, 0x2d, 0x67, 0xe7, 0x56, 0xd8, 0xad, 0x45, 0x57, 0x5a, 0x5f, 0x59, 0x01,
+	0xfd, 0x44, 0x1e, 0xac, 0x77, 0x9e, 0xa4, 0x5a, 0xe7, 0x6e, 0x73, 0xd3, 0x29, 0xef, 0xcb, 0x95,
+	0xc7, 0xeb, 0x12, 0x55, 0xde, 0xc4, 0xba, 0x03, 0x43, 0x91, 0x0a, 0xc5, 0xdd, 0xd6, 0xc6, 0x37,
+	0x71, 0xc5, 0xe3, 0xc0, 0xc2, 0xe8, 0x07, 0x72, 0x4f, 0xc2, 0x34, 0x62, 0x09, 0x28, 0x8e, 0x51,
+	0x0e, 0x06, 0xdd, 0x9b, 0x9b, 0xf2, 0xef, 0x4a, 0x98, 0x1e, 0x54, 0xa0, 0x10, 0x0c, 0x52, 0x49,
+	0xba, 0x06, 0x72, 0x8e, 0x26, 0x2a, 0x3e, 0x8f, 0x21, 0xc7, 0x68, 0x6c, 0x44, 0x2a, 0xbe, 0x80,
+	0x11, 0x5a, 0xb9, 0xed, 0x4d, 0x4d, 0x9e, 0x58, 0xe6, 0xbb, 0x0a, 0xf9, 0x7e, 0x45, 0xdc, 0xfe,
+	0xe1, 0x90, 0x3b, 0x36, 0x44, 0x76, 0x06, 0xfa, 0x98, 0xb4, 0x13, 0x14, 0x3c, 0x31, 0x55, 0x76,
+	0x9a, 0x61, 0xbd, 0xa3, 0xfb, 0x84, 0xe8, 0x34, 0x8e, 0x6c, 0x8c, 0xab, 0x97, 0xbe, 0xbd, 0xd7,
+	0xf3, 0xff, 0xcf, 0xb1, 0x6f, 0x69, 0xc3, 0x56, 0x39, 0x64, 0xd8, 0xd1, 0x69, 0x5c, 0x67, 0x74,
+	0x9f, 0x10, 0x85, 0x93, 0x25, 0xa0, 0x79, 0x5d, 0x80, 0xc2, 0x49, 0x0d, 0x78, 0x46, 0x3a, 0x30,
+	0x36, 0x89, 0xce, 0x85, 0x99, 0xd9, 0xe7, 0x0c, 0x57, 0x07, 0xc3, 0xa3, 0xf3, 0xb9, 0xe7, 0x5c,
+	0xcc, 0x3d, 0xe7, 0xcf, 0xdc, 0x73, 0xbe, 0x2e, 0xbc, 0xc6, 0xc5, 0xc2, 0x6b, 0xfc, 0x5a, 0x78,
+	0x8d, 0x8f, 0x7b, 0x5c, 0x98, 0x64, 0x3c, 0xf2, 0x99, 0x96, 0xc1, 0xd2, 0x4e, 0xe7, 0xfc, 0xdf,
+	0x7a, 0x17, 0xb2, 0x2c, 0x98, 0x2e, 0xbf, 0xaa, 0x99, 0x65, 0x58, 0x8c, 0xda, 0xd5, 0x17, 0x7b,
+	0xf1, 0x37, 0x00, 0x00, 0xff, 0xff, 0x71, 0x53, 0xfe, 0xf7, 0xca, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +224,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TargetSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinGasPriceCeiling.Size()
+		i -= size
+		if _, err := m.MinGasPriceCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinGasPriceFloor.Size()
+		i -= size
+		if _, err := m.MinGasPriceFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicMinGasPriceEnabled {
+		i--
+		if m.DynamicMinGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -122,6 +287,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -141,6 +361,37 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicMinGasPriceEnabled {
+		n += 2
+	}
+	l = m.MinGasPriceFloor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasPriceCeiling.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetSquareUtilization.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	l = m.OldParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.NewParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -213,6 +464,329 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicMinGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicMinGasPriceEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
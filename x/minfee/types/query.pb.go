@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -190,44 +191,154 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryParamsHistoryRequest is the request type for the Query/ParamsHistory
+// RPC method.
+type QueryParamsHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamsHistoryRequest) Reset()         { *m = QueryParamsHistoryRequest{} }
+func (m *QueryParamsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsHistoryRequest) ProtoMessage()    {}
+func (*QueryParamsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryParamsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsHistoryRequest.Merge(m, src)
+}
+func (m *QueryParamsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryParamsHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsHistoryResponse is the response type for the Query/ParamsHistory
+// RPC method.
+type QueryParamsHistoryResponse struct {
+	Changes    []ParamsChange      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamsHistoryResponse) Reset()         { *m = QueryParamsHistoryResponse{} }
+func (m *QueryParamsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsHistoryResponse) ProtoMessage()    {}
+func (*QueryParamsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryParamsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsHistoryResponse.Merge(m, src)
+}
+func (m *QueryParamsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryParamsHistoryResponse) GetChanges() []ParamsChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryParamsHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.minfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.minfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsHistoryRequest)(nil), "celestia.minfee.v1.QueryParamsHistoryRequest")
+	proto.RegisterType((*QueryParamsHistoryResponse)(nil), "celestia.minfee.v1.QueryParamsHistoryResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x93, 0x15, 0x54, 0xc2, 0x9c, 0xf0, 0x2e, 0x82, 0x06, 0x94, 0x40, 0x90, 0xf8, 0x23,
-	0xb4, 0xb6, 0xda, 0xbd, 0x70, 0xae, 0x56, 0xe2, 0xb2, 0xc0, 0x92, 0x23, 0x97, 0xca, 0xeb, 0x1d,
-	0xbc, 0x56, 0x1b, 0x3b, 0x8d, 0xdd, 0x42, 0xaf, 0x5c, 0xb8, 0x22, 0xf1, 0x04, 0x7d, 0x07, 0x1e,
-	0xa2, 0xc7, 0x0a, 0x2e, 0x88, 0x43, 0x85, 0x5a, 0x1e, 0x04, 0x25, 0x4e, 0x2a, 0x55, 0x69, 0x85,
-	0xb8, 0x4d, 0xf2, 0xcd, 0x37, 0xf3, 0xf3, 0xa7, 0x41, 0x21, 0x87, 0x21, 0x18, 0x2b, 0x19, 0x4d,
-	0xa5, 0x7a, 0x0f, 0x40, 0x27, 0x1d, 0x3a, 0x1a, 0x43, 0x3e, 0x25, 0x59, 0xae, 0xad, 0xc6, 0xb8,
-	0xd6, 0x89, 0xd3, 0xc9, 0xa4, 0x13, 0x44, 0x3b, 0x3c, 0x19, 0xcb, 0x59, 0x6a, 0x9c, 0x29, 0x38,
-	0x12, 0x5a, 0xe8, 0xb2, 0xa4, 0x45, 0x55, 0xfd, 0xbd, 0x2f, 0xb4, 0x16, 0x43, 0xa0, 0x2c, 0x93,
-	0x94, 0x29, 0xa5, 0x2d, 0xb3, 0x52, 0xab, 0xda, 0xd3, 0xe6, 0xda, 0xa4, 0xda, 0xf4, 0x9d, 0xcd,
-	0x7d, 0x38, 0x29, 0x6e, 0xa3, 0x3b, 0x6f, 0x0b, 0xa4, 0xd7, 0x60, 0x3f, 0xe8, 0x7c, 0xf0, 0x4a,
-	0xaa, 0x97, 0xcc, 0x9c, 0xe7, 0x92, 0x43, 0xfc, 0xd9, 0x47, 0xd1, 0x1e, 0x2d, 0x01, 0x93, 0x69,
-	0x65, 0x00, 0x5f, 0xa2, 0xdb, 0xca, 0xa9, 0xfd, 0x54, 0xaa, 0xbe, 0x60, 0xc5, 0x12, 0xc9, 0xe1,
-	0xae, 0xff, 0xc0, 0x7f, 0x7a, 0xa3, 0xd7, 0x99, 0x2f, 0x23, 0xef, 0xd7, 0x32, 0xba, 0xe7, 0x76,
-	0x9a, 0xcb, 0x01, 0x91, 0x9a, 0xa6, 0xcc, 0x5e, 0x91, 0x33, 0x10, 0x8c, 0x4f, 0x4f, 0x81, 0x7f,
-	0xff, 0x76, 0x8c, 0x2a, 0xa4, 0x53, 0xe0, 0x09, 0x56, 0x4d, 0x92, 0x23, 0x84, 0x4b, 0x90, 0xf3,
-	0x32, 0x88, 0x04, 0x46, 0x63, 0x30, 0x36, 0x7e, 0x83, 0x0e, 0xb7, 0xfe, 0x56, 0x48, 0x2f, 0x50,
-	0xcb, 0x05, 0x56, 0x32, 0xdc, 0xec, 0x06, 0xa4, 0x19, 0x33, 0x71, 0x9e, 0xde, 0xb5, 0x82, 0x2f,
-	0xa9, 0xfa, 0xbb, 0xb3, 0x03, 0x74, 0xbd, 0x9c, 0x88, 0x67, 0x3e, 0xc2, 0xcd, 0x57, 0xe3, 0xe7,
-	0xbb, 0x46, 0xed, 0x89, 0x28, 0x38, 0xf9, 0x8f, 0xe6, 0x1a, 0x3e, 0x7e, 0xf6, 0xe9, 0xc7, 0x9f,
-	0xaf, 0x07, 0x8f, 0xf0, 0x43, 0xba, 0xe3, 0x0e, 0xb6, 0x12, 0xc6, 0x16, 0xb5, 0xdc, 0x2b, 0xf0,
-	0xe3, 0xbd, 0x9b, 0xb6, 0x02, 0x0b, 0x9e, 0xfc, 0xb3, 0xaf, 0xa2, 0x68, 0x97, 0x14, 0x87, 0xf8,
-	0x56, 0xe3, 0x08, 0x7b, 0x67, 0xf3, 0x55, 0xe8, 0x2f, 0x56, 0xa1, 0xff, 0x7b, 0x15, 0xfa, 0x5f,
-	0xd6, 0xa1, 0xb7, 0x58, 0x87, 0xde, 0xcf, 0x75, 0xe8, 0xbd, 0xeb, 0x0a, 0x69, 0xaf, 0xc6, 0x17,
-	0x84, 0xeb, 0x74, 0x03, 0xaf, 0x73, 0xb1, 0xa9, 0x8f, 0x59, 0x96, 0xd1, 0x8f, 0xf5, 0x44, 0x3b,
-	0xcd, 0xc0, 0x5c, 0xb4, 0xca, 0x23, 0x3c, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x37, 0xfc,
-	0x9c, 0x2a, 0x03, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0x73, 0x2d, 0x04, 0xe1, 0x8a, 0x01, 0xb7, 0x08, 0x72, 0x45, 0x97, 0xf6, 0x90, 0xda,
+	0x02, 0x8a, 0xad, 0xa4, 0x0b, 0x23, 0x0a, 0x15, 0x65, 0x28, 0x10, 0x32, 0xb2, 0x44, 0xce, 0xd5,
+	0x38, 0x56, 0x7b, 0xf6, 0xf5, 0xec, 0x04, 0xb2, 0xb2, 0xb0, 0x82, 0xf8, 0x04, 0x4c, 0x7c, 0x01,
+	0xf8, 0x0e, 0x1d, 0x2b, 0x58, 0x10, 0x43, 0x85, 0x12, 0x3e, 0x08, 0x3a, 0xdb, 0x29, 0x39, 0x5d,
+	0xa2, 0xc0, 0x66, 0xfb, 0xfd, 0xff, 0xef, 0xfd, 0xee, 0xf9, 0xf9, 0x40, 0x10, 0xd1, 0x63, 0xaa,
+	0x34, 0x27, 0x38, 0xe6, 0xe2, 0x15, 0xa5, 0x78, 0x50, 0xc7, 0x27, 0x7d, 0x9a, 0x0e, 0x51, 0x92,
+	0x4a, 0x2d, 0x21, 0x9c, 0xc4, 0x91, 0x8d, 0xa3, 0x41, 0xdd, 0xaf, 0xce, 0xf0, 0x24, 0x24, 0x25,
+	0xb1, 0xb2, 0x26, 0x7f, 0x8d, 0x49, 0x26, 0xcd, 0x12, 0x67, 0x2b, 0x77, 0x7a, 0x9b, 0x49, 0xc9,
+	0x8e, 0x29, 0x26, 0x09, 0xc7, 0x44, 0x08, 0xa9, 0x89, 0xe6, 0x52, 0x4c, 0x3c, 0x95, 0x48, 0xaa,
+	0x58, 0xaa, 0x8e, 0xb5, 0xd9, 0x8d, 0x0b, 0xdd, 0xb3, 0x3b, 0xdc, 0x25, 0x8a, 0x5a, 0x38, 0x3c,
+	0xa8, 0x77, 0xa9, 0x26, 0x59, 0x59, 0xc6, 0x85, 0xc9, 0x63, 0xb5, 0x61, 0x05, 0xdc, 0x7c, 0x91,
+	0x29, 0x9e, 0x51, 0xfd, 0x5a, 0xa6, 0x47, 0x4f, 0xb9, 0xd8, 0x27, 0xaa, 0x95, 0xf2, 0x88, 0x86,
+	0xef, 0x3c, 0x50, 0x9d, 0x13, 0x6b, 0x53, 0x95, 0x48, 0xa1, 0x28, 0x3c, 0x04, 0x37, 0x84, 0x8d,
+	0x76, 0x62, 0x2e, 0x3a, 0x8c, 0x64, 0x40, 0x3c, 0xa2, 0xb7, 0xbc, 0x0d, 0x6f, 0xe7, 0x6a, 0xb3,
+	0x7e, 0x7a, 0x5e, 0x2d, 0xfd, 0x3c, 0xaf, 0xae, 0x5b, 0x22, 0x75, 0x78, 0x84, 0xb8, 0xc4, 0x31,
+	0xd1, 0x3d, 0x74, 0x40, 0x19, 0x89, 0x86, 0x7b, 0x34, 0xfa, 0xf6, 0xa5, 0x06, 0x1c, 0xfe, 0x1e,
+	0x8d, 0xda, 0x50, 0x14, 0x49, 0xd6, 0x00, 0x34, 0x20, 0x2d, 0xd3, 0xb4, 0x36, 0x3d, 0xe9, 0x53,
+	0xa5, 0xc3, 0xe7, 0x60, 0x35, 0x77, 0xea, 0x90, 0x1e, 0x80, 0xb2, 0x6d, 0xae, 0x61, 0x58, 0x69,
+	0xf8, 0xa8, 0x78, 0x25, 0xc8, 0x7a, 0x9a, 0x97, 0x32, 0xbe, 0xb6, 0xd3, 0x87, 0x11, 0xa8, 0x4c,
+	0x25, 0x7c, 0xc2, 0x95, 0x96, 0xe9, 0xd0, 0x55, 0x83, 0x8f, 0x01, 0xf8, 0xdb, 0x3c, 0x97, 0x7a,
+	0x0b, 0x39, 0xf0, 0xac, 0xd3, 0xc8, 0x8e, 0x81, 0xeb, 0x34, 0x6a, 0x11, 0x46, 0x9d, 0xb7, 0x3d,
+	0xe5, 0x0c, 0x3f, 0x7b, 0xc0, 0x9f, 0x55, 0xc5, 0xd1, 0x3f, 0x04, 0x57, 0xa2, 0x1e, 0x11, 0x8c,
+	0x66, 0xf8, 0xcb, 0x3b, 0x2b, 0x8d, 0x8d, 0xf9, 0xf8, 0x8f, 0x8c, 0xd0, 0x7d, 0xc4, 0xc4, 0x06,
+	0xf7, 0x73, 0xa0, 0x4b, 0x06, 0x74, 0x7b, 0x21, 0xa8, 0x2d, 0x3f, 0x4d, 0xda, 0xf8, 0xba, 0x0c,
+	0x2e, 0x1b, 0x52, 0xf8, 0xc9, 0x03, 0xb0, 0x38, 0x04, 0xf0, 0xfe, 0x2c, 0xb4, 0x39, 0x13, 0xe3,
+	0xef, 0xfe, 0x87, 0x78, 0x82, 0x13, 0xde, 0x7d, 0xfb, 0xfd, 0xf7, 0xc7, 0xa5, 0x3b, 0x70, 0x13,
+	0xcf, 0x78, 0x42, 0xb9, 0x81, 0x83, 0x1a, 0x94, 0x6d, 0x57, 0xe0, 0xd6, 0xdc, 0x4a, 0xb9, 0xf9,
+	0xf1, 0xb7, 0x17, 0xea, 0x1c, 0x45, 0xc5, 0x50, 0xac, 0xc2, 0xeb, 0x85, 0xf7, 0x0b, 0x3f, 0x78,
+	0xe0, 0x5a, 0xee, 0x22, 0x61, 0x6d, 0x41, 0xd6, 0xfc, 0x58, 0xf9, 0xe8, 0x5f, 0xe5, 0x8e, 0x65,
+	0xd3, 0xb0, 0xac, 0xc3, 0x4a, 0x81, 0xa5, 0xd3, 0xb3, 0xd2, 0xe6, 0xc1, 0xe9, 0x28, 0xf0, 0xce,
+	0x46, 0x81, 0xf7, 0x6b, 0x14, 0x78, 0xef, 0xc7, 0x41, 0xe9, 0x6c, 0x1c, 0x94, 0x7e, 0x8c, 0x83,
+	0xd2, 0xcb, 0x06, 0xe3, 0xba, 0xd7, 0xef, 0xa2, 0x48, 0xc6, 0x17, 0x0d, 0x95, 0x29, 0xbb, 0x58,
+	0xd7, 0x48, 0x92, 0xe0, 0x37, 0x93, 0xcc, 0x7a, 0x98, 0x50, 0xd5, 0x2d, 0x9b, 0xff, 0xc4, 0xee,
+	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xed, 0x92, 0xe2, 0xf9, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -246,6 +357,9 @@ type QueryClient interface {
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsHistory queries the recorded changes of the parameters of the
+	// module, oldest first.
+	ParamsHistory(ctx context.Context, in *QueryParamsHistoryRequest, opts ...grpc.CallOption) (*QueryParamsHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -274,12 +388,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ParamsHistory(ctx context.Context, in *QueryParamsHistoryRequest, opts ...grpc.CallOption) (*QueryParamsHistoryResponse, error) {
+	out := new(QueryParamsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/ParamsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsHistory queries the recorded changes of the parameters of the
+	// module, oldest first.
+	ParamsHistory(context.Context, *QueryParamsHistoryRequest) (*QueryParamsHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -292,6 +418,9 @@ func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ParamsHistory(ctx context.Context, req *QueryParamsHistoryRequest) (*QueryParamsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -333,6 +462,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/ParamsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsHistory(ctx, req.(*QueryParamsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.minfee.v1.Query",
@@ -346,6 +493,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ParamsHistory",
+			Handler:    _Query_ParamsHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/minfee/v1/query.proto",
@@ -463,6 +614,90 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -514,6 +749,38 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -787,6 +1054,212 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamsChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes the registrations that have expired and refunds their
+// deposits.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.ExpireNamespaces(sdk.UnwrapSDKContext(ctx))
+}
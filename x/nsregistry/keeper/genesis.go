@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the nsregistry module's state from a provided
+// genesis state. The deposits of the records are expected to be held by the
+// module account, which is populated by the bank genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) error {
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid nsregistry genesis state: %w", err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	for _, record := range genState.Records {
+		k.setNamespaceRecord(ctx, record)
+	}
+	return nil
+}
+
+// ExportGenesis returns the nsregistry module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	k.IterateNamespaceRecords(ctx, func(record types.NamespaceRecord) bool {
+		genesis.Records = append(genesis.Records, record)
+		return false
+	})
+	return genesis
+}
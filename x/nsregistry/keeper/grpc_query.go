@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Params returns the parameters of the module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Namespace returns the registration of a namespace.
+func (k Keeper) Namespace(c context.Context, req *types.QueryNamespaceRequest) (*types.QueryNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetNamespaceRecord(ctx, req.Namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.Namespace)
+	}
+	return &types.QueryNamespaceResponse{Record: record}, nil
+}
+
+// Namespaces returns all registered namespaces.
+func (k Keeper) Namespaces(c context.Context, req *types.QueryNamespacesRequest) (*types.QueryNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.NamespaceRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NamespaceKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.NamespaceRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespacesResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// NamespacesByOwner returns the namespaces registered to an owner.
+func (k Keeper) NamespacesByOwner(c context.Context, req *types.QueryNamespacesByOwnerRequest) (*types.QueryNamespacesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.NamespaceRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerPrefix(owner))
+	pageRes, err := query.Paginate(store, req.Pagination, func(namespace, _ []byte) error {
+		record, found := k.GetNamespaceRecord(ctx, namespace)
+		if !found {
+			return status.Errorf(codes.Internal, "owner index references unknown namespace %X", namespace)
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNamespacesByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/keeper"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	owner    = newAddress(1)
+	other    = newAddress(2)
+	deposit  = sdk.NewCoin(appconsts.BondDenom, math.NewInt(100))
+	period   = time.Hour
+	genesis  = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ns1      = share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2      = share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	reserved = share.TxNamespace
+)
+
+func TestRegisterNamespace(t *testing.T) {
+	k, ctx, bank := setup(t)
+
+	res, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns1, true, []string{other}))
+	require.NoError(t, err)
+	assert.Equal(t, genesis.Add(period), res.Expiration)
+	assert.Equal(t, deposit.Amount, bank.escrowed)
+
+	record, found := k.GetNamespaceRecord(ctx, ns1.Bytes())
+	require.True(t, found)
+	assert.Equal(t, owner, record.Owner)
+	assert.Equal(t, []string{other}, record.AllowedSigners)
+	assert.Equal(t, deposit, record.Deposit)
+	assert.True(t, record.CanSubmit(owner))
+	assert.True(t, record.CanSubmit(other))
+	assert.False(t, record.CanSubmit(newAddress(3)))
+
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, ns1, false, nil))
+	require.ErrorIs(t, err, types.ErrNamespaceRegistered)
+
+	// a lapsed registration that has not been pruned can be taken over
+	ctx = ctx.WithBlockTime(genesis.Add(period))
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, ns1, false, nil))
+	require.NoError(t, err)
+	record, _ = k.GetNamespaceRecord(ctx, ns1.Bytes())
+	assert.Equal(t, other, record.Owner)
+	assert.Equal(t, deposit.Amount, bank.refunded[owner])
+}
+
+func TestTransferNamespace(t *testing.T) {
+	k, ctx, _ := setup(t)
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns1, true, []string{other}))
+	require.NoError(t, err)
+
+	newOwner := newAddress(3)
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(other, ns1, newOwner))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, ns2, newOwner))
+	require.ErrorIs(t, err, types.ErrNamespaceNotFound)
+
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, ns1, newOwner))
+	require.NoError(t, err)
+
+	record, _ := k.GetNamespaceRecord(ctx, ns1.Bytes())
+	assert.Equal(t, newOwner, record.Owner)
+	assert.Empty(t, record.AllowedSigners)
+	assert.False(t, record.CanSubmit(other))
+
+	res, err := k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	assert.Empty(t, res.Records)
+	res, err = k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: newOwner})
+	require.NoError(t, err)
+	assert.Len(t, res.Records, 1)
+}
+
+func TestRenewNamespace(t *testing.T) {
+	k, ctx, bank := setup(t)
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns1, false, nil))
+	require.NoError(t, err)
+
+	_, err = k.RenewNamespace(ctx, types.NewMsgRenewNamespace(other, ns1))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+
+	res, err := k.RenewNamespace(ctx, types.NewMsgRenewNamespace(owner, ns1))
+	require.NoError(t, err)
+	assert.Equal(t, genesis.Add(2*period), res.Expiration)
+
+	// the registration outlives its original expiration
+	ctx = ctx.WithBlockTime(genesis.Add(period))
+	require.NoError(t, k.EndBlocker(ctx))
+	_, found := k.GetNamespaceRecord(ctx, ns1.Bytes())
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(genesis.Add(2 * period))
+	require.NoError(t, k.EndBlocker(ctx))
+	_, found = k.GetNamespaceRecord(ctx, ns1.Bytes())
+	require.False(t, found)
+	assert.Equal(t, deposit.Amount, bank.refunded[owner])
+
+	_, err = k.RenewNamespace(ctx, types.NewMsgRenewNamespace(owner, ns1))
+	require.ErrorIs(t, err, types.ErrNamespaceNotFound)
+}
+
+func TestUpdateNamespaceSigners(t *testing.T) {
+	k, ctx, _ := setup(t)
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns1, false, nil))
+	require.NoError(t, err)
+
+	_, err = k.UpdateNamespaceSigners(ctx, types.NewMsgUpdateNamespaceSigners(other, ns1, true, nil))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+
+	_, err = k.UpdateNamespaceSigners(ctx, types.NewMsgUpdateNamespaceSigners(owner, ns1, true, []string{other}))
+	require.NoError(t, err)
+
+	record, _ := k.GetNamespaceRecord(ctx, ns1.Bytes())
+	assert.True(t, record.Restricted)
+	assert.Equal(t, []string{other}, record.AllowedSigners)
+}
+
+func TestUpdateNsregistryParams(t *testing.T) {
+	k, ctx, _ := setup(t)
+	params := types.NewParams(sdk.NewCoin(appconsts.BondDenom, math.NewInt(5)), 2*period)
+
+	_, err := k.UpdateNsregistryParams(ctx, types.NewMsgUpdateNsregistryParams(owner, params))
+	require.Error(t, err)
+
+	_, err = k.UpdateNsregistryParams(ctx, types.NewMsgUpdateNsregistryParams(k.GetAuthority(), types.NewParams(deposit, 0)))
+	require.Error(t, err)
+
+	_, err = k.UpdateNsregistryParams(ctx, types.NewMsgUpdateNsregistryParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	assert.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestQueryNamespaces(t *testing.T) {
+	k, ctx, _ := setup(t)
+	for _, ns := range []share.Namespace{ns1, ns2} {
+		_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns, false, nil))
+		require.NoError(t, err)
+	}
+
+	res, err := k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: ns2.Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, ns2.Bytes(), res.Record.Namespace)
+	_, err = k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: reserved.Bytes()})
+	require.Error(t, err)
+
+	page, err := k.Namespaces(ctx, &types.QueryNamespacesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	assert.Equal(t, ns1.Bytes(), page.Records[0].Namespace)
+	assert.EqualValues(t, 2, page.Pagination.Total)
+
+	page, err = k.Namespaces(ctx, &types.QueryNamespacesRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	assert.Equal(t, ns2.Bytes(), page.Records[0].Namespace)
+
+	_, err = k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: "invalid"})
+	require.Error(t, err)
+}
+
+func TestGenesis(t *testing.T) {
+	k, ctx, _ := setup(t)
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns1, true, []string{other}))
+	require.NoError(t, err)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, ns2, false, nil))
+	require.NoError(t, err)
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Records, 2)
+
+	imported, importedCtx, _ := setup(t)
+	require.NoError(t, imported.InitGenesis(importedCtx, *exported))
+	assert.Equal(t, exported, imported.ExportGenesis(importedCtx))
+
+	// the indexes are rebuilt from the genesis records
+	res, err := imported.NamespacesByOwner(importedCtx, &types.QueryNamespacesByOwnerRequest{Owner: other})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	assert.Equal(t, ns2.Bytes(), res.Records[0].Namespace)
+
+	importedCtx = importedCtx.WithBlockTime(genesis.Add(period))
+	require.NoError(t, imported.EndBlocker(importedCtx))
+	assert.Empty(t, imported.ExportGenesis(importedCtx).Records)
+
+	invalid := *exported
+	invalid.Records = append(invalid.Records, exported.Records[0])
+	require.Error(t, imported.InitGenesis(importedCtx, invalid))
+}
+
+func setup(t *testing.T) (*keeper.Keeper, sdk.Context, *mockBankKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NoOpMetrics{})
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Time: genesis}, false, log.NewNopLogger())
+
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	bank := &mockBankKeeper{escrowed: math.ZeroInt(), refunded: map[string]math.Int{}}
+	k := keeper.NewKeeper(config.Codec, storeKey, mockAccountKeeper{}, bank, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	k.SetParams(ctx, types.NewParams(deposit, period))
+	return k, ctx, bank
+}
+
+func newAddress(b byte) string {
+	return sdk.AccAddress(bytes.Repeat([]byte{b}, 20)).String()
+}
+
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+type mockBankKeeper struct {
+	escrowed math.Int
+	refunded map[string]math.Int
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	m.escrowed = m.escrowed.Add(amt.AmountOf(appconsts.BondDenom))
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	amount := amt.AmountOf(appconsts.BondDenom)
+	m.escrowed = m.escrowed.Sub(amount)
+	if refunded, ok := m.refunded[recipientAddr.String()]; ok {
+		amount = amount.Add(refunded)
+	}
+	m.refunded[recipientAddr.String()] = amount
+	return nil
+}
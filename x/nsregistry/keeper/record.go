@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNamespaceRecord returns the record of a namespace and whether it exists.
+// The record may have expired if it has not yet been pruned by the
+// EndBlocker.
+func (k Keeper) GetNamespaceRecord(ctx sdk.Context, namespace []byte) (types.NamespaceRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NamespaceKey(namespace))
+	if bz == nil {
+		return types.NamespaceRecord{}, false
+	}
+
+	var record types.NamespaceRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// getActiveRecord returns the record of a namespace if it is registered to
+// owner and has not expired.
+func (k Keeper) getActiveRecord(ctx sdk.Context, namespace []byte, owner string) (types.NamespaceRecord, error) {
+	record, found := k.GetNamespaceRecord(ctx, namespace)
+	if !found || record.IsExpired(ctx.BlockTime()) {
+		return types.NamespaceRecord{}, errors.Wrapf(types.ErrNamespaceNotFound, "namespace %X", namespace)
+	}
+	if record.Owner != owner {
+		return types.NamespaceRecord{}, errors.Wrapf(types.ErrNotNamespaceOwner, "namespace %X is owned by %s", namespace, record.Owner)
+	}
+	return record, nil
+}
+
+// setNamespaceRecord stores the record of a namespace along with its entries
+// in the expiration queue and the owner index. Any previous record of the
+// namespace must have been removed with deleteNamespaceRecord.
+func (k Keeper) setNamespaceRecord(ctx sdk.Context, record types.NamespaceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NamespaceKey(record.Namespace), k.cdc.MustMarshal(&record))
+	store.Set(types.ExpirationKey(record.Expiration, record.Namespace), []byte{})
+	store.Set(types.OwnerKey(sdk.MustAccAddressFromBech32(record.Owner), record.Namespace), []byte{})
+}
+
+// deleteNamespaceRecord removes the record of a namespace along with its
+// entries in the expiration queue and the owner index.
+func (k Keeper) deleteNamespaceRecord(ctx sdk.Context, record types.NamespaceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.NamespaceKey(record.Namespace))
+	store.Delete(types.ExpirationKey(record.Expiration, record.Namespace))
+	store.Delete(types.OwnerKey(sdk.MustAccAddressFromBech32(record.Owner), record.Namespace))
+}
+
+// IterateNamespaceRecords iterates over all namespace records in namespace
+// order until cb returns true.
+func (k Keeper) IterateNamespaceRecords(ctx sdk.Context, cb func(record types.NamespaceRecord) (stop bool)) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NamespaceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.NamespaceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// expireNamespace removes the record of a namespace and refunds its deposit to
+// the owner.
+func (k Keeper) expireNamespace(ctx sdk.Context, record types.NamespaceRecord) error {
+	k.deleteNamespaceRecord(ctx, record)
+	if !record.Deposit.IsZero() {
+		owner := sdk.MustAccAddressFromBech32(record.Owner)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(record.Deposit)); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewExpireNamespaceEvent(record))
+}
+
+// ExpireNamespaces removes all registrations that have lapsed by the current
+// block time and refunds their deposits.
+func (k Keeper) ExpireNamespaces(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(types.ExpirationPrefix(ctx.BlockTime()))
+	iterator := store.Iterator(types.ExpirationKeyPrefix, end)
+
+	var expired []types.NamespaceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		namespace := types.NamespaceFromExpirationKey(iterator.Key())
+		if record, found := k.GetNamespaceRecord(ctx, namespace); found {
+			expired = append(expired, record)
+		}
+	}
+	// the records are removed after closing the iterator since the store must
+	// not be written to while it is being iterated over.
+	iterator.Close()
+
+	for _, record := range expired {
+		if err := k.expireNamespace(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// RegisterNamespace registers ownership of a namespace and escrows the
+// registration deposit from the owner.
+func (k Keeper) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if existing, found := k.GetNamespaceRecord(ctx, msg.Namespace); found {
+		if !existing.IsExpired(ctx.BlockTime()) {
+			return nil, errors.Wrapf(types.ErrNamespaceRegistered, "namespace %X is owned by %s", msg.Namespace, existing.Owner)
+		}
+		// the registration has lapsed but has not been pruned yet
+		if err := k.expireNamespace(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	params := k.GetParams(ctx)
+	if !params.RegistrationDeposit.IsZero() {
+		owner := sdk.MustAccAddressFromBech32(msg.Owner)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(params.RegistrationDeposit)); err != nil {
+			return nil, errors.Wrap(err, "failed to escrow registration deposit")
+		}
+	}
+
+	record := types.NamespaceRecord{
+		Namespace:      msg.Namespace,
+		Owner:          msg.Owner,
+		Restricted:     msg.Restricted,
+		AllowedSigners: msg.AllowedSigners,
+		Deposit:        params.RegistrationDeposit,
+		Expiration:     ctx.BlockTime().Add(params.RegistrationPeriod),
+	}
+	k.setNamespaceRecord(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewRegisterNamespaceEvent(record)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterNamespaceResponse{Expiration: record.Expiration}, nil
+}
+
+// TransferNamespace transfers ownership of a namespace along with its escrowed
+// deposit. The allowed signers are cleared so that the signers chosen by the
+// previous owner can not keep submitting blobs to a restricted namespace.
+func (k Keeper) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getActiveRecord(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteNamespaceRecord(ctx, record)
+	record.Owner = msg.NewOwner
+	record.AllowedSigners = nil
+	k.setNamespaceRecord(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewTransferNamespaceEvent(record.Namespace, msg.Owner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// RenewNamespace extends the registration of a namespace by one registration
+// period.
+func (k Keeper) RenewNamespace(goCtx context.Context, msg *types.MsgRenewNamespace) (*types.MsgRenewNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getActiveRecord(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteNamespaceRecord(ctx, record)
+	record.Expiration = record.Expiration.Add(k.GetParams(ctx).RegistrationPeriod)
+	k.setNamespaceRecord(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRenewNamespaceEvent(record.Namespace, record.Owner, record.Expiration),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewNamespaceResponse{Expiration: record.Expiration}, nil
+}
+
+// UpdateNamespaceSigners replaces the signers allowed to submit blobs to a
+// namespace.
+func (k Keeper) UpdateNamespaceSigners(goCtx context.Context, msg *types.MsgUpdateNamespaceSigners) (*types.MsgUpdateNamespaceSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getActiveRecord(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Restricted = msg.Restricted
+	record.AllowedSigners = msg.AllowedSigners
+	// the owner and expiration are unchanged so the indexes remain valid
+	k.setNamespaceRecord(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewUpdateNamespaceSignersEvent(record)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNamespaceSignersResponse{}, nil
+}
+
+// UpdateNsregistryParams updates nsregistry module parameters.
+func (k Keeper) UpdateNsregistryParams(goCtx context.Context, msg *types.MsgUpdateNsregistryParams) (*types.MsgUpdateNsregistryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to update the parameters.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateNsregistryParamsEvent(msg.Authority, msg.Params),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNsregistryParamsResponse{}, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidNamespace      = errors.Register(ModuleName, 2, "invalid namespace")
+	ErrNamespaceRegistered   = errors.Register(ModuleName, 3, "namespace is already registered")
+	ErrNamespaceNotFound     = errors.Register(ModuleName, 4, "namespace is not registered")
+	ErrNotNamespaceOwner     = errors.Register(ModuleName, 5, "signer is not the owner of the namespace")
+	ErrSignerNotAllowed      = errors.Register(ModuleName, 6, "signer is not allowed to submit blobs to the namespace")
+	ErrInvalidAllowedSigners = errors.Register(ModuleName, 7, "invalid allowed signers")
+)
@@ -0,0 +1,56 @@
+package types
+
+import "time"
+
+// NewRegisterNamespaceEvent returns a new EventRegisterNamespace.
+func NewRegisterNamespaceEvent(record NamespaceRecord) *EventRegisterNamespace {
+	return &EventRegisterNamespace{
+		Namespace:  record.Namespace,
+		Owner:      record.Owner,
+		Expiration: record.Expiration,
+	}
+}
+
+// NewTransferNamespaceEvent returns a new EventTransferNamespace.
+func NewTransferNamespaceEvent(namespace []byte, previousOwner, newOwner string) *EventTransferNamespace {
+	return &EventTransferNamespace{
+		Namespace:     namespace,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+	}
+}
+
+// NewRenewNamespaceEvent returns a new EventRenewNamespace.
+func NewRenewNamespaceEvent(namespace []byte, owner string, expiration time.Time) *EventRenewNamespace {
+	return &EventRenewNamespace{
+		Namespace:  namespace,
+		Owner:      owner,
+		Expiration: expiration,
+	}
+}
+
+// NewUpdateNamespaceSignersEvent returns a new EventUpdateNamespaceSigners.
+func NewUpdateNamespaceSignersEvent(record NamespaceRecord) *EventUpdateNamespaceSigners {
+	return &EventUpdateNamespaceSigners{
+		Namespace:      record.Namespace,
+		Owner:          record.Owner,
+		Restricted:     record.Restricted,
+		AllowedSigners: record.AllowedSigners,
+	}
+}
+
+// NewExpireNamespaceEvent returns a new EventExpireNamespace.
+func NewExpireNamespaceEvent(record NamespaceRecord) *EventExpireNamespace {
+	return &EventExpireNamespace{
+		Namespace: record.Namespace,
+		Owner:     record.Owner,
+	}
+}
+
+// NewUpdateNsregistryParamsEvent returns a new EventUpdateNsregistryParams.
+func NewUpdateNsregistryParamsEvent(authority string, params Params) *EventUpdateNsregistryParams {
+	return &EventUpdateNsregistryParams{
+		Signer: authority,
+		Params: params,
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Records))
+	for _, record := range gs.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[string(record.Namespace)]; ok {
+			return fmt.Errorf("duplicate record for namespace %X", record.Namespace)
+		}
+		seen[string(record.Namespace)] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nsregistry"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key used for storing module parameters.
+	ParamsKey = []byte{0x01}
+
+	// NamespaceKeyPrefix prefixes the namespace records, keyed by namespace.
+	NamespaceKeyPrefix = []byte{0x02}
+
+	// ExpirationKeyPrefix prefixes the expiration queue, keyed by expiration
+	// time and namespace.
+	ExpirationKeyPrefix = []byte{0x03}
+
+	// OwnerKeyPrefix prefixes the owner index, keyed by owner and namespace.
+	OwnerKeyPrefix = []byte{0x04}
+)
+
+// NamespaceKey returns the store key of the record of a namespace.
+func NamespaceKey(namespace []byte) []byte {
+	return append(append([]byte{}, NamespaceKeyPrefix...), namespace...)
+}
+
+// ExpirationKey returns the store key of a namespace in the expiration queue.
+func ExpirationKey(expiration time.Time, namespace []byte) []byte {
+	return append(ExpirationPrefix(expiration), namespace...)
+}
+
+// ExpirationPrefix returns the prefix of all namespaces in the expiration
+// queue that expire at the given time.
+func ExpirationPrefix(expiration time.Time) []byte {
+	return append(append([]byte{}, ExpirationKeyPrefix...), sdk.FormatTimeBytes(expiration)...)
+}
+
+// NamespaceFromExpirationKey returns the namespace of a key in the
+// expiration queue.
+func NamespaceFromExpirationKey(key []byte) []byte {
+	// the formatted time has a fixed length
+	return key[len(ExpirationKeyPrefix)+len(sdk.FormatTimeBytes(time.Time{})):]
+}
+
+// OwnerKey returns the store key of a namespace in the owner index.
+func OwnerKey(owner sdk.AccAddress, namespace []byte) []byte {
+	return append(OwnerPrefix(owner), namespace...)
+}
+
+// OwnerPrefix returns the prefix of all namespaces owned by owner in the
+// owner index.
+func OwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, OwnerKeyPrefix...), address.MustLengthPrefix(owner)...)
+}
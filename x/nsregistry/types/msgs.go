@@ -0,0 +1,142 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	blobtypes "github.com/celestiaorg/celestia-app/v6/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAllowedSigners is the maximum number of allowed signers of a namespace.
+// It bounds the work done by the ante handler for every MsgPayForBlobs.
+const MaxAllowedSigners = 100
+
+var (
+	_ sdk.Msg = &MsgRegisterNamespace{}
+	_ sdk.Msg = &MsgTransferNamespace{}
+	_ sdk.Msg = &MsgRenewNamespace{}
+	_ sdk.Msg = &MsgUpdateNamespaceSigners{}
+	_ sdk.Msg = &MsgUpdateNsregistryParams{}
+)
+
+// NewMsgRegisterNamespace returns a new MsgRegisterNamespace.
+func NewMsgRegisterNamespace(owner string, namespace share.Namespace, restricted bool, allowedSigners []string) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:          owner,
+		Namespace:      namespace.Bytes(),
+		Restricted:     restricted,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.Restricted, msg.AllowedSigners)
+}
+
+// NewMsgTransferNamespace returns a new MsgTransferNamespace.
+func NewMsgTransferNamespace(owner string, namespace share.Namespace, newOwner string) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Owner:     owner,
+		Namespace: namespace.Bytes(),
+		NewOwner:  newOwner,
+	}
+}
+
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err)
+	}
+	if msg.Owner == msg.NewOwner {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new owner is the current owner")
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+// NewMsgRenewNamespace returns a new MsgRenewNamespace.
+func NewMsgRenewNamespace(owner string, namespace share.Namespace) *MsgRenewNamespace {
+	return &MsgRenewNamespace{
+		Owner:     owner,
+		Namespace: namespace.Bytes(),
+	}
+}
+
+func (msg *MsgRenewNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+// NewMsgUpdateNamespaceSigners returns a new MsgUpdateNamespaceSigners.
+func NewMsgUpdateNamespaceSigners(owner string, namespace share.Namespace, restricted bool, allowedSigners []string) *MsgUpdateNamespaceSigners {
+	return &MsgUpdateNamespaceSigners{
+		Owner:          owner,
+		Namespace:      namespace.Bytes(),
+		Restricted:     restricted,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+func (msg *MsgUpdateNamespaceSigners) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.Restricted, msg.AllowedSigners)
+}
+
+// NewMsgUpdateNsregistryParams returns a new MsgUpdateNsregistryParams.
+func NewMsgUpdateNsregistryParams(authority string, params Params) *MsgUpdateNsregistryParams {
+	return &MsgUpdateNsregistryParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateNamespace returns an error if namespace can not be registered
+// because it is not a valid blob namespace.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return nil
+}
+
+// ValidateAllowedSigners returns an error if the allowed signers contain an
+// invalid or duplicate address, or are set for an unrestricted namespace.
+func ValidateAllowedSigners(restricted bool, allowedSigners []string) error {
+	if !restricted && len(allowedSigners) > 0 {
+		return errors.Wrap(ErrInvalidAllowedSigners, "allowed signers can only be set for a restricted namespace")
+	}
+	if len(allowedSigners) > MaxAllowedSigners {
+		return errors.Wrapf(ErrInvalidAllowedSigners, "%d allowed signers exceeds the maximum of %d", len(allowedSigners), MaxAllowedSigners)
+	}
+
+	seen := make(map[string]struct{}, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(ErrInvalidAllowedSigners, "invalid address %s: %s", signer, err)
+		}
+		if _, ok := seen[signer]; ok {
+			return errors.Wrapf(ErrInvalidAllowedSigners, "duplicate address %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v6/x/nsregistry/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterNamespaceValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	signer := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgRegisterNamespace
+		wantErr error
+	}{
+		{
+			name: "valid unrestricted namespace",
+			msg:  types.NewMsgRegisterNamespace(owner, ns, false, nil),
+		},
+		{
+			name: "valid restricted namespace",
+			msg:  types.NewMsgRegisterNamespace(owner, ns, true, []string{signer}),
+		},
+		{
+			name:    "reserved namespace",
+			msg:     types.NewMsgRegisterNamespace(owner, share.TxNamespace, false, nil),
+			wantErr: types.ErrInvalidNamespace,
+		},
+		{
+			name:    "malformed namespace",
+			msg:     &types.MsgRegisterNamespace{Owner: owner, Namespace: []byte{1, 2, 3}},
+			wantErr: types.ErrInvalidNamespace,
+		},
+		{
+			name:    "allowed signers of an unrestricted namespace",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, false, []string{signer}),
+			wantErr: types.ErrInvalidAllowedSigners,
+		},
+		{
+			name:    "duplicate allowed signers",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, true, []string{signer, signer}),
+			wantErr: types.ErrInvalidAllowedSigners,
+		},
+		{
+			name:    "invalid allowed signer",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, true, []string{"invalid"}),
+			wantErr: types.ErrInvalidAllowedSigners,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Error(t, (&types.MsgRegisterNamespace{Owner: "invalid", Namespace: ns.Bytes()}).ValidateBasic())
+	require.Error(t, types.NewMsgTransferNamespace(owner, ns, owner).ValidateBasic())
+	require.NoError(t, types.NewMsgTransferNamespace(owner, ns, signer).ValidateBasic())
+}
@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsregistry/v1/nsregistry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NamespaceRecord describes the ownership of a registered namespace.
+type NamespaceRecord struct {
+	// Namespace is the full namespace (version and ID) that is registered.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Owner is the account that owns the namespace.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Restricted indicates whether only the owner and the allowed signers may
+	// submit MsgPayForBlobs to the namespace.
+	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// AllowedSigners are the accounts, in addition to the owner, that may submit
+	// MsgPayForBlobs to a restricted namespace.
+	AllowedSigners []string `protobuf:"bytes,4,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+	// Deposit is the amount escrowed for the registration.
+	Deposit types.Coin `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit"`
+	// Expiration is the time after which the registration lapses.
+	Expiration time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *NamespaceRecord) Reset()         { *m = NamespaceRecord{} }
+func (m *NamespaceRecord) String() string { return proto.CompactTextString(m) }
+func (*NamespaceRecord) ProtoMessage()    {}
+func (*NamespaceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b892d1d806edcc56, []int{0}
+}
+func (m *NamespaceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRecord.Merge(m, src)
+}
+func (m *NamespaceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceRecord proto.InternalMessageInfo
+
+func (m *NamespaceRecord) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *NamespaceRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NamespaceRecord) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *NamespaceRecord) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func (m *NamespaceRecord) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *NamespaceRecord) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*NamespaceRecord)(nil), "celestia.nsregistry.v1.NamespaceRecord")
+}
+
+func init() {
+	proto.RegisterFile("celestia/nsregistry/v1/nsregistry.proto", fileDescriptor_b892d1d806edcc56)
+}
+
+var fileDescriptor_b892d1d806edcc56 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0xd7, 0xfd, 0x47, 0x6b, 0x10, 0x95, 0xa2, 0x0a, 0xa5, 0x2b, 0xe4, 0x8d, 0xb8, 0xb0,
+	0x97, 0xda, 0x5a, 0x90, 0x90, 0x38, 0x36, 0x70, 0x46, 0x28, 0xe5, 0xc4, 0xa5, 0x72, 0x92, 0xc1,
+	0x58, 0x4a, 0x3c, 0x96, 0xed, 0x6e, 0xdb, 0xb7, 0xe8, 0xc3, 0xf0, 0x06, 0x5c, 0x7a, 0x5c, 0x71,
+	0xe2, 0x04, 0x68, 0xf7, 0x45, 0xd0, 0xc6, 0x09, 0xe4, 0xd4, 0xdb, 0x7c, 0x33, 0xbf, 0x2f, 0xa3,
+	0xf9, 0x62, 0xfa, 0xb2, 0x82, 0x06, 0x7c, 0xd0, 0x52, 0x18, 0xef, 0x40, 0x69, 0x1f, 0xdc, 0xad,
+	0x58, 0x2e, 0x46, 0x8a, 0x5b, 0x87, 0x01, 0x93, 0x67, 0x03, 0xc8, 0x47, 0xa3, 0xe5, 0x62, 0x7a,
+	0xa2, 0x50, 0x61, 0x87, 0x88, 0x6d, 0x15, 0xe9, 0xe9, 0x4c, 0x21, 0xaa, 0x06, 0x44, 0xa7, 0xca,
+	0xab, 0x2f, 0x22, 0xe8, 0x16, 0x7c, 0x90, 0xad, 0xed, 0x81, 0xd3, 0x0a, 0x7d, 0x8b, 0xfe, 0x32,
+	0x3a, 0xa3, 0xe8, 0x47, 0x2c, 0x2a, 0x51, 0x4a, 0x0f, 0x62, 0xb9, 0x28, 0x21, 0xc8, 0x85, 0xa8,
+	0x50, 0x9b, 0x38, 0x7f, 0xf1, 0x7d, 0x87, 0x1e, 0x7f, 0x90, 0x2d, 0x78, 0x2b, 0x2b, 0x28, 0xa0,
+	0x42, 0x57, 0x27, 0xcf, 0xe9, 0x91, 0x19, 0x5a, 0x29, 0xc9, 0xc8, 0xfc, 0x49, 0xf1, 0xbf, 0x91,
+	0x70, 0xba, 0x8f, 0xd7, 0x06, 0x5c, 0xba, 0x93, 0x91, 0xf9, 0x51, 0x9e, 0xfe, 0xf8, 0x76, 0x76,
+	0xd2, 0xaf, 0x3c, 0xaf, 0x6b, 0x07, 0xde, 0x5f, 0x04, 0xa7, 0x8d, 0x2a, 0x22, 0x96, 0x30, 0x4a,
+	0x1d, 0xf8, 0xe0, 0x74, 0x15, 0xa0, 0x4e, 0x77, 0x33, 0x32, 0x3f, 0x2c, 0x46, 0x9d, 0xe4, 0x9c,
+	0x1e, 0xcb, 0xa6, 0xc1, 0x6b, 0xa8, 0x2f, 0xbd, 0x56, 0x06, 0x9c, 0x4f, 0xf7, 0xb2, 0xdd, 0x07,
+	0xbf, 0xfc, 0xb4, 0x37, 0x5c, 0x44, 0x3e, 0x79, 0x4b, 0x1f, 0xd5, 0x60, 0xd1, 0xeb, 0x90, 0xee,
+	0x67, 0x64, 0xfe, 0xf8, 0xd5, 0x29, 0xef, 0x7d, 0xdb, 0xb3, 0x79, 0x7f, 0x36, 0x7f, 0x87, 0xda,
+	0xe4, 0x7b, 0xf7, 0xbf, 0x66, 0x93, 0x62, 0xe0, 0x93, 0xf7, 0x94, 0xc2, 0x8d, 0xd5, 0x4e, 0x06,
+	0x8d, 0x26, 0x3d, 0xe8, 0xdc, 0x53, 0x1e, 0x03, 0xe7, 0x43, 0xe0, 0xfc, 0xd3, 0x10, 0x78, 0x7e,
+	0xb8, 0xb5, 0xdf, 0xfd, 0x9e, 0x91, 0x62, 0xe4, 0xcb, 0x3f, 0xde, 0xaf, 0x19, 0x59, 0xad, 0x19,
+	0xf9, 0xb3, 0x66, 0xe4, 0x6e, 0xc3, 0x26, 0xab, 0x0d, 0x9b, 0xfc, 0xdc, 0xb0, 0xc9, 0xe7, 0x37,
+	0x4a, 0x87, 0xaf, 0x57, 0x25, 0xaf, 0xb0, 0x15, 0xc3, 0x4f, 0x47, 0xa7, 0xfe, 0xd5, 0x67, 0xd2,
+	0x5a, 0x71, 0x33, 0x7e, 0x2f, 0xe1, 0xd6, 0x82, 0x2f, 0x0f, 0xba, 0xdd, 0xaf, 0xff, 0x06, 0x00,
+	0x00, 0xff, 0xff, 0x2b, 0xcd, 0xb8, 0x4d, 0x53, 0x02, 0x00, 0x00,
+}
+
+func (m *NamespaceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNsregistry(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNsregistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintNsregistry(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNsregistry(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintNsregistry(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNsregistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNsregistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NamespaceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovNsregistry(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNsregistry(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovNsregistry(uint64(l))
+		}
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovNsregistry(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovNsregistry(uint64(l))
+	return n
+}
+
+func sovNsregistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNsregistry(x uint64) (n int) {
+	return sovNsregistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NamespaceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNsregistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNsregistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNsregistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNsregistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNsregistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNsregistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNsregistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNsregistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNsregistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNsregistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNsregistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNsregistry = fmt.Errorf("proto: unexpected end of group")
+)
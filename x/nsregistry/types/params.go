@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultRegistrationDeposit is 100 TIA.
+	DefaultRegistrationDeposit = sdk.NewCoin(appconsts.BondDenom, math.NewInt(100_000_000))
+
+	// DefaultRegistrationPeriod is one year.
+	DefaultRegistrationPeriod = 365 * 24 * time.Hour
+)
+
+// NewParams creates a new instance of Params.
+func NewParams(registrationDeposit sdk.Coin, registrationPeriod time.Duration) Params {
+	return Params{
+		RegistrationDeposit: registrationDeposit,
+		RegistrationPeriod:  registrationPeriod,
+	}
+}
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationDeposit, DefaultRegistrationPeriod)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := p.RegistrationDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+	if p.RegistrationPeriod <= 0 {
+		return fmt.Errorf("registration period must be positive: %s", p.RegistrationPeriod)
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsregistry/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the nsregistry module.
+type Params struct {
+	// RegistrationDeposit is the deposit escrowed by the module when a namespace
+	// is registered. It is refunded to the owner once the registration expires.
+	RegistrationDeposit types.Coin `protobuf:"bytes,1,opt,name=registration_deposit,json=registrationDeposit,proto3" json:"registration_deposit"`
+	// RegistrationPeriod is the duration a registration lasts before it must be
+	// renewed.
+	RegistrationPeriod time.Duration `protobuf:"bytes,2,opt,name=registration_period,json=registrationPeriod,proto3,stdduration" json:"registration_period"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a6bd1769d4b587f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRegistrationDeposit() types.Coin {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRegistrationPeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationPeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.nsregistry.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("celestia/nsregistry/v1/params.proto", fileDescriptor_0a6bd1769d4b587f)
+}
+
+var fileDescriptor_0a6bd1769d4b587f = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x84, 0x2a, 0x14, 0xb6, 0x50, 0xa1, 0xd2, 0xc1, 0x45, 0xb0, 0xb0, 0x60, 0x2b,
+	0x20, 0x71, 0x80, 0xd2, 0x03, 0x44, 0x11, 0x13, 0x4b, 0xe5, 0x24, 0xc6, 0x58, 0x6a, 0xf2, 0x2c,
+	0xdb, 0x89, 0xe8, 0x2d, 0x18, 0x39, 0x07, 0xa7, 0xe8, 0xd8, 0x91, 0x09, 0x50, 0x72, 0x11, 0x14,
+	0x27, 0x81, 0xb0, 0x3d, 0xfb, 0xfd, 0xff, 0xf7, 0x7e, 0xfd, 0xfe, 0x65, 0xca, 0x37, 0xdc, 0x58,
+	0xc9, 0x68, 0x61, 0x34, 0x17, 0xd2, 0x58, 0xbd, 0xa5, 0x55, 0x48, 0x15, 0xd3, 0x2c, 0x37, 0x44,
+	0x69, 0xb0, 0x10, 0x9c, 0x0e, 0x22, 0xf2, 0x27, 0x22, 0x55, 0x38, 0x9f, 0x0a, 0x10, 0xe0, 0x24,
+	0xb4, 0x9d, 0x3a, 0xf5, 0x1c, 0x0b, 0x00, 0xb1, 0xe1, 0xd4, 0xbd, 0x92, 0xf2, 0x89, 0x66, 0xa5,
+	0x66, 0x56, 0x42, 0x31, 0xec, 0x53, 0x30, 0x39, 0x18, 0x9a, 0x30, 0xc3, 0x69, 0x15, 0x26, 0xdc,
+	0xb2, 0x90, 0xa6, 0x20, 0xfb, 0xfd, 0xc5, 0x3b, 0xf2, 0x27, 0x91, 0x3b, 0x1f, 0xc4, 0xfe, 0xb4,
+	0xbf, 0xe7, 0x00, 0xeb, 0x8c, 0x2b, 0x30, 0xd2, 0xce, 0xd0, 0x39, 0xba, 0x3a, 0xbe, 0x39, 0x23,
+	0x1d, 0x89, 0xb4, 0x24, 0xd2, 0x93, 0xc8, 0x3d, 0xc8, 0x62, 0x79, 0xb8, 0xfb, 0x5c, 0x78, 0xf1,
+	0xc9, 0xd8, 0xbc, 0xea, 0xbc, 0xc1, 0x83, 0xff, 0xef, 0x7b, 0xad, 0xb8, 0x96, 0x90, 0xcd, 0x0e,
+	0x7a, 0x64, 0x17, 0x9e, 0x0c, 0xe1, 0xc9, 0xaa, 0x0f, 0xbf, 0x3c, 0x6a, 0x91, 0x6f, 0x5f, 0x0b,
+	0x14, 0x07, 0x63, 0x7f, 0xe4, 0xec, 0xcb, 0x68, 0x57, 0x63, 0xb4, 0xaf, 0x31, 0xfa, 0xae, 0x31,
+	0x7a, 0x6d, 0xb0, 0xb7, 0x6f, 0xb0, 0xf7, 0xd1, 0x60, 0xef, 0xf1, 0x4e, 0x48, 0xfb, 0x5c, 0x26,
+	0x24, 0x85, 0x9c, 0x0e, 0x3d, 0x82, 0x16, 0xbf, 0xf3, 0x35, 0x53, 0x8a, 0xbe, 0x8c, 0xeb, 0xb7,
+	0x5b, 0xc5, 0x4d, 0x32, 0x71, 0x11, 0x6e, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x69, 0xa2, 0xd9,
+	0x9e, 0xa2, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RegistrationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RegistrationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RegistrationDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RegistrationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
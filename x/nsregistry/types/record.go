@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the record is malformed.
+func (r NamespaceRecord) Validate() error {
+	if err := ValidateNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of namespace %X: %w", r.Namespace, err)
+	}
+	if err := ValidateAllowedSigners(r.Restricted, r.AllowedSigners); err != nil {
+		return err
+	}
+	if err := r.Deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit of namespace %X: %w", r.Namespace, err)
+	}
+	return nil
+}
+
+// IsExpired returns true if the registration has lapsed at time t.
+func (r NamespaceRecord) IsExpired(t time.Time) bool {
+	return !t.Before(r.Expiration)
+}
+
+// CanSubmit returns true if signer may submit MsgPayForBlobs to the
+// namespace.
+func (r NamespaceRecord) CanSubmit(signer string) bool {
+	if !r.Restricted || signer == r.Owner {
+		return true
+	}
+	return slices.Contains(r.AllowedSigners, signer)
+}
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
 	cmd.AddCommand(CmdGetMissingValidators())
+	cmd.AddCommand(CmdQuerySignalHistory())
+	cmd.AddCommand(CmdQueryTallyHistory())
 	return cmd
 }
 
@@ -75,6 +77,9 @@ func CmdGetUpgrade() *cobra.Command {
 				return err
 			}
 
+			if resp.Upgrade != nil && resp.Upgrade.ScheduledBy != "" {
+				return clientCtx.PrintString(fmt.Sprintf("An upgrade scheduled by %s is pending to app version %d at height %d.\n", resp.Upgrade.ScheduledBy, resp.Upgrade.AppVersion, resp.Upgrade.UpgradeHeight))
+			}
 			if resp.Upgrade != nil {
 				return clientCtx.PrintString(fmt.Sprintf("An upgrade is pending to app version %d at height %d.\n", resp.Upgrade.AppVersion, resp.Upgrade.UpgradeHeight))
 			}
@@ -116,3 +121,77 @@ func CmdGetMissingValidators() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQuerySignalHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signal-history version",
+		Short:   "Query for the signals that validators submitted for a particular version, ordered by height",
+		Args:    cobra.ExactArgs(1),
+		Example: "signal-history 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SignalHistory(cmd.Context(), &types.QuerySignalHistoryRequest{Version: version, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signal-history")
+	return cmd
+}
+
+func CmdQueryTallyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tally-history version",
+		Short:   "Query for the tally of voting power that has signalled for a particular version over time",
+		Args:    cobra.ExactArgs(1),
+		Example: "tally-history 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TallyHistory(cmd.Context(), &types.QueryTallyHistoryRequest{Version: version, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tally-history")
+	return cmd
+}
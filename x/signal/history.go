@@ -0,0 +1,105 @@
+package signal
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordSignal records that a validator signalled for a version at the
+// current height along with its voting power, and records the resulting tally
+// of the version.
+func (k Keeper) recordSignal(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) error {
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	record := types.SignalRecord{
+		ValidatorAddress: valAddress.String(),
+		Version:          version,
+		Height:           ctx.BlockHeight(),
+		VotingPower:      power,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SignalRecordKey(version, record.Height, valAddress), k.binaryCodec.MustMarshal(&record))
+
+	return k.recordTally(ctx, version)
+}
+
+// recordTally records the current tally of a version at the current height.
+// A tally recorded earlier at the same height is replaced.
+func (k Keeper) recordTally(ctx sdk.Context, version uint64) error {
+	tally, err := k.versionTally(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	record := types.TallyRecord{
+		Version:          version,
+		Height:           ctx.BlockHeight(),
+		VotingPower:      tally.VotingPower,
+		ThresholdPower:   tally.ThresholdPower,
+		TotalVotingPower: tally.TotalVotingPower,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TallyRecordKey(version, record.Height), k.binaryCodec.MustMarshal(&record))
+	return nil
+}
+
+// SignalHistory enables a client to query for the signals that validators
+// submitted for a particular version, ordered by height.
+func (k Keeper) SignalHistory(ctx context.Context, req *types.QuerySignalHistoryRequest) (*types.QuerySignalHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var signals []*types.SignalRecord
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.SignalRecordPrefix(req.Version))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.SignalRecord
+		if err := k.binaryCodec.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		signals = append(signals, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySignalHistoryResponse{Signals: signals, Pagination: pageRes}, nil
+}
+
+// TallyHistory enables a client to query for the tally of voting power that
+// has signalled for a particular version over time, ordered by height. A tally
+// is recorded at every height at which a validator signalled for the version
+// or switched to another version.
+func (k Keeper) TallyHistory(ctx context.Context, req *types.QueryTallyHistoryRequest) (*types.QueryTallyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var tallies []*types.TallyRecord
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.TallyRecordPrefix(req.Version))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.TallyRecord
+		if err := k.binaryCodec.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		tallies = append(tallies, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTallyHistoryResponse{Tallies: tallies, Pagination: pageRes}, nil
+}
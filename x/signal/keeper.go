@@ -1,17 +1,19 @@
 package signal
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
+	"strconv"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v6/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -44,6 +46,10 @@ type Keeper struct {
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address that is allowed to schedule and cancel
+	// upgrades. It is usually the governance module account.
+	authority string
 }
 
 // NewKeeper returns a signal keeper.
@@ -51,14 +57,21 @@ func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the signal module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -82,8 +95,20 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 		return nil, err
 	}
 
+	previousVersion, hasSignalled := k.getValidatorVersion(sdkCtx, valAddr)
 	k.SetValidatorVersion(sdkCtx, valAddr, req.Version)
 
+	if err := k.recordSignal(sdkCtx, valAddr, req.Version); err != nil {
+		return nil, err
+	}
+	if hasSignalled && previousVersion != req.Version {
+		// the validator's voting power no longer counts towards the
+		// previous version.
+		if err := k.recordTally(sdkCtx, previousVersion); err != nil {
+			return nil, err
+		}
+	}
+
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSignalVersion,
@@ -140,34 +165,110 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// ScheduleUpgrade is a method required by the MsgServer interface. It persists
+// an upgrade to the requested version at the requested height without
+// requiring a quorum of validators to signal for it. The upgrade height must be
+// at least the upgrade height delay of the chain after the current height.
+func (k *Keeper) ScheduleUpgrade(ctx context.Context, req *types.MsgScheduleUpgrade) (*types.MsgScheduleUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+
+	if k.IsUpgradePending(sdkCtx) {
+		return nil, types.ErrUpgradePending.Wrapf("can not schedule upgrade")
+	}
+
+	appVersion := sdkCtx.BlockHeader().Version.App
+	if req.AppVersion <= appVersion {
+		return nil, types.ErrInvalidUpgradeVersion.Wrapf("can not upgrade to version %v because it is less than or equal to current version %v", req.AppVersion, appVersion)
+	}
+
+	header := sdkCtx.HeaderInfo()
+	minUpgradeHeight := header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID)
+	if req.UpgradeHeight < minUpgradeHeight {
+		return nil, types.ErrInvalidUpgradeHeight.Wrapf("upgrade height %d is lower than the minimum upgrade height %d", req.UpgradeHeight, minUpgradeHeight)
+	}
+
+	k.setUpgrade(sdkCtx, types.Upgrade{
+		AppVersion:    req.AppVersion,
+		UpgradeHeight: req.UpgradeHeight,
+		ScheduledBy:   req.Authority,
+	})
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, strconv.FormatUint(req.AppVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(req.UpgradeHeight, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgScheduleUpgrade),
+		),
+	)
+
+	return &types.MsgScheduleUpgradeResponse{}, nil
+}
+
+// CancelUpgrade is a method required by the MsgServer interface. It clears the
+// pending upgrade, regardless of whether it was scheduled or reached through
+// signalling, and resets the tally so that validators have to signal again.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending.Wrapf("can not cancel upgrade")
+	}
+
+	k.ResetTally(sdkCtx)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, strconv.FormatUint(upgrade.AppVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(upgrade.UpgradeHeight, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgCancelUpgrade),
+		),
+	)
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	totalVotingPower, err := k.stakingKeeper.GetLastTotalPower(sdkCtx)
+	return k.versionTally(sdkCtx, req.Version)
+}
+
+// versionTally returns the voting power that has signalled for a version.
+func (k Keeper) versionTally(ctx sdk.Context, version uint64) (*types.QueryVersionTallyResponse, error) {
+	totalVotingPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
 	if err != nil {
 		return nil, err
 	}
 	currentVotingPower := math.NewInt(0)
-	store := sdkCtx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.FirstSignalKey, nil)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignalKeyPrefix)
+	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
-			continue
-		}
 		valAddress := sdk.ValAddress(iterator.Key())
-		power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
 		if err != nil {
 			return nil, err
 		}
-		version := VersionFromBytes(iterator.Value())
-		if version == req.Version {
+		if VersionFromBytes(iterator.Value()) == version {
 			currentVotingPower = currentVotingPower.AddRaw(power)
 		}
 	}
 
-	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
+	threshold, err := k.GetVotingPowerThreshold(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +305,7 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 
 		// Check if this validator has voted for the requested version
 		store := sdkCtx.KVStore(k.storeKey)
-		votedVersionBytes := store.Get(valAddr)
+		votedVersionBytes := store.Get(types.SignalKey(valAddr))
 
 		// If validator hasn't voted or voted for a different version, add to missing list
 		if votedVersionBytes == nil {
@@ -230,13 +331,24 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 // SetValidatorVersion saves a signalled version for a validator.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(valAddress, VersionToBytes(version))
+	store.Set(types.SignalKey(valAddress), VersionToBytes(version))
+}
+
+// getValidatorVersion returns the version a validator has signalled for and
+// whether it has signalled at all.
+func (k Keeper) getValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.SignalKey(valAddress))
+	if value == nil {
+		return 0, false
+	}
+	return VersionFromBytes(value), true
 }
 
 // DeleteValidatorVersion deletes a signalled version for a validator.
 func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(valAddress)
+	store.Delete(types.SignalKey(valAddress))
 }
 
 // TallyVotingPower tallies the voting power for each version and returns true
@@ -244,13 +356,10 @@ func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddres
 // Returns false and 0 otherwise.
 func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64, error) {
 	versionToPower := make(map[uint64]int64)
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.FirstSignalKey, nil)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignalKeyPrefix)
+	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
-			continue
-		}
 		valAddress := sdk.ValAddress(iterator.Key())
 		// check that the validator is still part of the bonded set
 		found := true
@@ -312,16 +421,18 @@ func (k *Keeper) ShouldUpgrade(ctx sdk.Context) (isQuorumVersion bool, upgrade t
 	return false, types.Upgrade{}
 }
 
-// ResetTally resets the tally after a version change. It iterates over the
-// store and deletes all versions. It also resets the quorumVersion and
-// upgradeHeight to 0.
+// ResetTally resets the tally after a version change. It deletes the signals
+// of all validators and the pending upgrade. The signal and tally history is
+// kept.
 func (k *Keeper) ResetTally(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
+	store.Delete(types.UpgradeKey)
+
+	signals := prefix.NewStore(store, types.SignalKeyPrefix)
+	iterator := signals.Iterator(nil, nil)
 	defer iterator.Close()
-	// delete the value in the upgrade key and all signals.
 	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+		signals.Delete(iterator.Key())
 	}
 }
 
@@ -23,11 +23,17 @@ import (
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func TestGetVotingPowerThreshold(t *testing.T) {
 	bigInt := big.NewInt(0)
 	bigInt.SetString("23058430092136939509", 10)
@@ -72,7 +78,7 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
+			k := signal.NewKeeper(config.Codec, nil, stakingKeeper, authority)
 			got, err := k.GetVotingPowerThreshold(sdk.Context{})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
@@ -548,6 +554,195 @@ func TestTallyAfterTryUpgrade(t *testing.T) {
 	require.EqualValues(t, 120, res.TotalVotingPower)
 }
 
+func TestScheduleUpgrade(t *testing.T) {
+	minUpgradeHeight := appconsts.GetUpgradeHeightDelay(appconsts.TestChainID)
+
+	t.Run("should schedule an upgrade at the requested height", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		_, err := upgradeKeeper.ScheduleUpgrade(ctx, types.NewMsgScheduleUpgrade(authority, 2, minUpgradeHeight+10))
+		require.NoError(t, err)
+
+		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &types.Upgrade{AppVersion: 2, UpgradeHeight: minUpgradeHeight + 10, ScheduledBy: authority}, got.Upgrade)
+
+		shouldUpgrade, _ := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(minUpgradeHeight + 9))
+		require.False(t, shouldUpgrade)
+		shouldUpgrade, upgrade := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(minUpgradeHeight + 10))
+		require.True(t, shouldUpgrade)
+		require.Equal(t, uint64(2), upgrade.AppVersion)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeScheduleUpgrade, events[0].Type)
+		attributes := make(map[string]string)
+		for _, attr := range events[0].Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		require.Equal(t, authority, attributes[types.AttributeKeyAuthority])
+		require.Equal(t, "2", attributes[types.AttributeKeyAppVersion])
+		require.Equal(t, fmt.Sprint(minUpgradeHeight+10), attributes[types.AttributeKeyUpgradeHeight])
+
+		// signalling is rejected while the scheduled upgrade is pending.
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+		require.ErrorIs(t, err, types.ErrUpgradePending)
+	})
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgScheduleUpgrade
+		wantErr error
+	}{
+		{
+			name:    "should return an error if the authority is invalid",
+			msg:     types.NewMsgScheduleUpgrade(testutil.ValAddrs[0].String(), 2, minUpgradeHeight),
+			wantErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:    "should return an error if the version is not greater than the current version",
+			msg:     types.NewMsgScheduleUpgrade(authority, 1, minUpgradeHeight),
+			wantErr: types.ErrInvalidUpgradeVersion,
+		},
+		{
+			name:    "should return an error if the upgrade height is below the minimum delay",
+			msg:     types.NewMsgScheduleUpgrade(authority, 2, minUpgradeHeight-1),
+			wantErr: types.ErrInvalidUpgradeHeight,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upgradeKeeper, ctx, _ := setup(t)
+			_, err := upgradeKeeper.ScheduleUpgrade(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+		})
+	}
+
+	t.Run("should return an error if an upgrade is already pending", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		_, err := upgradeKeeper.ScheduleUpgrade(ctx, types.NewMsgScheduleUpgrade(authority, 2, minUpgradeHeight))
+		require.NoError(t, err)
+		_, err = upgradeKeeper.ScheduleUpgrade(ctx, types.NewMsgScheduleUpgrade(authority, 3, minUpgradeHeight))
+		require.ErrorIs(t, err, types.ErrUpgradePending)
+	})
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	t.Run("should return an error if the authority is invalid", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		_, err := upgradeKeeper.ScheduleUpgrade(ctx, types.NewMsgScheduleUpgrade(authority, 2, appconsts.TestUpgradeHeightDelay))
+		require.NoError(t, err)
+		_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testutil.ValAddrs[0].String()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+
+	t.Run("should clear an upgrade reached through signalling and reset the tally", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.NoError(t, err)
+
+		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.Nil(t, got.Upgrade)
+		shouldUpgrade, _ := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(math.MaxInt64))
+		require.False(t, shouldUpgrade)
+
+		tally, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, tally.VotingPower)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeCancelUpgrade, events[0].Type)
+
+		// validators can signal again after the cancellation.
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+		require.NoError(t, err)
+	})
+}
+
+func TestSignalHistory(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	signal := func(height int64, valIndex int, version uint64) {
+		_, err := upgradeKeeper.SignalVersion(ctx.WithBlockHeight(height), &types.MsgSignalVersion{
+			ValidatorAddress: testutil.ValAddrs[valIndex].String(),
+			Version:          version,
+		})
+		require.NoError(t, err)
+	}
+	signal(10, 0, 2) // 40 power
+	signal(12, 2, 2) // 59 power
+	signal(12, 3, 3) // 20 power
+	signal(15, 0, 3) // switches from 2 to 3
+
+	signals, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SignalRecord{
+		{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2, Height: 10, VotingPower: 40},
+		{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 2, Height: 12, VotingPower: 59},
+	}, signals.Signals)
+
+	tallies, err := upgradeKeeper.TallyHistory(ctx, &types.QueryTallyHistoryRequest{Version: 2})
+	require.NoError(t, err)
+	require.Len(t, tallies.Tallies, 3)
+	for i, want := range []struct {
+		height int64
+		power  uint64
+	}{{10, 40}, {12, 99}, {15, 59}} {
+		require.Equal(t, want.height, tallies.Tallies[i].Height)
+		require.Equal(t, want.power, tallies.Tallies[i].VotingPower)
+		require.EqualValues(t, 100, tallies.Tallies[i].ThresholdPower)
+		require.EqualValues(t, 120, tallies.Tallies[i].TotalVotingPower)
+	}
+
+	tallies, err = upgradeKeeper.TallyHistory(ctx, &types.QueryTallyHistoryRequest{Version: 3, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, tallies.Tallies, 1)
+	require.EqualValues(t, 20, tallies.Tallies[0].VotingPower)
+	require.EqualValues(t, 2, tallies.Pagination.Total)
+
+	// the history doesn't count towards the tally and survives a reset.
+	tally, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 60, tally.VotingPower)
+
+	upgradeKeeper.ResetTally(ctx)
+	tally, err = upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, tally.VotingPower)
+	hasQuorum, _, err := upgradeKeeper.TallyVotingPower(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, hasQuorum)
+
+	signals, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Version: 3})
+	require.NoError(t, err)
+	require.Len(t, signals.Signals, 2)
+
+	_, err = upgradeKeeper.SignalHistory(ctx, nil)
+	require.Error(t, err)
+	_, err = upgradeKeeper.TallyHistory(ctx, nil)
+	require.Error(t, err)
+}
+
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	signalStore := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
@@ -571,7 +766,7 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 		},
 	)
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper, authority)
 	return upgradeKeeper, mockCtx, mockStakingKeeper
 }
 
@@ -0,0 +1,49 @@
+package signal
+
+import (
+	"bytes"
+
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is responsible for handling migrations related to the signal module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator creates a new Migrator instance using the provided Keeper for handling migrations in the signal module.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateSignalKeys moves the signals of validators, which used to be keyed by
+// the bare validator address, under types.SignalKeyPrefix so that iterating
+// over the signals doesn't visit the signal and tally history.
+func (m *Migrator) MigrateSignalKeys(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	type signal struct {
+		valAddress []byte
+		version    []byte
+	}
+	var signals []signal
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		signals = append(signals, signal{valAddress: bytes.Clone(iterator.Key()), version: bytes.Clone(iterator.Value())})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, signal := range signals {
+		store.Delete(signal.valAddress)
+	}
+	for _, signal := range signals {
+		store.Set(types.SignalKey(signal.valAddress), signal.version)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package signal_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v6/app"
+	"github.com/celestiaorg/celestia-app/v6/app/encoding"
+	testutil "github.com/celestiaorg/celestia-app/v6/test/util"
+	"github.com/celestiaorg/celestia-app/v6/x/signal"
+	"github.com/celestiaorg/celestia-app/v6/x/signal/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateSignalKeys(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	stateStore := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NoOpMetrics{})
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	stakingKeeper := newMockStakingKeeper(map[string]int64{
+		testutil.ValAddrs[0].String(): 40,
+		testutil.ValAddrs[1].String(): 60,
+	})
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	keeper := signal.NewKeeper(config.Codec, storeKey, stakingKeeper, authority)
+
+	// Signals used to be keyed by the bare validator address.
+	kvStore := ctx.KVStore(storeKey)
+	upgrade := []byte("pending upgrade")
+	kvStore.Set(types.UpgradeKey, upgrade)
+	kvStore.Set(testutil.ValAddrs[0], signal.VersionToBytes(2))
+	kvStore.Set(testutil.ValAddrs[1], signal.VersionToBytes(3))
+
+	migrator := signal.NewMigrator(keeper)
+	require.NoError(t, migrator.MigrateSignalKeys(ctx))
+
+	require.Nil(t, kvStore.Get(testutil.ValAddrs[0]))
+	require.Nil(t, kvStore.Get(testutil.ValAddrs[1]))
+	require.Equal(t, upgrade, kvStore.Get(types.UpgradeKey))
+
+	res, err := keeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 40, res.VotingPower)
+	res, err = keeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 60, res.VotingPower)
+}
@@ -18,7 +18,7 @@ import (
 
 const (
 	// consensusVersion defines the current x/signal module consensus version.
-	consensusVersion uint64 = 3
+	consensusVersion uint64 = 4
 )
 
 var (
@@ -90,6 +90,13 @@ func (am AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, &am.keeper)
 	types.RegisterQueryServer(registrar, &am.keeper)
+
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 3, m.MigrateSignalKeys); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
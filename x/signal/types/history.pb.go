@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignalRecord records that a validator signalled for a version.
+type SignalRecord struct {
+	// ValidatorAddress is the address of the validator that signalled.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Version is the version that the validator signalled for.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Height is the height at which the validator signalled.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// VotingPower is the voting power of the validator at the time it
+	// signalled.
+	VotingPower int64 `protobuf:"varint,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *SignalRecord) Reset()         { *m = SignalRecord{} }
+func (m *SignalRecord) String() string { return proto.CompactTextString(m) }
+func (*SignalRecord) ProtoMessage()    {}
+func (*SignalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e00287d263198a01, []int{0}
+}
+func (m *SignalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRecord.Merge(m, src)
+}
+func (m *SignalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRecord proto.InternalMessageInfo
+
+func (m *SignalRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SignalRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// TallyRecord records the tally of voting power that has signalled for a
+// version at a height at which the tally changed.
+type TallyRecord struct {
+	// Version is the version that was tallied.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Height is the height at which the tally changed.
+	Height           int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	VotingPower      uint64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	ThresholdPower   uint64 `protobuf:"varint,4,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
+	TotalVotingPower uint64 `protobuf:"varint,5,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *TallyRecord) Reset()         { *m = TallyRecord{} }
+func (m *TallyRecord) String() string { return proto.CompactTextString(m) }
+func (*TallyRecord) ProtoMessage()    {}
+func (*TallyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e00287d263198a01, []int{1}
+}
+func (m *TallyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyRecord.Merge(m, src)
+}
+func (m *TallyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyRecord proto.InternalMessageInfo
+
+func (m *TallyRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TallyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TallyRecord) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *TallyRecord) GetThresholdPower() uint64 {
+	if m != nil {
+		return m.ThresholdPower
+	}
+	return 0
+}
+
+func (m *TallyRecord) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SignalRecord)(nil), "celestia.signal.v1.SignalRecord")
+	proto.RegisterType((*TallyRecord)(nil), "celestia.signal.v1.TallyRecord")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/history.proto", fileDescriptor_e00287d263198a01) }
+
+var fileDescriptor_e00287d263198a01 = []byte{
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x19, 0x40, 0x8c, 0x03, 0x51, 0x9c, 0x85, 0xa9, 0x26, 0x36, 0x85, 0x8d, 0x2c, 0xa4,
+	0x0d, 0x7a, 0x02, 0x59, 0x1b, 0x63, 0x8a, 0x61, 0xe1, 0xa6, 0x19, 0xda, 0x49, 0x3b, 0xc9, 0xd0,
+	0xd7, 0xcc, 0x8c, 0x55, 0x6e, 0xe1, 0x5d, 0x74, 0xe3, 0x0d, 0x5c, 0x12, 0x57, 0x2e, 0x0d, 0x5c,
+	0xc4, 0x38, 0xa5, 0x88, 0x26, 0xba, 0x9b, 0xff, 0x9f, 0xef, 0xbd, 0xff, 0x25, 0x3f, 0x76, 0x42,
+	0x26, 0x98, 0xd2, 0x9c, 0x7a, 0x8a, 0xc7, 0x29, 0x15, 0x5e, 0x3e, 0xf0, 0x12, 0xae, 0x34, 0xc8,
+	0x99, 0x9b, 0x49, 0xd0, 0x40, 0x48, 0x49, 0xb8, 0x05, 0xe1, 0xe6, 0x83, 0xa3, 0xc3, 0x10, 0xd4,
+	0x14, 0x54, 0x60, 0x08, 0xaf, 0x10, 0x05, 0xde, 0x7d, 0x42, 0xb8, 0x35, 0x32, 0xa0, 0xcf, 0x42,
+	0x90, 0x11, 0xb9, 0xc2, 0xfb, 0x39, 0x15, 0x3c, 0xa2, 0x1a, 0x64, 0x40, 0xa3, 0x48, 0x32, 0xa5,
+	0x2c, 0xe4, 0xa0, 0xde, 0xce, 0xb0, 0xf3, 0xf6, 0xdc, 0x3f, 0x5e, 0x4d, 0x8f, 0x4b, 0xe6, 0xa2,
+	0x40, 0x46, 0x5a, 0xf2, 0x34, 0xf6, 0xdb, 0xf9, 0x2f, 0x9f, 0x58, 0x78, 0x3b, 0x67, 0x52, 0x71,
+	0x48, 0xad, 0xaa, 0x83, 0x7a, 0x75, 0xbf, 0x94, 0xe4, 0x00, 0x37, 0x12, 0xc6, 0xe3, 0x44, 0x5b,
+	0x35, 0x07, 0xf5, 0x6a, 0xfe, 0x4a, 0x91, 0x0e, 0x6e, 0xe5, 0xa0, 0x79, 0x1a, 0x07, 0x19, 0xdc,
+	0x33, 0x69, 0xd5, 0xcd, 0x6f, 0xb3, 0xf0, 0xae, 0xbf, 0xac, 0xee, 0x0b, 0xc2, 0xcd, 0x1b, 0x2a,
+	0xc4, 0x6c, 0x75, 0xf4, 0x46, 0x08, 0xfa, 0x2b, 0xa4, 0xfa, 0x6f, 0x48, 0xcd, 0x8c, 0x6d, 0x86,
+	0x90, 0x13, 0xbc, 0xa7, 0x13, 0xc9, 0x54, 0x02, 0x22, 0xda, 0x38, 0xa5, 0xee, 0xef, 0xae, 0xed,
+	0x02, 0x3c, 0xc5, 0x44, 0x83, 0xa6, 0x22, 0xf8, 0xb1, 0x71, 0xcb, 0xb0, 0x6d, 0xf3, 0x33, 0xfe,
+	0x5e, 0x3b, 0xbc, 0x7c, 0x5d, 0xd8, 0x68, 0xbe, 0xb0, 0xd1, 0xc7, 0xc2, 0x46, 0x8f, 0x4b, 0xbb,
+	0x32, 0x5f, 0xda, 0x95, 0xf7, 0xa5, 0x5d, 0xb9, 0x3d, 0x8b, 0xb9, 0x4e, 0xee, 0x26, 0x6e, 0x08,
+	0x53, 0xaf, 0x6c, 0x11, 0x64, 0xbc, 0x7e, 0xf7, 0x69, 0x96, 0x79, 0x0f, 0x65, 0xf3, 0x7a, 0x96,
+	0x31, 0x35, 0x69, 0x98, 0x1a, 0xcf, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x76, 0x01, 0x96,
+	0x19, 0x02, 0x00, 0x00,
+}
+
+func (m *SignalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ThresholdPower != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovHistory(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *TallyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovHistory(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovHistory(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovHistory(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
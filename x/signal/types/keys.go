@@ -1,12 +1,46 @@
 package types
 
+import "encoding/binary"
+
 var (
 	// UpgradeKey is the key in the signal store used to persist an upgrade if one is
 	// pending.
 	UpgradeKey = []byte{0x00}
 
-	// FirstSignalKey is used as a divider to separate the UpgradeKey from all
-	// the keys associated with signals from validators. In practice, this key
-	// isn't expected to be set or retrieved.
-	FirstSignalKey = []byte{0x000}
+	// SignalKeyPrefix is the prefix of the signals from validators, which are
+	// keyed by validator address.
+	SignalKeyPrefix = []byte{0x01}
+
+	// SignalRecordKeyPrefix is the prefix of the recorded signals.
+	SignalRecordKeyPrefix = []byte{0x02}
+
+	// TallyRecordKeyPrefix is the prefix of the recorded tallies.
+	TallyRecordKeyPrefix = []byte{0x03}
 )
+
+// SignalKey returns the key of the signal of a validator.
+func SignalKey(valAddress []byte) []byte {
+	return append(append([]byte{}, SignalKeyPrefix...), valAddress...)
+}
+
+// SignalRecordPrefix returns the prefix of the recorded signals for a version.
+func SignalRecordPrefix(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, SignalRecordKeyPrefix...), version)
+}
+
+// SignalRecordKey returns the key of the signal of a validator for a version
+// at a height.
+func SignalRecordKey(version uint64, height int64, valAddress []byte) []byte {
+	key := binary.BigEndian.AppendUint64(SignalRecordPrefix(version), uint64(height))
+	return append(key, valAddress...)
+}
+
+// TallyRecordPrefix returns the prefix of the recorded tallies for a version.
+func TallyRecordPrefix(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, TallyRecordKeyPrefix...), version)
+}
+
+// TallyRecordKey returns the key of the tally for a version at a height.
+func TallyRecordKey(version uint64, height int64) []byte {
+	return binary.BigEndian.AppendUint64(TallyRecordPrefix(version), uint64(height))
+}
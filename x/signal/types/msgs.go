@@ -10,19 +10,28 @@ const (
 	ModuleName = "signal"
 	StoreKey   = ModuleName
 
-	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
-	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgSignalVersion   = "/celestia.signal.v1.Msg/SignalVersion"
+	URLMsgTryUpgrade      = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgScheduleUpgrade = "/celestia.signal.v1.Msg/ScheduleUpgrade"
+	URLMsgCancelUpgrade   = "/celestia.signal.v1.Msg/CancelUpgrade"
 
-	EventTypeTryUpgrade    = "signal_try_upgrade"
-	EventTypeSignalVersion = "signal_version"
+	EventTypeTryUpgrade      = "signal_try_upgrade"
+	EventTypeSignalVersion   = "signal_version"
+	EventTypeScheduleUpgrade = "signal_schedule_upgrade"
+	EventTypeCancelUpgrade   = "signal_cancel_upgrade"
 
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeySigner           = "signer"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyAppVersion       = "app_version"
+	AttributeKeyUpgradeHeight    = "upgrade_height"
 )
 
 var (
 	_ sdk.Msg = &MsgSignalVersion{}
 	_ sdk.Msg = &MsgTryUpgrade{}
+	_ sdk.Msg = &MsgScheduleUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -49,3 +58,35 @@ func (msg *MsgTryUpgrade) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	return err
 }
+
+func NewMsgScheduleUpgrade(authority string, appVersion uint64, upgradeHeight int64) *MsgScheduleUpgrade {
+	return &MsgScheduleUpgrade{
+		Authority:     authority,
+		AppVersion:    appVersion,
+		UpgradeHeight: upgradeHeight,
+	}
+}
+
+func (msg *MsgScheduleUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.AppVersion == 0 {
+		return ErrInvalidUpgradeVersion.Wrap("app version must be positive")
+	}
+	if msg.UpgradeHeight <= 0 {
+		return ErrInvalidUpgradeHeight.Wrapf("upgrade height must be positive: %d", msg.UpgradeHeight)
+	}
+	return nil
+}
+
+func NewMsgCancelUpgrade(authority string) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority,
+	}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -307,6 +308,218 @@ func (m *QueryGetMissingValidatorsResponse) GetMissingValidators() []string {
 	return nil
 }
 
+// QuerySignalHistoryRequest is the request type for the SignalHistory query.
+type QuerySignalHistoryRequest struct {
+	Version    uint64             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryRequest) Reset()         { *m = QuerySignalHistoryRequest{} }
+func (m *QuerySignalHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryRequest) ProtoMessage()    {}
+func (*QuerySignalHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{6}
+}
+func (m *QuerySignalHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryRequest.Merge(m, src)
+}
+func (m *QuerySignalHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QuerySignalHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySignalHistoryResponse is the response type for the SignalHistory query.
+type QuerySignalHistoryResponse struct {
+	Signals    []*SignalRecord     `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryResponse) Reset()         { *m = QuerySignalHistoryResponse{} }
+func (m *QuerySignalHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryResponse) ProtoMessage()    {}
+func (*QuerySignalHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{7}
+}
+func (m *QuerySignalHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryResponse.Merge(m, src)
+}
+func (m *QuerySignalHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryResponse) GetSignals() []*SignalRecord {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *QuerySignalHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTallyHistoryRequest is the request type for the TallyHistory query.
+type QueryTallyHistoryRequest struct {
+	Version    uint64             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTallyHistoryRequest) Reset()         { *m = QueryTallyHistoryRequest{} }
+func (m *QueryTallyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyHistoryRequest) ProtoMessage()    {}
+func (*QueryTallyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{8}
+}
+func (m *QueryTallyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyHistoryRequest.Merge(m, src)
+}
+func (m *QueryTallyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTallyHistoryRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryTallyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTallyHistoryResponse is the response type for the TallyHistory query.
+type QueryTallyHistoryResponse struct {
+	Tallies    []*TallyRecord      `protobuf:"bytes,1,rep,name=tallies,proto3" json:"tallies,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTallyHistoryResponse) Reset()         { *m = QueryTallyHistoryResponse{} }
+func (m *QueryTallyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyHistoryResponse) ProtoMessage()    {}
+func (*QueryTallyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{9}
+}
+func (m *QueryTallyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyHistoryResponse.Merge(m, src)
+}
+func (m *QueryTallyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTallyHistoryResponse) GetTallies() []*TallyRecord {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+func (m *QueryTallyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
@@ -314,43 +527,60 @@ func init() {
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
 	proto.RegisterType((*QueryGetMissingValidatorsRequest)(nil), "celestia.signal.v1.QueryGetMissingValidatorsRequest")
 	proto.RegisterType((*QueryGetMissingValidatorsResponse)(nil), "celestia.signal.v1.QueryGetMissingValidatorsResponse")
+	proto.RegisterType((*QuerySignalHistoryRequest)(nil), "celestia.signal.v1.QuerySignalHistoryRequest")
+	proto.RegisterType((*QuerySignalHistoryResponse)(nil), "celestia.signal.v1.QuerySignalHistoryResponse")
+	proto.RegisterType((*QueryTallyHistoryRequest)(nil), "celestia.signal.v1.QueryTallyHistoryRequest")
+	proto.RegisterType((*QueryTallyHistoryResponse)(nil), "celestia.signal.v1.QueryTallyHistoryResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0x40, 0xc5, 0xb4, 0xe2, 0x63, 0x54, 0x81, 0x31, 0x91, 0x95, 0x5a, 0x48, 0x54,
-	0xd0, 0xd8, 0x6a, 0x68, 0x6f, 0x9c, 0xb8, 0x70, 0x01, 0xa9, 0x44, 0x90, 0x03, 0x97, 0x68, 0xdb,
-	0xac, 0x9c, 0x95, 0x1c, 0xaf, 0xbb, 0xbb, 0x31, 0x44, 0x88, 0x03, 0xfc, 0x01, 0x90, 0x10, 0x3f,
-	0x82, 0x7f, 0x02, 0xb7, 0x4a, 0x5c, 0x38, 0xa2, 0x84, 0x1f, 0x82, 0xb2, 0xbb, 0x4e, 0x02, 0x49,
-	0x4a, 0xb9, 0x25, 0x33, 0xef, 0xbd, 0x79, 0xf3, 0x66, 0x0d, 0xc1, 0x31, 0x4b, 0x99, 0xd2, 0x9c,
-	0xc6, 0x8a, 0x27, 0x19, 0x4d, 0xe3, 0x62, 0x2f, 0x3e, 0x19, 0x30, 0x39, 0x8c, 0x72, 0x29, 0xb4,
-	0x40, 0x2c, 0xfb, 0x91, 0xed, 0x47, 0xc5, 0x9e, 0x5f, 0x4b, 0x84, 0x48, 0x52, 0x16, 0xd3, 0x9c,
-	0xc7, 0x34, 0xcb, 0x84, 0xa6, 0x9a, 0x8b, 0x4c, 0x59, 0x86, 0x5f, 0x5f, 0xa2, 0x38, 0xc8, 0x13,
-	0x49, 0xbb, 0xcc, 0x22, 0xc2, 0x7d, 0xf0, 0x9e, 0x4d, 0x46, 0xb4, 0x99, 0x54, 0x5c, 0x64, 0xcf,
-	0x69, 0x9a, 0x0e, 0x5b, 0xec, 0x64, 0xc0, 0x94, 0x46, 0x0f, 0xd6, 0x0b, 0x5b, 0xf6, 0x48, 0x9d,
-	0xec, 0x5c, 0x68, 0x95, 0x7f, 0xc3, 0xcf, 0x04, 0x6e, 0x2d, 0xa1, 0xa9, 0x5c, 0x64, 0x8a, 0xe1,
-	0x36, 0x6c, 0x16, 0x42, 0xf3, 0x2c, 0xe9, 0xe4, 0xe2, 0x15, 0x93, 0x8e, 0xbc, 0x61, 0x6b, 0x87,
-	0x93, 0x12, 0xde, 0x85, 0xab, 0xba, 0x27, 0x99, 0xea, 0x89, 0xb4, 0xeb, 0x50, 0x6b, 0x06, 0x75,
-	0x65, 0x5a, 0xb6, 0xc0, 0x5d, 0x40, 0x2d, 0x34, 0x4d, 0x3b, 0x7f, 0x28, 0x56, 0x0d, 0xf6, 0x9a,
-	0xe9, 0xb4, 0x67, 0xb2, 0xa1, 0x07, 0x37, 0x8c, 0xad, 0xc7, 0x4c, 0xbf, 0xb0, 0x6b, 0xba, 0x5d,
-	0xc2, 0x43, 0xb8, 0xb9, 0xd0, 0x71, 0x76, 0x0f, 0x60, 0xdd, 0x65, 0x62, 0x9c, 0x6e, 0x34, 0x6f,
-	0x47, 0x8b, 0x41, 0x47, 0x25, 0xab, 0xc4, 0x86, 0x0f, 0xa1, 0x5e, 0x2a, 0x3e, 0xe5, 0x4a, 0xf1,
-	0x2c, 0x69, 0xd3, 0x94, 0x77, 0xa9, 0x16, 0x52, 0xfd, 0x3b, 0xc1, 0x16, 0x6c, 0x9f, 0xc1, 0x76,
-	0xce, 0x1a, 0x80, 0x7d, 0xdb, 0xec, 0x14, 0xd3, 0xae, 0x47, 0xea, 0xd5, 0x9d, 0xcb, 0xad, 0xeb,
-	0xfd, 0xbf, 0x69, 0xcd, 0x6f, 0x55, 0xb8, 0x68, 0x44, 0xf1, 0x03, 0x81, 0xcd, 0xf9, 0xd3, 0xe0,
-	0xee, 0xb2, 0x95, 0x56, 0x1d, 0xde, 0x6f, 0x9c, 0x13, 0x6d, 0x6d, 0x86, 0xe1, 0xfb, 0xef, 0xbf,
-	0x3e, 0xad, 0xd5, 0xd0, 0x9f, 0x7b, 0x65, 0x7a, 0x82, 0x88, 0xdf, 0xb8, 0x75, 0xdf, 0xe2, 0x3b,
-	0x02, 0x30, 0xcb, 0x1e, 0xef, 0xad, 0x9c, 0xb0, 0x70, 0x3a, 0xff, 0xfe, 0xb9, 0xb0, 0xce, 0x8b,
-	0x6f, 0xbc, 0x6c, 0x21, 0x2e, 0xbe, 0x78, 0xfc, 0x42, 0x60, 0x6b, 0x59, 0xde, 0xb8, 0x7f, 0xd6,
-	0x84, 0x55, 0xc7, 0xf5, 0x0f, 0xfe, 0x93, 0xe5, 0x1c, 0xde, 0x31, 0x0e, 0x03, 0xac, 0xcd, 0x39,
-	0x74, 0xb7, 0x9c, 0xe5, 0xf5, 0xe8, 0xc9, 0xd7, 0x51, 0x40, 0x4e, 0x47, 0x01, 0xf9, 0x39, 0x0a,
-	0xc8, 0xc7, 0x71, 0x50, 0x39, 0x1d, 0x07, 0x95, 0x1f, 0xe3, 0xa0, 0xf2, 0xb2, 0x99, 0x70, 0xdd,
-	0x1b, 0x1c, 0x45, 0xc7, 0xa2, 0x1f, 0x97, 0x06, 0x84, 0x4c, 0xa6, 0xbf, 0x1b, 0x34, 0xcf, 0xe3,
-	0xd7, 0xa5, 0xb8, 0x1e, 0xe6, 0x4c, 0x1d, 0x5d, 0x32, 0x1f, 0xfb, 0x83, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x79, 0xc3, 0x7d, 0xbc, 0x62, 0x04, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xed, 0xb6, 0x94, 0x88, 0x49, 0xf9, 0x5a, 0x55, 0x10, 0x4c, 0x65, 0x52, 0xab, 0xa2, 0x55,
+	0x69, 0x6d, 0x25, 0xb4, 0x07, 0x10, 0x27, 0x0e, 0x94, 0x03, 0x48, 0xc5, 0x40, 0x0f, 0x5c, 0xa2,
+	0x4d, 0xb2, 0x72, 0x56, 0x72, 0xbc, 0xae, 0x77, 0x13, 0x88, 0xa0, 0x07, 0xf8, 0x03, 0x20, 0x01,
+	0xe2, 0x88, 0x38, 0xf2, 0x4f, 0x38, 0x56, 0xe2, 0xc2, 0x11, 0xb5, 0xfc, 0x0a, 0x4e, 0x28, 0xbb,
+	0xeb, 0xd6, 0x69, 0x9c, 0xb4, 0x48, 0x88, 0x5b, 0xb2, 0xf3, 0x66, 0xe7, 0xed, 0x9b, 0x37, 0x63,
+	0xb0, 0x1b, 0x34, 0xa4, 0x42, 0x32, 0xe2, 0x09, 0x16, 0x44, 0x24, 0xf4, 0xba, 0x15, 0x6f, 0xbb,
+	0x43, 0x93, 0x9e, 0x1b, 0x27, 0x5c, 0x72, 0x8c, 0xd3, 0xb8, 0xab, 0xe3, 0x6e, 0xb7, 0x62, 0xcd,
+	0x05, 0x9c, 0x07, 0x21, 0xf5, 0x48, 0xcc, 0x3c, 0x12, 0x45, 0x5c, 0x12, 0xc9, 0x78, 0x24, 0x74,
+	0x86, 0x55, 0xce, 0xb9, 0xb1, 0x13, 0x07, 0x09, 0x69, 0xd2, 0x31, 0x88, 0x16, 0x13, 0x92, 0xa7,
+	0x55, 0xad, 0xe5, 0x06, 0x17, 0x6d, 0x2e, 0xbc, 0x3a, 0x11, 0x54, 0xd3, 0xf1, 0xba, 0x95, 0x3a,
+	0x95, 0xa4, 0xe2, 0xc5, 0x24, 0x60, 0x91, 0x2a, 0xa8, 0xb1, 0xce, 0x1a, 0x94, 0x1e, 0xf5, 0x11,
+	0x5b, 0x34, 0x11, 0x8c, 0x47, 0x4f, 0x48, 0x18, 0xf6, 0x7c, 0xba, 0xdd, 0xa1, 0x42, 0xe2, 0x12,
+	0x14, 0xba, 0xfa, 0xb8, 0x84, 0xca, 0x68, 0xe9, 0x94, 0x9f, 0xfe, 0x75, 0x3e, 0x22, 0xb8, 0x92,
+	0x93, 0x26, 0x62, 0x1e, 0x09, 0x8a, 0xe7, 0x61, 0xa6, 0xcb, 0x25, 0x8b, 0x82, 0x5a, 0xcc, 0x9f,
+	0xd3, 0xc4, 0x24, 0x17, 0xf5, 0xd9, 0x66, 0xff, 0x08, 0x2f, 0xc2, 0x79, 0xd9, 0x4a, 0xa8, 0x68,
+	0xf1, 0xb0, 0x69, 0x50, 0x93, 0x0a, 0x75, 0xee, 0xe0, 0x58, 0x03, 0x57, 0x00, 0x4b, 0x2e, 0x49,
+	0x58, 0x1b, 0xb8, 0x71, 0x4a, 0x61, 0x2f, 0xa8, 0xc8, 0xd6, 0xe1, 0xb5, 0x4e, 0x09, 0x2e, 0x29,
+	0x5a, 0x1b, 0x54, 0x3e, 0xd5, 0xa2, 0x99, 0xb7, 0x38, 0x9b, 0x70, 0x79, 0x28, 0x62, 0xe8, 0xae,
+	0x43, 0xc1, 0x28, 0xac, 0x98, 0x16, 0xab, 0x57, 0xdd, 0xe1, 0xb6, 0xb9, 0x69, 0x56, 0x8a, 0x75,
+	0xee, 0x40, 0x39, 0xbd, 0xf1, 0x21, 0x13, 0x82, 0x45, 0xc1, 0x16, 0x09, 0x59, 0x93, 0x48, 0x9e,
+	0x88, 0xe3, 0x15, 0xf4, 0x61, 0x7e, 0x4c, 0xb6, 0x61, 0xb6, 0x0a, 0xb8, 0xad, 0x83, 0xb5, 0xee,
+	0x41, 0xb4, 0x84, 0xca, 0x53, 0x4b, 0x67, 0xfc, 0x8b, 0xed, 0xa3, 0x69, 0xce, 0x8e, 0x69, 0xca,
+	0x63, 0x45, 0xfa, 0xbe, 0xf6, 0xc4, 0xb1, 0x54, 0xf0, 0x3d, 0x80, 0x43, 0x5b, 0xa8, 0x36, 0x14,
+	0xab, 0xd7, 0x5d, 0xed, 0x21, 0xb7, 0xef, 0x21, 0x57, 0x5b, 0xda, 0x78, 0xc8, 0xdd, 0x24, 0x41,
+	0x2a, 0xab, 0x9f, 0xc9, 0x74, 0xbe, 0x20, 0xb0, 0xf2, 0xea, 0x9b, 0xc7, 0xdc, 0x86, 0x82, 0x56,
+	0x53, 0xbf, 0xa0, 0x58, 0x2d, 0xe7, 0xc9, 0xac, 0x73, 0x7d, 0xda, 0xe0, 0x49, 0xd3, 0x4f, 0x13,
+	0xf0, 0x46, 0x0e, 0xc5, 0xc5, 0x63, 0x29, 0xea, 0xc2, 0x03, 0x1c, 0x5f, 0x19, 0xbb, 0x2b, 0xc3,
+	0xfe, 0x77, 0x85, 0x3e, 0xa7, 0x63, 0x33, 0x58, 0xde, 0x08, 0x74, 0x0b, 0x0a, 0x92, 0x84, 0x21,
+	0xa3, 0xa9, 0x40, 0xd7, 0xf2, 0x04, 0x32, 0xa3, 0xa6, 0xf5, 0x31, 0xf8, 0x7f, 0xa6, 0x4f, 0xf5,
+	0xf7, 0x34, 0x4c, 0x2b, 0x86, 0xf8, 0x2d, 0x82, 0x99, 0xec, 0x74, 0xe3, 0x95, 0x3c, 0x36, 0xa3,
+	0x76, 0x87, 0xb5, 0x7a, 0x42, 0xb4, 0xe6, 0xe0, 0x38, 0x6f, 0xbe, 0xff, 0x7a, 0x3f, 0x39, 0x87,
+	0xad, 0xcc, 0x52, 0xeb, 0x3f, 0xae, 0xe7, 0xbd, 0x34, 0x4d, 0xd8, 0xc1, 0xaf, 0x11, 0xc0, 0xe1,
+	0xf8, 0xe2, 0xe5, 0x91, 0x15, 0x86, 0xa6, 0xdf, 0xba, 0x71, 0x22, 0xac, 0xe1, 0x62, 0x29, 0x2e,
+	0xb3, 0x18, 0x0f, 0xaf, 0x60, 0xfc, 0x15, 0xc1, 0x6c, 0xde, 0xc8, 0xe2, 0xb5, 0x71, 0x15, 0x46,
+	0xed, 0x07, 0x6b, 0xfd, 0x2f, 0xb3, 0x0c, 0xc3, 0x05, 0xc5, 0xd0, 0xc6, 0x73, 0x19, 0x86, 0x66,
+	0x1d, 0x64, 0xf4, 0xfa, 0x80, 0xe0, 0xec, 0xc0, 0x28, 0xe2, 0xd1, 0x4d, 0xc9, 0x5b, 0x19, 0x96,
+	0x7b, 0x52, 0xf8, 0x18, 0x5a, 0xe6, 0xcb, 0x94, 0xa1, 0xf5, 0x09, 0xc1, 0x4c, 0xd6, 0xff, 0x63,
+	0x8c, 0x95, 0x33, 0xa5, 0x63, 0x8c, 0x95, 0x37, 0x54, 0xce, 0xb2, 0xe2, 0xb4, 0x80, 0x9d, 0xa3,
+	0xc6, 0xaa, 0x0d, 0x31, 0xbb, 0xfb, 0xe0, 0xdb, 0x9e, 0x8d, 0x76, 0xf7, 0x6c, 0xf4, 0x73, 0xcf,
+	0x46, 0xef, 0xf6, 0xed, 0x89, 0xdd, 0x7d, 0x7b, 0xe2, 0xc7, 0xbe, 0x3d, 0xf1, 0xac, 0x1a, 0x30,
+	0xd9, 0xea, 0xd4, 0xdd, 0x06, 0x6f, 0x7b, 0x69, 0x79, 0x9e, 0x04, 0x07, 0xbf, 0x57, 0x49, 0x1c,
+	0x7b, 0x2f, 0xd2, 0x12, 0xb2, 0x17, 0x53, 0x51, 0x3f, 0xad, 0x3e, 0xb0, 0x37, 0xff, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x20, 0xaa, 0x54, 0xe2, 0x24, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +604,12 @@ type QueryClient interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(ctx context.Context, in *QueryGetMissingValidatorsRequest, opts ...grpc.CallOption) (*QueryGetMissingValidatorsResponse, error)
+	// SignalHistory enables a client to query for the signals that validators
+	// submitted for a particular version, ordered by height.
+	SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error)
+	// TallyHistory enables a client to query for the tally of voting power that
+	// has signalled for a particular version over time, ordered by height.
+	TallyHistory(ctx context.Context, in *QueryTallyHistoryRequest, opts ...grpc.CallOption) (*QueryTallyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -411,6 +647,24 @@ func (c *queryClient) GetMissingValidators(ctx context.Context, in *QueryGetMiss
 	return out, nil
 }
 
+func (c *queryClient) SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error) {
+	out := new(QuerySignalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/SignalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyHistory(ctx context.Context, in *QueryTallyHistoryRequest, opts ...grpc.CallOption) (*QueryTallyHistoryResponse, error) {
+	out := new(QueryTallyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/TallyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VersionTally enables a client to query for the tally of voting power that
@@ -422,6 +676,12 @@ type QueryServer interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(context.Context, *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error)
+	// SignalHistory enables a client to query for the signals that validators
+	// submitted for a particular version, ordered by height.
+	SignalHistory(context.Context, *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error)
+	// TallyHistory enables a client to query for the tally of voting power that
+	// has signalled for a particular version over time, ordered by height.
+	TallyHistory(context.Context, *QueryTallyHistoryRequest) (*QueryTallyHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -437,6 +697,12 @@ func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUp
 func (*UnimplementedQueryServer) GetMissingValidators(ctx context.Context, req *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissingValidators not implemented")
 }
+func (*UnimplementedQueryServer) SignalHistory(ctx context.Context, req *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalHistory not implemented")
+}
+func (*UnimplementedQueryServer) TallyHistory(ctx context.Context, req *QueryTallyHistoryRequest) (*QueryTallyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -496,6 +762,42 @@ func _Query_GetMissingValidators_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SignalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/SignalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalHistory(ctx, req.(*QuerySignalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/TallyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyHistory(ctx, req.(*QueryTallyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
@@ -513,6 +815,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMissingValidators",
 			Handler:    _Query_GetMissingValidators_Handler,
 		},
+		{
+			MethodName: "SignalHistory",
+			Handler:    _Query_SignalHistory_Handler,
+		},
+		{
+			MethodName: "TallyHistory",
+			Handler:    _Query_TallyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
@@ -702,73 +1012,251 @@ func (m *QueryGetMissingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySignalHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVersionTallyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
-	}
-	return n
+
+func (m *QuerySignalHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVersionTallyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySignalHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	if m.ThresholdPower != 0 {
-		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetUpgradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignalHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Upgrade != nil {
-		l = m.Upgrade.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySignalHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMissingValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySignalHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVersionTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryVersionTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *QueryGetUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMissingValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Version != 0 {
@@ -792,6 +1280,76 @@ func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySignalHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1261,6 +1819,456 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySignalHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, &SignalRecord{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, &TallyRecord{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
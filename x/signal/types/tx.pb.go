@@ -202,44 +202,242 @@ func (m *MsgTryUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTryUpgradeResponse proto.InternalMessageInfo
 
+// MsgScheduleUpgrade schedules an upgrade to app_version at upgrade_height.
+type MsgScheduleUpgrade struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// app_version is the app version to upgrade to.
+	AppVersion uint64 `protobuf:"varint,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// upgrade_height is the height at which the network upgrades. It must be at
+	// least the upgrade height delay of the chain after the current height.
+	UpgradeHeight int64 `protobuf:"varint,3,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+}
+
+func (m *MsgScheduleUpgrade) Reset()         { *m = MsgScheduleUpgrade{} }
+func (m *MsgScheduleUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpgrade) ProtoMessage()    {}
+func (*MsgScheduleUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{4}
+}
+func (m *MsgScheduleUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpgrade.Merge(m, src)
+}
+func (m *MsgScheduleUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleUpgrade proto.InternalMessageInfo
+
+func (m *MsgScheduleUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleUpgrade) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *MsgScheduleUpgrade) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+// MsgScheduleUpgradeResponse is the response type for the ScheduleUpgrade
+// method.
+type MsgScheduleUpgradeResponse struct {
+}
+
+func (m *MsgScheduleUpgradeResponse) Reset()         { *m = MsgScheduleUpgradeResponse{} }
+func (m *MsgScheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpgradeResponse) ProtoMessage()    {}
+func (*MsgScheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{5}
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpgradeResponse.Merge(m, src)
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleUpgradeResponse proto.InternalMessageInfo
+
+// MsgCancelUpgrade cancels the pending upgrade.
+type MsgCancelUpgrade struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{6}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgCancelUpgradeResponse is the response type for the CancelUpgrade method.
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{7}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignalVersion)(nil), "celestia.signal.v1.MsgSignalVersion")
 	proto.RegisterType((*MsgSignalVersionResponse)(nil), "celestia.signal.v1.MsgSignalVersionResponse")
 	proto.RegisterType((*MsgTryUpgrade)(nil), "celestia.signal.v1.MsgTryUpgrade")
 	proto.RegisterType((*MsgTryUpgradeResponse)(nil), "celestia.signal.v1.MsgTryUpgradeResponse")
+	proto.RegisterType((*MsgScheduleUpgrade)(nil), "celestia.signal.v1.MsgScheduleUpgrade")
+	proto.RegisterType((*MsgScheduleUpgradeResponse)(nil), "celestia.signal.v1.MsgScheduleUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "celestia.signal.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "celestia.signal.v1.MsgCancelUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/tx.proto", fileDescriptor_815f2cc162e6e27e) }
 
 var fileDescriptor_815f2cc162e6e27e = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc6, 0xeb, 0x02, 0x43, 0x18, 0x4d, 0xda, 0xcc, 0x60, 0x59, 0x80, 0x68, 0x8b, 0x38, 0x8c,
-	0x89, 0xc6, 0x6c, 0xdc, 0x76, 0x63, 0x67, 0xba, 0x43, 0x07, 0x3b, 0x70, 0xa9, 0xdc, 0xc6, 0x32,
-	0x96, 0x92, 0x38, 0xf2, 0xdf, 0x8d, 0xda, 0x0b, 0x42, 0x15, 0x1f, 0x00, 0x09, 0x3e, 0x48, 0x0f,
-	0xfd, 0x10, 0x1c, 0xab, 0x72, 0xe1, 0x88, 0x5a, 0xa4, 0x7e, 0x0d, 0xd4, 0x38, 0x29, 0x7d, 0x11,
-	0x62, 0x37, 0xff, 0xf3, 0x3c, 0x79, 0x7e, 0x8f, 0x5f, 0xf0, 0xe3, 0x36, 0x8f, 0x38, 0x18, 0xc9,
-	0x28, 0x48, 0x91, 0xb0, 0x88, 0x66, 0xa7, 0xd4, 0x74, 0x83, 0x54, 0x2b, 0xa3, 0x08, 0x29, 0xc5,
-	0xc0, 0x8a, 0x41, 0x76, 0xea, 0x3e, 0x11, 0x4a, 0x89, 0x88, 0x53, 0x96, 0x4a, 0xca, 0x92, 0x44,
-	0x19, 0x66, 0xa4, 0x4a, 0xc0, 0xfe, 0xe1, 0xee, 0xb7, 0x15, 0xc4, 0x0a, 0x68, 0x0c, 0x62, 0x9e,
-	0x14, 0x83, 0x28, 0x84, 0x03, 0x2b, 0x34, 0xf3, 0x89, 0xda, 0xc1, 0x4a, 0xfe, 0x37, 0x84, 0x77,
-	0xea, 0x20, 0xae, 0x72, 0xc4, 0x35, 0xd7, 0x20, 0x55, 0x42, 0x2e, 0xf1, 0x6e, 0xc6, 0x22, 0x19,
-	0x32, 0xa3, 0x74, 0x93, 0x85, 0xa1, 0xe6, 0x00, 0x0e, 0x3a, 0x44, 0xc7, 0xf7, 0x2e, 0x8e, 0xc6,
-	0xc3, 0xda, 0xd3, 0x22, 0xe1, 0xba, 0xf4, 0xbc, 0xb6, 0x96, 0x2b, 0xa3, 0x65, 0x22, 0x1a, 0x3b,
-	0xd9, 0xda, 0x77, 0xe2, 0xe0, 0xbb, 0x99, 0x8d, 0x76, 0xaa, 0x87, 0xe8, 0xf8, 0x76, 0xa3, 0x1c,
-	0xcf, 0x1f, 0xf5, 0x67, 0x83, 0x93, 0x4d, 0x98, 0xef, 0x62, 0x67, 0xbd, 0x55, 0x83, 0x43, 0xaa,
-	0x12, 0xe0, 0xfe, 0x25, 0xde, 0xae, 0x83, 0x78, 0xab, 0x7b, 0xef, 0x52, 0xa1, 0x59, 0xc8, 0xc9,
-	0x4b, 0xbc, 0x35, 0x3f, 0x22, 0xae, 0x8b, 0x8e, 0xce, 0x78, 0x58, 0xdb, 0x2b, 0x3a, 0xae, 0x56,
-	0x2b, 0x7c, 0xe7, 0xf7, 0xe7, 0xd8, 0x62, 0xf0, 0xf7, 0xf1, 0xc3, 0x95, 0xbc, 0x12, 0x74, 0xf6,
-	0xb9, 0x8a, 0x6f, 0xd5, 0x41, 0x90, 0x8f, 0x78, 0x7b, 0xf5, 0x7c, 0x9e, 0x05, 0x9b, 0x77, 0x13,
-	0xac, 0xf7, 0x75, 0x5f, 0xdc, 0xc4, 0xb5, 0xd8, 0xd5, 0x41, 0xff, 0xc7, 0xef, 0xaf, 0xd5, 0x07,
-	0xfe, 0xee, 0xd2, 0x5b, 0xb0, 0x2b, 0x92, 0x61, 0xbc, 0xb4, 0xdb, 0xa3, 0x7f, 0xc4, 0xfe, 0xb5,
-	0xb8, 0xcf, 0xff, 0x6b, 0x59, 0x60, 0xdd, 0x1c, 0xbb, 0xe7, 0x93, 0x25, 0x6c, 0xa7, 0x88, 0xb9,
-	0xf3, 0x69, 0x36, 0x38, 0x41, 0x17, 0x6f, 0xbe, 0x4f, 0x3c, 0x34, 0x9a, 0x78, 0xe8, 0xd7, 0xc4,
-	0x43, 0x5f, 0xa6, 0x5e, 0x65, 0x34, 0xf5, 0x2a, 0x3f, 0xa7, 0x5e, 0xe5, 0xfd, 0x99, 0x90, 0xe6,
-	0x43, 0xa7, 0x15, 0xb4, 0x55, 0x4c, 0x4b, 0xa2, 0xd2, 0x62, 0xb1, 0xae, 0xb1, 0x34, 0xa5, 0xdd,
-	0x32, 0xd9, 0xf4, 0x52, 0x0e, 0xad, 0xad, 0xfc, 0xdd, 0xbd, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0xac, 0xd7, 0x83, 0x5d, 0xfc, 0x02, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0x52, 0xc5, 0xf0, 0x92, 0x22, 0x8c, 0x28, 0xcb, 0x8a, 0x6b, 0xd9, 0xa8, 0xa9, 0xc4,
+	0xee, 0x0a, 0x26, 0x1e, 0x7a, 0x13, 0x2f, 0x1e, 0x2c, 0x87, 0xa2, 0x1c, 0xb8, 0x34, 0xc3, 0x76,
+	0x32, 0x9d, 0x64, 0xbb, 0x33, 0xd9, 0x99, 0x6e, 0xe8, 0xc5, 0x18, 0x7e, 0x81, 0x89, 0xfe, 0x04,
+	0x7f, 0x00, 0x07, 0x7e, 0x84, 0x47, 0x82, 0x1e, 0x3c, 0x9a, 0xd6, 0x84, 0xbf, 0x61, 0xba, 0x5f,
+	0xed, 0xb6, 0x36, 0x90, 0x70, 0x9b, 0xf7, 0x7d, 0x9f, 0x3e, 0xcf, 0xf3, 0x7e, 0x74, 0xe1, 0xa1,
+	0x4b, 0x3c, 0x22, 0x15, 0xc3, 0x8e, 0x64, 0xd4, 0xc7, 0x9e, 0x13, 0x6e, 0x3b, 0xea, 0xd8, 0x16,
+	0x01, 0x57, 0x1c, 0xa1, 0xb4, 0x68, 0xc7, 0x45, 0x3b, 0xdc, 0x36, 0x36, 0x28, 0xe7, 0xd4, 0x23,
+	0x0e, 0x16, 0xcc, 0xc1, 0xbe, 0xcf, 0x15, 0x56, 0x8c, 0xfb, 0x32, 0xfe, 0x85, 0xb1, 0xe6, 0x72,
+	0xd9, 0xe1, 0xd2, 0xe9, 0x48, 0x3a, 0x64, 0xea, 0x48, 0x9a, 0x14, 0xd6, 0xe3, 0x42, 0x33, 0x8a,
+	0x9c, 0x38, 0x88, 0x4b, 0xd6, 0x37, 0x0d, 0x96, 0xeb, 0x92, 0xee, 0x47, 0x12, 0x07, 0x24, 0x90,
+	0x8c, 0xfb, 0x68, 0x0f, 0x56, 0x42, 0xec, 0xb1, 0x16, 0x56, 0x3c, 0x68, 0xe2, 0x56, 0x2b, 0x20,
+	0x52, 0xea, 0x5a, 0x59, 0xab, 0x2c, 0xec, 0x6e, 0x5e, 0x9c, 0x55, 0x1f, 0x25, 0x0c, 0x07, 0x29,
+	0xe6, 0x4d, 0x0c, 0xd9, 0x57, 0x01, 0xf3, 0x69, 0x63, 0x39, 0x9c, 0xc8, 0x23, 0x1d, 0xee, 0x84,
+	0x31, 0xb5, 0x3e, 0x57, 0xd6, 0x2a, 0xb7, 0x1a, 0x69, 0x58, 0x7b, 0x70, 0x72, 0x79, 0xba, 0x35,
+	0x2d, 0x66, 0x19, 0xa0, 0x4f, 0xba, 0x6a, 0x10, 0x29, 0xb8, 0x2f, 0x89, 0xb5, 0x07, 0xa5, 0xba,
+	0xa4, 0x1f, 0x82, 0xde, 0x47, 0x41, 0x03, 0xdc, 0x22, 0xe8, 0x25, 0xcc, 0x0f, 0x47, 0x44, 0x82,
+	0xc4, 0xa3, 0x7e, 0x71, 0x56, 0x5d, 0x4d, 0x3c, 0xe6, 0xad, 0x25, 0xb8, 0xda, 0xe2, 0x50, 0x36,
+	0x09, 0xac, 0x35, 0xb8, 0x9f, 0xe3, 0xcb, 0x84, 0xbe, 0x6b, 0x80, 0x86, 0x2e, 0xdc, 0x36, 0x69,
+	0x75, 0x3d, 0x92, 0xca, 0xbd, 0x86, 0x05, 0xdc, 0x55, 0x6d, 0x1e, 0x30, 0xd5, 0xbb, 0x52, 0x71,
+	0x04, 0x45, 0x8f, 0x61, 0x11, 0x0b, 0xd1, 0xcc, 0x4f, 0x02, 0xb0, 0x10, 0xe9, 0xd8, 0x9f, 0xc2,
+	0x52, 0x37, 0xd6, 0x68, 0xb6, 0x09, 0xa3, 0x6d, 0xa5, 0x17, 0xcb, 0x5a, 0xa5, 0xd8, 0x28, 0x25,
+	0xd9, 0x77, 0x51, 0xb2, 0xb6, 0x34, 0x34, 0x3f, 0xe2, 0xb5, 0x36, 0xc0, 0x98, 0x76, 0x99, 0x35,
+	0x71, 0x18, 0xed, 0xf7, 0x2d, 0xf6, 0x5d, 0xe2, 0xdd, 0xb0, 0x83, 0x29, 0xe5, 0x78, 0x4b, 0x39,
+	0xee, 0x54, 0x77, 0xe7, 0x57, 0x11, 0x8a, 0x75, 0x49, 0xd1, 0x27, 0x28, 0xe5, 0x8f, 0xeb, 0x89,
+	0x3d, 0x7d, 0xd8, 0xf6, 0xe4, 0xb2, 0x8d, 0x17, 0xd7, 0x41, 0x65, 0x4d, 0xae, 0x9f, 0xfc, 0xfc,
+	0xfb, 0x75, 0xee, 0x9e, 0xb5, 0x32, 0xf6, 0x47, 0x8a, 0x5f, 0x28, 0x04, 0x18, 0x3b, 0x95, 0xcd,
+	0x19, 0xb4, 0x23, 0x88, 0xf1, 0xfc, 0x4a, 0x48, 0x26, 0x6b, 0x44, 0xb2, 0xab, 0x16, 0x1a, 0x93,
+	0x4d, 0x76, 0x85, 0x18, 0xdc, 0x9d, 0x3c, 0x9c, 0x67, 0xb3, 0x7a, 0xca, 0xe3, 0x0c, 0xfb, 0x7a,
+	0xb8, 0xd4, 0x06, 0x72, 0xa1, 0x94, 0xdf, 0xef, 0xac, 0x11, 0xe7, 0x50, 0x33, 0x47, 0xfc, 0xdf,
+	0x7d, 0x1a, 0xb7, 0x3f, 0x5f, 0x9e, 0x6e, 0x69, 0xbb, 0xef, 0x7f, 0xf4, 0x4d, 0xed, 0xbc, 0x6f,
+	0x6a, 0x7f, 0xfa, 0xa6, 0xf6, 0x65, 0x60, 0x16, 0xce, 0x07, 0x66, 0xe1, 0xf7, 0xc0, 0x2c, 0x1c,
+	0xee, 0x50, 0xa6, 0xda, 0xdd, 0x23, 0xdb, 0xe5, 0x1d, 0x27, 0x25, 0xe6, 0x01, 0xcd, 0xde, 0x55,
+	0x2c, 0x84, 0x73, 0x9c, 0x4e, 0x4a, 0xf5, 0x04, 0x91, 0x47, 0xf3, 0xd1, 0x47, 0xe8, 0xd5, 0xbf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x46, 0x45, 0xbe, 0x09, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -259,6 +457,12 @@ type MsgClient interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...grpc.CallOption) (*MsgTryUpgradeResponse, error)
+	// ScheduleUpgrade schedules an upgrade to a version at an explicit height.
+	// It can only be executed by the governance authority.
+	ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error)
+	// CancelUpgrade cancels the pending upgrade and resets the tally. It can
+	// only be executed by the governance authority.
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -287,6 +491,24 @@ func (c *msgClient) TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error) {
+	out := new(MsgScheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/ScheduleUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignalVersion allows a validator to signal for a version.
@@ -294,6 +516,12 @@ type MsgServer interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(context.Context, *MsgTryUpgrade) (*MsgTryUpgradeResponse, error)
+	// ScheduleUpgrade schedules an upgrade to a version at an explicit height.
+	// It can only be executed by the governance authority.
+	ScheduleUpgrade(context.Context, *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error)
+	// CancelUpgrade cancels the pending upgrade and resets the tally. It can
+	// only be executed by the governance authority.
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,6 +534,12 @@ func (*UnimplementedMsgServer) SignalVersion(ctx context.Context, req *MsgSignal
 func (*UnimplementedMsgServer) TryUpgrade(ctx context.Context, req *MsgTryUpgrade) (*MsgTryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleUpgrade(ctx context.Context, req *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,6 +581,42 @@ func _Msg_TryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/ScheduleUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleUpgrade(ctx, req.(*MsgScheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Msg",
@@ -360,6 +630,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryUpgrade",
 			Handler:    _Msg_TryUpgrade_Handler,
 		},
+		{
+			MethodName: "ScheduleUpgrade",
+			Handler:    _Msg_ScheduleUpgrade_Handler,
+		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/tx.proto",
@@ -476,6 +754,122 @@ func (m *MsgTryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -534,13 +928,63 @@ func (m *MsgTryUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgScheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovTx(uint64(m.AppVersion))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeHeight))
+	}
+	return n
 }
-func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
+
+func (m *MsgScheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -823,6 +1267,308 @@ func (m *MsgTryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,10 @@ type Upgrade struct {
 	// UpgradeHeight is the height at which the network should upgrade to the
 	// AppVersion.
 	UpgradeHeight int64 `protobuf:"varint,2,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+	// ScheduledBy is the authority that scheduled the upgrade through
+	// MsgScheduleUpgrade. It is empty if the upgrade was triggered by a quorum
+	// of validators signalling for the version.
+	ScheduledBy string `protobuf:"bytes,3,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
 }
 
 func (m *Upgrade) Reset()         { *m = Upgrade{} }
@@ -79,6 +83,13 @@ func (m *Upgrade) GetUpgradeHeight() int64 {
 	return 0
 }
 
+func (m *Upgrade) GetScheduledBy() string {
+	if m != nil {
+		return m.ScheduledBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Upgrade)(nil), "celestia.signal.v1.Upgrade")
 }
@@ -86,20 +97,21 @@ func init() {
 func init() { proto.RegisterFile("celestia/signal/v1/upgrade.proto", fileDescriptor_7872d1b4aca9f179) }
 
 var fileDescriptor_7872d1b4aca9f179 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
+	// 224 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4e, 0xcd, 0x49,
 	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0xcc, 0xd1, 0x2f, 0x33, 0xd4, 0x2f,
 	0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0x0a, 0xe4, 0x62, 0x0f, 0x85, 0x28, 0x12, 0x92, 0xe7,
+	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0x2a, 0xe1, 0x62, 0x0f, 0x85, 0x28, 0x12, 0x92, 0xe7,
 	0xe2, 0x4e, 0x2c, 0x28, 0x88, 0x2f, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0x93, 0x60, 0x54, 0x60,
 	0xd4, 0x60, 0x09, 0xe2, 0x4a, 0x2c, 0x28, 0x08, 0x83, 0x88, 0x08, 0xa9, 0x72, 0xf1, 0x41, 0x0d,
 	0x8c, 0xcf, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x85,
-	0x8a, 0x7a, 0x80, 0x05, 0x9d, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe6, 0x96, 0xfc,
-	0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0xe6, 0xfe, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xdb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63, 0x22, 0x2e, 0x9d,
-	0xdf, 0x00, 0x00, 0x00,
+	0x8a, 0x7a, 0x80, 0x05, 0x85, 0x14, 0xb9, 0x78, 0x8a, 0x93, 0x33, 0x52, 0x53, 0x4a, 0x73, 0x52,
+	0x53, 0xe2, 0x93, 0x2a, 0x25, 0x98, 0x15, 0x18, 0x35, 0x38, 0x83, 0xb8, 0xe1, 0x62, 0x4e, 0x95,
+	0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
+	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x94, 0x9e, 0x59,
+	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x73, 0x6e, 0x7e, 0x51, 0x3a, 0x9c, 0xad,
+	0x9b, 0x58, 0x50, 0xa0, 0x5f, 0x01, 0xf3, 0x62, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
+	0x7b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x82, 0x28, 0x9a, 0x02, 0x01, 0x00, 0x00,
 }
 
 func (m *Upgrade) Marshal() (dAtA []byte, err error) {
@@ -122,6 +134,13 @@ func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledBy) > 0 {
+		i -= len(m.ScheduledBy)
+		copy(dAtA[i:], m.ScheduledBy)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ScheduledBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.UpgradeHeight != 0 {
 		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradeHeight))
 		i--
@@ -158,6 +177,10 @@ func (m *Upgrade) Size() (n int) {
 	if m.UpgradeHeight != 0 {
 		n += 1 + sovUpgrade(uint64(m.UpgradeHeight))
 	}
+	l = len(m.ScheduledBy)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
@@ -234,6 +257,38 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])